@@ -19,41 +19,83 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		},
 		DefaultTransform: transform.FromCamel(),
 		TableMap: map[string]*plugin.Table{
-			"k8_resource":                   tableKubernetesResource(ctx),
-			"k8_cluster":                    tableKubernetesCluster(ctx),
-			"k8_cluster_role":               tableKubernetesClusterRole(ctx),
-			"k8_cluster_role_binding":       tableKubernetesClusterRoleBinding(ctx),
-			"k8_config_map":                 tableKubernetesConfigMap(ctx),
-			"k8_cronjob":                    tableKubernetesCronJob(ctx),
-			"k8_custom_resource":            tableKubernetesCustomResource(ctx),
-			"k8_custom_resource_definition": tableKubernetesCustomResourceDefinition(ctx),
-			"k8_daemonset":                  tableKubernetesDaemonset(ctx),
-			"k8_deployment":                 tableKubernetesDeployment(ctx),
-			"k8_endpoint_slice":             tableKubernetesEndpointSlice(ctx),
-			"k8_endpoints":                  tableKubernetesEndpoints(ctx),
-			"k8_event":                      tableKubernetesEvent(ctx),
-			"k8_horizontal_pod_autoscaler":  tableKubernetesHorizontalPodAutoscaler(ctx),
-			"k8_ingress":                    tableKubernetesIngress(ctx),
-			"k8_job":                        tableKubernetesJob(ctx),
-			"k8_limit_range":                tableKubernetesLimitRange(ctx),
-			"k8_namespace":                  tableKubernetesNamespace(ctx),
-			"k8_network_policy":             tableKubernetesNetworkPolicy(ctx),
-			"k8_node":                       tableKubernetesNode(ctx),
-			"k8_persistent_volume_claim":    tableKubernetesPersistentVolumeClaim(ctx),
-			"k8_persistent_volume":          tableKubernetesPersistentVolume(ctx),
-			"k8_pod":                        tableKubernetesPod(ctx),
-			"k8_pod_disruption_budget":      tableKubernetesPDB(ctx),
-			"k8_pod_template":               tableKubernetesPodTemplate(ctx),
-			"k8_replicaset":                 tableKubernetesReplicaSet(ctx),
-			"k8_replication_controller":     tableKubernetesReplicaController(ctx),
-			"k8_resource_quota":             tableKubernetesResourceQuota(ctx),
-			"k8_role":                       tableKubernetesRole(ctx),
-			"k8_role_binding":               tableKubernetesRoleBinding(ctx),
-			"k8_secret":                     tableKubernetesSecret(ctx),
-			"k8_service":                    tableKubernetesService(ctx),
-			"k8_service_account":            tableKubernetesServiceAccount(ctx),
-			"k8_stateful_set":               tableKubernetesStatefulSet(ctx),
-			"k8_storage_class":              tableKubernetesStorageClass(ctx),
+			"k8_resource":                            tableKubernetesResource(ctx),
+			"k8_api_service":                         tableKubernetesAPIService(ctx),
+			"k8_argo_app_project":                    tableKubernetesArgoAppProject(ctx),
+			"k8_argo_application":                    tableKubernetesArgoApplication(ctx),
+			"k8_certificate":                         tableKubernetesCertificate(ctx),
+			"k8_certificate_request":                 tableKubernetesCertificateRequest(ctx),
+			"k8_certificate_signing_request":         tableKubernetesCertificateSigningRequest(ctx),
+			"k8_cluster":                             tableKubernetesCluster(ctx),
+			"k8_cluster_issuer":                      tableKubernetesClusterIssuer(ctx),
+			"k8_cluster_role":                        tableKubernetesClusterRole(ctx),
+			"k8_cluster_role_binding":                tableKubernetesClusterRoleBinding(ctx),
+			"k8_config_map":                          tableKubernetesConfigMap(ctx),
+			"k8_cronjob":                             tableKubernetesCronJob(ctx),
+			"k8_csi_driver":                          tableKubernetesCSIDriver(ctx),
+			"k8_csi_node":                            tableKubernetesCSINode(ctx),
+			"k8_custom_resource":                     tableKubernetesCustomResource(ctx),
+			"k8_custom_resource_definition":          tableKubernetesCustomResourceDefinition(ctx),
+			"k8_daemonset":                           tableKubernetesDaemonset(ctx),
+			"k8_deployment":                          tableKubernetesDeployment(ctx),
+			"k8_endpoint_slice":                      tableKubernetesEndpointSlice(ctx),
+			"k8_endpoints":                           tableKubernetesEndpoints(ctx),
+			"k8_event":                               tableKubernetesEvent(ctx),
+			"k8_flow_schema":                         tableKubernetesFlowSchema(ctx),
+			"k8_flux_git_repository":                 tableKubernetesFluxGitRepository(ctx),
+			"k8_flux_helm_release":                   tableKubernetesFluxHelmRelease(ctx),
+			"k8_flux_helm_repository":                tableKubernetesFluxHelmRepository(ctx),
+			"k8_flux_kustomization":                  tableKubernetesFluxKustomization(ctx),
+			"k8_flux_oci_repository":                 tableKubernetesFluxOCIRepository(ctx),
+			"k8_gateway":                             tableKubernetesGateway(ctx),
+			"k8_gateway_class":                       tableKubernetesGatewayClass(ctx),
+			"k8_grpc_route":                          tableKubernetesGRPCRoute(ctx),
+			"k8_helm_release":                        tableKubernetesHelmRelease(ctx),
+			"k8_horizontal_pod_autoscaler":           tableKubernetesHorizontalPodAutoscaler(ctx),
+			"k8_http_route":                          tableKubernetesHTTPRoute(ctx),
+			"k8_ingress":                             tableKubernetesIngress(ctx),
+			"k8_ingress_class":                       tableKubernetesIngressClass(ctx),
+			"k8_issuer":                              tableKubernetesIssuer(ctx),
+			"k8_job":                                 tableKubernetesJob(ctx),
+			"k8_lease":                               tableKubernetesLease(ctx),
+			"k8_limit_range":                         tableKubernetesLimitRange(ctx),
+			"k8_mutating_webhook_configuration":      tableKubernetesMutatingWebhookConfiguration(ctx),
+			"k8_namespace":                           tableKubernetesNamespace(ctx),
+			"k8_network_policy":                      tableKubernetesNetworkPolicy(ctx),
+			"k8_node":                                tableKubernetesNode(ctx),
+			"k8_node_metric":                         tableKubernetesNodeMetric(ctx),
+			"k8_persistent_volume_claim":             tableKubernetesPersistentVolumeClaim(ctx),
+			"k8_persistent_volume":                   tableKubernetesPersistentVolume(ctx),
+			"k8_pod":                                 tableKubernetesPod(ctx),
+			"k8_pod_disruption_budget":               tableKubernetesPDB(ctx),
+			"k8_pod_metric":                          tableKubernetesPodMetric(ctx),
+			"k8_pod_monitor":                         tableKubernetesPodMonitor(ctx),
+			"k8_pod_template":                        tableKubernetesPodTemplate(ctx),
+			"k8_priority_class":                      tableKubernetesPriorityClass(ctx),
+			"k8_priority_level_configuration":        tableKubernetesPriorityLevelConfiguration(ctx),
+			"k8_prometheus":                          tableKubernetesPrometheus(ctx),
+			"k8_prometheus_rule":                     tableKubernetesPrometheusRule(ctx),
+			"k8_reference_grant":                     tableKubernetesReferenceGrant(ctx),
+			"k8_replicaset":                          tableKubernetesReplicaSet(ctx),
+			"k8_replication_controller":              tableKubernetesReplicaController(ctx),
+			"k8_resource_quota":                      tableKubernetesResourceQuota(ctx),
+			"k8_role":                                tableKubernetesRole(ctx),
+			"k8_role_binding":                        tableKubernetesRoleBinding(ctx),
+			"k8_runtime_class":                       tableKubernetesRuntimeClass(ctx),
+			"k8_secret":                              tableKubernetesSecret(ctx),
+			"k8_service":                             tableKubernetesService(ctx),
+			"k8_service_account":                     tableKubernetesServiceAccount(ctx),
+			"k8_service_monitor":                     tableKubernetesServiceMonitor(ctx),
+			"k8_stateful_set":                        tableKubernetesStatefulSet(ctx),
+			"k8_storage_class":                       tableKubernetesStorageClass(ctx),
+			"k8_validating_admission_policy":         tableKubernetesValidatingAdmissionPolicy(ctx),
+			"k8_validating_admission_policy_binding": tableKubernetesValidatingAdmissionPolicyBinding(ctx),
+			"k8_validating_webhook_configuration":    tableKubernetesValidatingWebhookConfiguration(ctx),
+			"k8_vertical_pod_autoscaler":             tableKubernetesVerticalPodAutoscaler(ctx),
+			"k8_volume_attachment":                   tableKubernetesVolumeAttachment(ctx),
+			"k8_volume_snapshot":                     tableKubernetesVolumeSnapshot(ctx),
+			"k8_volume_snapshot_class":               tableKubernetesVolumeSnapshotClass(ctx),
+			"k8_volume_snapshot_content":             tableKubernetesVolumeSnapshotContent(ctx),
 		},
 	}
 	for key, table := range p.TableMap {
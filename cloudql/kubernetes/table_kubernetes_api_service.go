@@ -0,0 +1,130 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesAPIService(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_api_service",
+		Description: "APIService registers a group version of the Kubernetes API and the service serving it, aggregated APIs such as metrics.k8s.io are served by a service of the cluster.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesAPIService,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesAPIService,
+		},
+		// APIService, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "group",
+				Type:        proto.ColumnType_STRING,
+				Description: "API group served by the APIService.",
+				Transform:   transform.FromField("Description.APIService.Spec.Group"),
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "API version served by the APIService.",
+				Transform:   transform.FromField("Description.APIService.Spec.Version"),
+			},
+			{
+				Name:        "service",
+				Type:        proto.ColumnType_JSON,
+				Description: "Service serving the API, null if the API is served by the kube-apiserver itself.",
+				Transform:   transform.FromField("Description.APIService.Spec.Service"),
+			},
+			{
+				Name:        "service_namespace",
+				Type:        proto.ColumnType_STRING,
+				Description: "Namespace of the service serving the API.",
+				Transform:   transform.FromField("Description.APIService.Spec.Service.Namespace"),
+			},
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the service serving the API.",
+				Transform:   transform.FromField("Description.APIService.Spec.Service.Name"),
+			},
+			{
+				Name:        "group_priority_minimum",
+				Type:        proto.ColumnType_INT,
+				Description: "Minimum priority of the group, groups with a higher priority are preferred by clients.",
+				Transform:   transform.FromField("Description.APIService.Spec.GroupPriorityMinimum"),
+			},
+			{
+				Name:        "version_priority",
+				Type:        proto.ColumnType_INT,
+				Description: "Priority of the version within its group.",
+				Transform:   transform.FromField("Description.APIService.Spec.VersionPriority"),
+			},
+			{
+				Name:        "insecure_skip_tls_verify",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the TLS certificate of the service is not verified.",
+				Transform:   transform.FromField("Description.APIService.Spec.InsecureSkipTLSVerify"),
+			},
+			{
+				Name:        "has_ca_bundle",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if a CA bundle is set to verify the TLS certificate of the service.",
+				Transform:   transform.FromField("Description.APIService.Spec.HasCABundle"),
+			},
+			{
+				Name:        "available",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Available condition of the APIService is True, i.e. the API can be discovered and served.",
+				Transform:   transform.FromField("Description.Available"),
+			},
+			{
+				Name:        "available_reason",
+				Type:        proto.ColumnType_STRING,
+				Description: "Reason of the Available condition, e.g. MissingEndpoints or FailedDiscoveryCheck.",
+				Transform:   transform.FromField("Description.AvailableCondition.Reason"),
+			},
+			{
+				Name:        "available_message",
+				Type:        proto.ColumnType_STRING,
+				Description: "Message of the Available condition.",
+				Transform:   transform.FromField("Description.AvailableCondition.Message"),
+			},
+			{
+				Name:        "available_last_transition_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last time the Available condition changed.",
+				Transform:   transform.FromField("Description.AvailableCondition.LastTransitionTime"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the APIService.",
+				Transform:   transform.FromField("Description.APIService.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformAPIServiceTags),
+			},
+		}),
+	}
+}
+
+func transformAPIServiceTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesAPIService).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
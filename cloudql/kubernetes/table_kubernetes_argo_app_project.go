@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesArgoAppProject(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_argo_app_project",
+		Description: "ArgoAppProject is an Argo CD AppProject, restricting the sources, destinations and kinds of the applications it groups.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesArgoAppProject,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesArgoAppProject,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the project.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.Description"),
+			},
+			{
+				Name:        "source_repos",
+				Type:        proto.ColumnType_JSON,
+				Description: "Repositories the applications may be rendered from, * allows any.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.SourceRepos"),
+			},
+			{
+				Name:        "source_namespaces",
+				Type:        proto.ColumnType_JSON,
+				Description: "Namespaces the applications of the project may be created in, besides the Argo CD namespace.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.SourceNamespaces"),
+			},
+			{
+				Name:        "destinations",
+				Type:        proto.ColumnType_JSON,
+				Description: "Clusters and namespaces the applications may be deployed to.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.Destinations"),
+			},
+			{
+				Name:        "cluster_resource_whitelist",
+				Type:        proto.ColumnType_JSON,
+				Description: "Cluster-scoped kinds the applications may deploy.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.ClusterResourceWhitelist"),
+			},
+			{
+				Name:        "cluster_resource_blacklist",
+				Type:        proto.ColumnType_JSON,
+				Description: "Cluster-scoped kinds the applications may not deploy.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.ClusterResourceBlacklist"),
+			},
+			{
+				Name:        "namespace_resource_whitelist",
+				Type:        proto.ColumnType_JSON,
+				Description: "Namespaced kinds the applications may deploy, all if empty.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.NamespaceResourceWhitelist"),
+			},
+			{
+				Name:        "namespace_resource_blacklist",
+				Type:        proto.ColumnType_JSON,
+				Description: "Namespaced kinds the applications may not deploy.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.NamespaceResourceBlacklist"),
+			},
+			{
+				Name:        "roles",
+				Type:        proto.ColumnType_JSON,
+				Description: "Project roles, with their policies and groups.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.Roles"),
+			},
+			{
+				Name:        "sync_windows",
+				Type:        proto.ColumnType_JSON,
+				Description: "Time windows the applications may or may not be synced in.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.SyncWindows"),
+			},
+			{
+				Name:        "orphaned_resources",
+				Type:        proto.ColumnType_JSON,
+				Description: "Orphaned resources monitoring settings, null if disabled.",
+				Transform:   transform.FromField("Description.ArgoAppProject.Spec.OrphanedResources"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformArgoAppProjectTags),
+			},
+		}),
+	}
+}
+
+func transformArgoAppProjectTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesArgoAppProject).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,189 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesArgoApplication(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_argo_application",
+		Description: "ArgoApplication is an Argo CD Application, a set of manifests rendered from a source repository and synced to a destination cluster and namespace.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesArgoApplication,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesArgoApplication,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project",
+				Type:        proto.ColumnType_STRING,
+				Description: "Argo CD project of the application.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Project"),
+			},
+			{
+				Name:        "source_repo_url",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the repository the manifests are rendered from, null for multi-source applications.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Source.RepoURL"),
+			},
+			{
+				Name:        "source_path",
+				Type:        proto.ColumnType_STRING,
+				Description: "Path of the manifests in the repository.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Source.Path"),
+			},
+			{
+				Name:        "source_chart",
+				Type:        proto.ColumnType_STRING,
+				Description: "Helm chart rendered, if the repository is a Helm repository.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Source.Chart"),
+			},
+			{
+				Name:        "source_target_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision (branch, tag, commit or chart version) the application tracks.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Source.TargetRevision"),
+			},
+			{
+				Name:        "sources",
+				Type:        proto.ColumnType_JSON,
+				Description: "Sources of multi-source applications.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Sources"),
+			},
+			{
+				Name:        "destination_server",
+				Type:        proto.ColumnType_STRING,
+				Description: "API server URL of the destination cluster.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Destination.Server"),
+			},
+			{
+				Name:        "destination_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the destination cluster, if it isn't referenced by its API server URL.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Destination.Name"),
+			},
+			{
+				Name:        "destination_namespace",
+				Type:        proto.ColumnType_STRING,
+				Description: "Namespace the namespaced resources are deployed to by default.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.Destination.Namespace"),
+			},
+			{
+				Name:        "sync_policy",
+				Type:        proto.ColumnType_JSON,
+				Description: "Sync policy of the application, with its automated sync settings and sync options.",
+				Transform:   transform.FromField("Description.ArgoApplication.Spec.SyncPolicy"),
+			},
+			{
+				Name:        "sync_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Sync status of the application: Synced, OutOfSync or Unknown.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Sync.Status"),
+			},
+			{
+				Name:        "sync_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision the live state was last compared to.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Sync.Revision"),
+			},
+			{
+				Name:        "health_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Health status of the application: Healthy, Progressing, Degraded, Suspended, Missing or Unknown.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Health.Status"),
+			},
+			{
+				Name:        "health_message",
+				Type:        proto.ColumnType_STRING,
+				Description: "Message explaining the health status.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Health.Message"),
+			},
+			{
+				Name:        "operation_phase",
+				Type:        proto.ColumnType_STRING,
+				Description: "Phase of the last sync operation: Running, Terminating, Failed, Error or Succeeded.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.OperationState.Phase"),
+			},
+			{
+				Name:        "operation_message",
+				Type:        proto.ColumnType_STRING,
+				Description: "Message of the last sync operation.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.OperationState.Message"),
+			},
+			{
+				Name:        "operation_started_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Start time of the last sync operation.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.OperationState.StartedAt"),
+			},
+			{
+				Name:        "operation_finished_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Completion time of the last sync operation.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.OperationState.FinishedAt"),
+			},
+			{
+				Name:        "operation_state",
+				Type:        proto.ColumnType_JSON,
+				Description: "State of the last sync operation, with the revision it synced.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.OperationState"),
+			},
+			{
+				Name:        "resources",
+				Type:        proto.ColumnType_JSON,
+				Description: "Resources managed by the application, with their sync and health status.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Resources"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Errors and warnings of the application, e.g. ComparisonError or OrphanedResourceWarning.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Conditions"),
+			},
+			{
+				Name:        "images",
+				Type:        proto.ColumnType_JSON,
+				Description: "Container images used by the managed resources.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.Summary.Images"),
+			},
+			{
+				Name:        "source_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the source: Helm, Kustomize, Directory or Plugin.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.SourceType"),
+			},
+			{
+				Name:        "reconciled_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last time the application state was reconciled.",
+				Transform:   transform.FromField("Description.ArgoApplication.Status.ReconciledAt"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformArgoApplicationTags),
+			},
+		}),
+	}
+}
+
+func transformArgoApplicationTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesArgoApplication).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,189 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesCertificate(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_certificate",
+		Description: "Certificate is a cert-manager certificate kept issued and renewed in a secret of its namespace.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesCertificate,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesCertificate,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "secret_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the secret the certificate and its private key are stored in.",
+				Transform:   transform.FromField("Description.Certificate.Spec.SecretName"),
+			},
+			{
+				Name:        "common_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Common name of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.CommonName"),
+			},
+			{
+				Name:        "dns_names",
+				Type:        proto.ColumnType_JSON,
+				Description: "DNS subject alternative names of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.DNSNames"),
+			},
+			{
+				Name:        "ip_addresses",
+				Type:        proto.ColumnType_JSON,
+				Description: "IP address subject alternative names of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.IPAddresses"),
+			},
+			{
+				Name:        "uris",
+				Type:        proto.ColumnType_JSON,
+				Description: "URI subject alternative names of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.URIs"),
+			},
+			{
+				Name:        "email_addresses",
+				Type:        proto.ColumnType_JSON,
+				Description: "Email subject alternative names of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.EmailAddresses"),
+			},
+			{
+				Name:        "issuer_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Issuer or ClusterIssuer signing the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.IssuerRef"),
+			},
+			{
+				Name:        "issuer_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the issuer signing the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.IssuerRef.Name"),
+			},
+			{
+				Name:        "issuer_kind",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kind of the issuer signing the certificate, Issuer (the default) or ClusterIssuer.",
+				Transform:   transform.FromField("Description.Certificate.Spec.IssuerRef.Kind"),
+			},
+			{
+				Name:        "duration",
+				Type:        proto.ColumnType_STRING,
+				Description: "Requested validity duration of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.Duration"),
+			},
+			{
+				Name:        "renew_before",
+				Type:        proto.ColumnType_STRING,
+				Description: "How long before its expiry the certificate is renewed.",
+				Transform:   transform.FromField("Description.Certificate.Spec.RenewBefore"),
+			},
+			{
+				Name:        "is_ca",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the certificate is a CA certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.IsCA"),
+			},
+			{
+				Name:        "usages",
+				Type:        proto.ColumnType_JSON,
+				Description: "Key usages of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Spec.Usages"),
+			},
+			{
+				Name:        "private_key",
+				Type:        proto.ColumnType_JSON,
+				Description: "Algorithm, size, encoding and rotation policy of the private key.",
+				Transform:   transform.FromField("Description.Certificate.Spec.PrivateKey"),
+			},
+			{
+				Name:        "not_before",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Start of the validity period of the current certificate.",
+				Transform:   transform.FromField("Description.Certificate.Status.NotBefore"),
+			},
+			{
+				Name:        "not_after",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Expiry time of the current certificate.",
+				Transform:   transform.FromField("Description.Certificate.Status.NotAfter"),
+			},
+			{
+				Name:        "renewal_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time cert-manager will renew the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Status.RenewalTime"),
+			},
+			{
+				Name:        "revision",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of times the certificate was issued.",
+				Transform:   transform.FromField("Description.Certificate.Status.Revision"),
+			},
+			{
+				Name:        "last_failure_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last time the issuance of the certificate failed.",
+				Transform:   transform.FromField("Description.Certificate.Status.LastFailureTime"),
+			},
+			{
+				Name:        "failed_issuance_attempts",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of consecutive failed issuances of the certificate.",
+				Transform:   transform.FromField("Description.Certificate.Status.FailedIssuanceAttempts"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the certificate is True, i.e. it is issued, up to date and valid.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_reason",
+				Type:        proto.ColumnType_STRING,
+				Description: "Reason of the Ready condition.",
+				Transform:   transform.FromField("Description.ReadyCondition.Reason"),
+			},
+			{
+				Name:        "ready_message",
+				Type:        proto.ColumnType_STRING,
+				Description: "Message of the Ready condition.",
+				Transform:   transform.FromField("Description.ReadyCondition.Message"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the certificate, e.g. Ready and Issuing.",
+				Transform:   transform.FromField("Description.Certificate.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformCertificateTags),
+			},
+		}),
+	}
+}
+
+func transformCertificateTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesCertificate).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
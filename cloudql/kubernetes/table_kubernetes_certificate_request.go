@@ -0,0 +1,135 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesCertificateRequest(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_certificate_request",
+		Description: "CertificateRequest is a cert-manager request for a certificate signed by an issuer, usually created for a Certificate. The request itself is not stored.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesCertificateRequest,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesCertificateRequest,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "issuer_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Issuer or ClusterIssuer the request is addressed to.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.IssuerRef"),
+			},
+			{
+				Name:        "issuer_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the issuer the request is addressed to.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.IssuerRef.Name"),
+			},
+			{
+				Name:        "issuer_kind",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kind of the issuer the request is addressed to, Issuer (the default) or ClusterIssuer.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.IssuerRef.Kind"),
+			},
+			{
+				Name:        "duration",
+				Type:        proto.ColumnType_STRING,
+				Description: "Requested validity duration of the certificate.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.Duration"),
+			},
+			{
+				Name:        "is_ca",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if a CA certificate is requested.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.IsCA"),
+			},
+			{
+				Name:        "usages",
+				Type:        proto.ColumnType_JSON,
+				Description: "Requested key usages of the certificate.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.Usages"),
+			},
+			{
+				Name:        "username",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the user that created the request.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.Username"),
+			},
+			{
+				Name:        "groups",
+				Type:        proto.ColumnType_JSON,
+				Description: "Groups of the user that created the request.",
+				Transform:   transform.FromField("Description.CertificateRequest.Spec.Groups"),
+			},
+			{
+				Name:        "failure_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the request failed.",
+				Transform:   transform.FromField("Description.CertificateRequest.Status.FailureTime"),
+			},
+			{
+				Name:        "certificate",
+				Type:        proto.ColumnType_JSON,
+				Description: "Subject, issuer, validity and subject alternative names of the issued certificate.",
+				Transform:   transform.FromField("Description.CertificateRequest.Status.IssuedCertificate"),
+			},
+			{
+				Name:        "certificate_not_after",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Expiry time of the issued certificate.",
+				Transform:   transform.FromField("Description.CertificateRequest.Status.IssuedCertificate.NotAfter"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the request is True, i.e. the certificate is issued.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_reason",
+				Type:        proto.ColumnType_STRING,
+				Description: "Reason of the Ready condition, e.g. Pending, Failed or Denied.",
+				Transform:   transform.FromField("Description.ReadyCondition.Reason"),
+			},
+			{
+				Name:        "ready_message",
+				Type:        proto.ColumnType_STRING,
+				Description: "Message of the Ready condition.",
+				Transform:   transform.FromField("Description.ReadyCondition.Message"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the request, e.g. Ready, Approved and Denied.",
+				Transform:   transform.FromField("Description.CertificateRequest.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformCertificateRequestTags),
+			},
+		}),
+	}
+}
+
+func transformCertificateRequestTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesCertificateRequest).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesCertificateSigningRequest(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_certificate_signing_request",
+		Description: "CertificateSigningRequest is a request for an X.509 certificate signed by one of the signers of the cluster, the request itself is not stored.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesCertificateSigningRequest,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesCertificateSigningRequest,
+		},
+		// CertificateSigningRequest, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "signer_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the signer the request is addressed to, e.g. kubernetes.io/kube-apiserver-client.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.SignerName"),
+			},
+			{
+				Name:        "username",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the user that created the request.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.Username"),
+			},
+			{
+				Name:        "user_uid",
+				Type:        proto.ColumnType_STRING,
+				Description: "UID of the user that created the request.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.UID"),
+			},
+			{
+				Name:        "groups",
+				Type:        proto.ColumnType_JSON,
+				Description: "Groups of the user that created the request.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.Groups"),
+			},
+			{
+				Name:        "extra",
+				Type:        proto.ColumnType_JSON,
+				Description: "Extra attributes of the user that created the request.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.Extra"),
+			},
+			{
+				Name:        "usages",
+				Type:        proto.ColumnType_JSON,
+				Description: "Key usages requested for the certificate, e.g. client auth.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.Usages"),
+			},
+			{
+				Name:        "expiration_seconds",
+				Type:        proto.ColumnType_INT,
+				Description: "Requested validity duration of the certificate.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Spec.ExpirationSeconds"),
+			},
+			{
+				Name:        "approval_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Approved, Denied or Failed once the matching condition is set, Pending otherwise.",
+				Transform:   transform.FromField("Description.ApprovalStatus"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Approval conditions of the request, with the reason and message of the approver.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Status.Conditions"),
+			},
+			{
+				Name:        "certificate",
+				Type:        proto.ColumnType_JSON,
+				Description: "Subject, issuer, validity and subject alternative names of the issued certificate.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Status.Certificate"),
+			},
+			{
+				Name:        "certificate_not_before",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Start of the validity period of the issued certificate.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Status.Certificate.NotBefore"),
+			},
+			{
+				Name:        "certificate_not_after",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Expiry time of the issued certificate.",
+				Transform:   transform.FromField("Description.CertificateSigningRequest.Status.Certificate.NotAfter"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformCertificateSigningRequestTags),
+			},
+		}),
+	}
+}
+
+func transformCertificateSigningRequestTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesCertificateSigningRequest).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
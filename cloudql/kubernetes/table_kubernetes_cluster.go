@@ -16,6 +16,10 @@ func tableKubernetesCluster(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesCluster,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("context_name"),
+			Hydrate:    opengovernance.GetKubernetesCluster,
+		},
 		Columns: commonGeneralColumns([]*plugin.Column{
 			{
 				Name:        "context_name",
@@ -0,0 +1,112 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesClusterIssuer(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_cluster_issuer",
+		Description: "ClusterIssuer is a cert-manager certificate authority that signs the certificates of every namespace.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesClusterIssuer,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesClusterIssuer,
+		},
+		// ClusterIssuer, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "issuer_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kind of issuer configured: ACME, CA, Vault, SelfSigned or Venafi.",
+				Transform:   transform.FromField("Description.IssuerType"),
+			},
+			{
+				Name:        "acme",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of an ACME issuer, e.g. Let's Encrypt.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Spec.ACME"),
+			},
+			{
+				Name:        "ca",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of a CA issuer.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Spec.CA"),
+			},
+			{
+				Name:        "vault",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of a Vault issuer.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Spec.Vault"),
+			},
+			{
+				Name:        "self_signed",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of a self-signed issuer.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Spec.SelfSigned"),
+			},
+			{
+				Name:        "venafi",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of a Venafi issuer.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Spec.Venafi"),
+			},
+			{
+				Name:        "acme_status",
+				Type:        proto.ColumnType_JSON,
+				Description: "Account of an ACME issuer on the ACME server.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Status.ACME"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the issuer is True, i.e. it can sign certificates.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_reason",
+				Type:        proto.ColumnType_STRING,
+				Description: "Reason of the Ready condition.",
+				Transform:   transform.FromField("Description.ReadyCondition.Reason"),
+			},
+			{
+				Name:        "ready_message",
+				Type:        proto.ColumnType_STRING,
+				Description: "Message of the Ready condition.",
+				Transform:   transform.FromField("Description.ReadyCondition.Message"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the issuer.",
+				Transform:   transform.FromField("Description.ClusterIssuer.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformClusterIssuerTags),
+			},
+		}),
+	}
+}
+
+func transformClusterIssuerTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesClusterIssuer).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
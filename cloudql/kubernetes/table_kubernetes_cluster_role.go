@@ -16,6 +16,10 @@ func tableKubernetesClusterRole(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesClusterRole,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesClusterRole,
+		},
 		// ClusterRole, is a non-namespaced resource.
 		Columns: commonColumns([]*plugin.Column{
 			{
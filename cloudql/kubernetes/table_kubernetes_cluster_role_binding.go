@@ -16,6 +16,10 @@ func tableKubernetesClusterRoleBinding(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesClusterRoleBinding,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesClusterRoleBinding,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "subjects",
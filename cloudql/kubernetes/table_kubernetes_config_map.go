@@ -15,6 +15,10 @@ func tableKubernetesConfigMap(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesConfigMap,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesConfigMap,
+		},
 		// ClusterRole, is a non-namespaced resource.
 		Columns: commonColumns([]*plugin.Column{
 			{
@@ -15,6 +15,10 @@ func tableKubernetesCronJob(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesCronJob,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesCronJob,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			//// CronJobSpec columns
 			{
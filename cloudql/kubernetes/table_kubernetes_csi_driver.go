@@ -0,0 +1,94 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesCSIDriver(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_csi_driver",
+		Description: "CSIDriver captures information about a Container Storage Interface (CSI) volume driver deployed on the cluster.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesCSIDriver,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesCSIDriver,
+		},
+		// CSIDriver, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "attach_required",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the driver requires an attach operation (ControllerPublishVolume) before volumes are mounted.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.AttachRequired"),
+			},
+			{
+				Name:        "pod_info_on_mount",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the driver requires information about the pod during mount operations.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.PodInfoOnMount"),
+			},
+			{
+				Name:        "volume_lifecycle_modes",
+				Type:        proto.ColumnType_JSON,
+				Description: "Volume modes supported by the driver, Persistent and/or Ephemeral.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.VolumeLifecycleModes"),
+			},
+			{
+				Name:        "storage_capacity",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the scheduler considers the storage capacity reported by the driver.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.StorageCapacity"),
+			},
+			{
+				Name:        "fs_group_policy",
+				Type:        proto.ColumnType_STRING,
+				Description: "Policy applied to change the ownership and permissions of the volumes to the fsGroup of the pod.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.FSGroupPolicy"),
+			},
+			{
+				Name:        "token_requests",
+				Type:        proto.ColumnType_JSON,
+				Description: "Service account tokens passed to the driver by the kubelet when mounting volumes.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.TokenRequests"),
+			},
+			{
+				Name:        "requires_republish",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the driver requires volumes to be periodically republished.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.RequiresRepublish"),
+			},
+			{
+				Name:        "selinux_mount",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the driver supports the -o context mount option.",
+				Transform:   transform.FromField("Description.CSIDriver.Spec.SELinuxMount"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformCSIDriverTags),
+			},
+		}),
+	}
+}
+
+func transformCSIDriverTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesCSIDriver).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
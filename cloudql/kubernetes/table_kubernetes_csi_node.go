@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesCSINode(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_csi_node",
+		Description: "CSINode holds information about the CSI drivers installed on a node, it has the same name as the node.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesCSINode,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesCSINode,
+		},
+		// CSINode, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "drivers",
+				Type:        proto.ColumnType_JSON,
+				Description: "CSI drivers registered on the node, with their node ID, topology keys and maximum number of attachable volumes.",
+				Transform:   transform.FromField("Description.CSINode.Spec.Drivers"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformCSINodeTags),
+			},
+		}),
+	}
+}
+
+func transformCSINodeTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesCSINode).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -15,6 +15,10 @@ func tableKubernetesCustomResource(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesCustomResource,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace", "fully_qualified_name"}),
+			Hydrate:    opengovernance.GetKubernetesCustomResource,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "fully_qualified_name",
@@ -22,6 +26,18 @@ func tableKubernetesCustomResource(ctx context.Context) *plugin.Table {
 				Description: "The fully qualified name of the custom resource.",
 				Transform:   transform.FromField("Description.FullyQualifiedName"),
 			},
+			{
+				Name:        "spec",
+				Type:        proto.ColumnType_JSON,
+				Description: "Spec of the custom resource, as listed in the storage or preferred served version of its custom resource definition.",
+				Transform:   transform.FromField("Description.Spec"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_JSON,
+				Description: "Status of the custom resource.",
+				Transform:   transform.FromField("Description.Status"),
+			},
 			{
 				Name:        "title",
 				Type:        proto.ColumnType_STRING,
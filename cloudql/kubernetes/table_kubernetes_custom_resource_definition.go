@@ -15,6 +15,10 @@ func tableKubernetesCustomResourceDefinition(ctx context.Context) *plugin.Table
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesCustomResourceDefinition,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesCustomResourceDefinition,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "spec",
@@ -15,6 +15,10 @@ func tableKubernetesDaemonset(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesDaemonSet,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesDaemonSet,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "min_ready_seconds",
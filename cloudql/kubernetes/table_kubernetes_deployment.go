@@ -15,6 +15,10 @@ func tableKubernetesDeployment(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesDeployment,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesDeployment,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			//// Spec Columns
 			{
@@ -15,6 +15,10 @@ func tableKubernetesEndpointSlice(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesEndpointSlice,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesEndpointSlice,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "address_type",
@@ -15,6 +15,10 @@ func tableKubernetesEndpoints(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesEndpoint,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesEndpoint,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "subsets",
@@ -15,6 +15,10 @@ func tableKubernetesEvent(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesEvent,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesEvent,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "last_timestamp",
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesFlowSchema(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_flow_schema",
+		Description: "FlowSchema classifies the requests to the API server into flows and assigns them to a priority level (API Priority and Fairness).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesFlowSchema,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesFlowSchema,
+		},
+		// FlowSchema, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "priority_level_configuration",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the PriorityLevelConfiguration the matching requests are assigned to.",
+				Transform:   transform.FromField("Description.FlowSchema.Spec.PriorityLevelConfiguration"),
+			},
+			{
+				Name:        "matching_precedence",
+				Type:        proto.ColumnType_INT,
+				Description: "Precedence of the schema, the matching schema with the lowest precedence applies to a request.",
+				Transform:   transform.FromField("Description.FlowSchema.Spec.MatchingPrecedence"),
+			},
+			{
+				Name:        "distinguisher_method",
+				Type:        proto.ColumnType_STRING,
+				Description: "How the matching requests are split into flows, ByUser or ByNamespace. Null if all of them are one flow.",
+				Transform:   transform.FromField("Description.FlowSchema.Spec.DistinguisherMethod"),
+			},
+			{
+				Name:        "rules",
+				Type:        proto.ColumnType_JSON,
+				Description: "Rules of the schema, each with the subjects and the resource and non-resource requests it matches.",
+				Transform:   transform.FromField("Description.FlowSchema.Spec.Rules"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the schema, e.g. Dangling if its priority level doesn't exist.",
+				Transform:   transform.FromField("Description.FlowSchema.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformFlowSchemaTags),
+			},
+		}),
+	}
+}
+
+func transformFlowSchemaTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesFlowSchema).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesFluxGitRepository(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_flux_git_repository",
+		Description: "FluxGitRepository is a Flux GitRepository, a Git repository fetched by the Flux source controller.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesFluxGitRepository,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesFluxGitRepository,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the repository.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Spec.URL"),
+			},
+			{
+				Name:        "reference",
+				Type:        proto.ColumnType_JSON,
+				Description: "Branch, tag, semver range, ref name or commit checked out, the default branch if null.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Spec.Reference"),
+			},
+			{
+				Name:        "secret_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Secret holding the credentials of the repository.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Spec.SecretRef"),
+			},
+			{
+				Name:        "provider",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provider used to authenticate, e.g. generic, azure or github.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Spec.Provider"),
+			},
+			{
+				Name:        "interval",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interval the repository is fetched at.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Spec.Interval"),
+			},
+			{
+				Name:        "suspend",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the reconciliation of the repository is suspended.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Spec.Suspend"),
+			},
+			{
+				Name:        "artifact_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision of the last artifact fetched, e.g. main@sha1:<commit>.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Status.Artifact.Revision"),
+			},
+			{
+				Name:        "artifact",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last artifact fetched, with its revision, digest and update time.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Status.Artifact"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the repository is True.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_condition",
+				Type:        proto.ColumnType_JSON,
+				Description: "Ready condition of the repository, with its reason and message.",
+				Transform:   transform.FromField("Description.ReadyCondition"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the repository, e.g. Ready and ArtifactInStorage.",
+				Transform:   transform.FromField("Description.FluxGitRepository.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformFluxGitRepositoryTags),
+			},
+		}),
+	}
+}
+
+func transformFluxGitRepositoryTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesFluxGitRepository).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,147 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesFluxHelmRelease(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_flux_helm_release",
+		Description: "FluxHelmRelease is a Flux HelmRelease, a Helm chart released and upgraded by the Flux helm controller.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesFluxHelmRelease,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesFluxHelmRelease,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "chart",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the chart, null if the chart is referenced with chart_ref.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.Chart.Spec.Chart"),
+			},
+			{
+				Name:        "chart_version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version or semver range of the chart.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.Chart.Spec.Version"),
+			},
+			{
+				Name:        "source_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Source (HelmRepository, GitRepository or Bucket) the chart is fetched from.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.Chart.Spec.SourceRef"),
+			},
+			{
+				Name:        "chart_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "OCIRepository or HelmChart the chart is fetched from, if the chart isn't defined with chart.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.ChartRef"),
+			},
+			{
+				Name:        "release_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the Helm release, <target namespace>-<name> if empty.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.ReleaseName"),
+			},
+			{
+				Name:        "target_namespace",
+				Type:        proto.ColumnType_STRING,
+				Description: "Namespace the chart is released to, the namespace of the HelmRelease if empty.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.TargetNamespace"),
+			},
+			{
+				Name:        "interval",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interval the release is reconciled at.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.Interval"),
+			},
+			{
+				Name:        "suspend",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the reconciliation of the release is suspended.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.Suspend"),
+			},
+			{
+				Name:        "service_account_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service account impersonated to release the chart.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.ServiceAccountName"),
+			},
+			{
+				Name:        "depends_on",
+				Type:        proto.ColumnType_JSON,
+				Description: "HelmReleases that must be ready before this one is released.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Spec.DependsOn"),
+			},
+			{
+				Name:        "last_applied_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of the chart last released successfully.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Status.LastAppliedRevision"),
+			},
+			{
+				Name:        "last_attempted_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of the chart of the last release attempt.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Status.LastAttemptedRevision"),
+			},
+			{
+				Name:        "history",
+				Type:        proto.ColumnType_JSON,
+				Description: "Latest releases, with their chart version, app version and status.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Status.History"),
+			},
+			{
+				Name:        "failures",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of reconciliation failures since the last success.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Status.Failures"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the release is True.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_condition",
+				Type:        proto.ColumnType_JSON,
+				Description: "Ready condition of the release, with its reason and message.",
+				Transform:   transform.FromField("Description.ReadyCondition"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the release, e.g. Ready, Released and TestSuccess.",
+				Transform:   transform.FromField("Description.FluxHelmRelease.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformFluxHelmReleaseTags),
+			},
+		}),
+	}
+}
+
+func transformFluxHelmReleaseTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesFluxHelmRelease).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,117 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesFluxHelmRepository(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_flux_helm_repository",
+		Description: "FluxHelmRepository is a Flux HelmRepository, a Helm chart repository indexed by the Flux source controller.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesFluxHelmRepository,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesFluxHelmRepository,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the repository.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.URL"),
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the repository: default for HTTP/S repositories or oci.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.Type"),
+			},
+			{
+				Name:        "secret_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Secret holding the credentials of the repository.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.SecretRef"),
+			},
+			{
+				Name:        "provider",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provider used to authenticate to OCI repositories, e.g. generic, aws or azure.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.Provider"),
+			},
+			{
+				Name:        "pass_credentials",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the credentials are also passed to the hosts of the charts.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.PassCredentials"),
+			},
+			{
+				Name:        "interval",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interval the repository is indexed at.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.Interval"),
+			},
+			{
+				Name:        "suspend",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the reconciliation of the repository is suspended.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Spec.Suspend"),
+			},
+			{
+				Name:        "artifact_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision of the last index fetched.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Status.Artifact.Revision"),
+			},
+			{
+				Name:        "artifact",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last index fetched, with its revision, digest and update time.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Status.Artifact"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the repository is True.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_condition",
+				Type:        proto.ColumnType_JSON,
+				Description: "Ready condition of the repository, with its reason and message.",
+				Transform:   transform.FromField("Description.ReadyCondition"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the repository, e.g. Ready and ArtifactInStorage.",
+				Transform:   transform.FromField("Description.FluxHelmRepository.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformFluxHelmRepositoryTags),
+			},
+		}),
+	}
+}
+
+func transformFluxHelmRepositoryTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesFluxHelmRepository).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
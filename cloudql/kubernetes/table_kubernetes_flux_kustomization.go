@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesFluxKustomization(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_flux_kustomization",
+		Description: "FluxKustomization is a Flux Kustomization, a set of manifests built with kustomize from a source and applied to the cluster.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesFluxKustomization,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesFluxKustomization,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "source_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Source (GitRepository, OCIRepository or Bucket) the manifests are built from.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.SourceRef"),
+			},
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "Path of the manifests in the source.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.Path"),
+			},
+			{
+				Name:        "interval",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interval the kustomization is reconciled at.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.Interval"),
+			},
+			{
+				Name:        "retry_interval",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interval a failed reconciliation is retried at.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.RetryInterval"),
+			},
+			{
+				Name:        "suspend",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the reconciliation of the kustomization is suspended.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.Suspend"),
+			},
+			{
+				Name:        "prune",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the objects removed from the source are deleted from the cluster.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.Prune"),
+			},
+			{
+				Name:        "target_namespace",
+				Type:        proto.ColumnType_STRING,
+				Description: "Namespace the namespaced objects are applied to, if set.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.TargetNamespace"),
+			},
+			{
+				Name:        "service_account_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service account impersonated to apply the objects.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.ServiceAccountName"),
+			},
+			{
+				Name:        "depends_on",
+				Type:        proto.ColumnType_JSON,
+				Description: "Kustomizations that must be ready before this one is applied.",
+				Transform:   transform.FromField("Description.FluxKustomization.Spec.DependsOn"),
+			},
+			{
+				Name:        "last_applied_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision of the source last applied successfully.",
+				Transform:   transform.FromField("Description.FluxKustomization.Status.LastAppliedRevision"),
+			},
+			{
+				Name:        "last_attempted_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision of the source of the last reconciliation attempt.",
+				Transform:   transform.FromField("Description.FluxKustomization.Status.LastAttemptedRevision"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the kustomization is True.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_condition",
+				Type:        proto.ColumnType_JSON,
+				Description: "Ready condition of the kustomization, with its reason and message.",
+				Transform:   transform.FromField("Description.ReadyCondition"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the kustomization, e.g. Ready and Healthy.",
+				Transform:   transform.FromField("Description.FluxKustomization.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformFluxKustomizationTags),
+			},
+		}),
+	}
+}
+
+func transformFluxKustomizationTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesFluxKustomization).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
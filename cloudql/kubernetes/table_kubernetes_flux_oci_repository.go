@@ -0,0 +1,117 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesFluxOCIRepository(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_flux_oci_repository",
+		Description: "FluxOCIRepository is a Flux OCIRepository, an OCI artifact pulled by the Flux source controller.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesFluxOCIRepository,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesFluxOCIRepository,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the OCI repository, e.g. oci://ghcr.io/org/manifests.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.URL"),
+			},
+			{
+				Name:        "reference",
+				Type:        proto.ColumnType_JSON,
+				Description: "Digest, semver range or tag pulled, the latest tag if null.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.Reference"),
+			},
+			{
+				Name:        "secret_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Secret holding the credentials of the registry.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.SecretRef"),
+			},
+			{
+				Name:        "provider",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provider used to authenticate, e.g. generic, aws, azure or gcp.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.Provider"),
+			},
+			{
+				Name:        "insecure",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the registry is reached over plain HTTP.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.Insecure"),
+			},
+			{
+				Name:        "interval",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interval the repository is pulled at.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.Interval"),
+			},
+			{
+				Name:        "suspend",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the reconciliation of the repository is suspended.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Spec.Suspend"),
+			},
+			{
+				Name:        "artifact_revision",
+				Type:        proto.ColumnType_STRING,
+				Description: "Revision of the last artifact pulled, e.g. latest@sha256:<digest>.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Status.Artifact.Revision"),
+			},
+			{
+				Name:        "artifact",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last artifact pulled, with its revision, digest and update time.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Status.Artifact"),
+			},
+			{
+				Name:        "ready",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the Ready condition of the repository is True.",
+				Transform:   transform.FromField("Description.Ready"),
+			},
+			{
+				Name:        "ready_condition",
+				Type:        proto.ColumnType_JSON,
+				Description: "Ready condition of the repository, with its reason and message.",
+				Transform:   transform.FromField("Description.ReadyCondition"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the repository, e.g. Ready and ArtifactInStorage.",
+				Transform:   transform.FromField("Description.FluxOCIRepository.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformFluxOCIRepositoryTags),
+			},
+		}),
+	}
+}
+
+func transformFluxOCIRepositoryTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesFluxOCIRepository).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesGateway(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_gateway",
+		Description: "Gateway represents an instance of a service-traffic handling infrastructure, e.g. a cloud load balancer (Gateway API).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesGateway,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesGateway,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "gateway_class_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the GatewayClass of the gateway.",
+				Transform:   transform.FromField("Description.Gateway.Spec.GatewayClassName"),
+			},
+			{
+				Name:        "listeners",
+				Type:        proto.ColumnType_JSON,
+				Description: "Listeners of the gateway, with their hostname, port, protocol, TLS configuration and allowed routes.",
+				Transform:   transform.FromField("Description.Gateway.Spec.Listeners"),
+			},
+			{
+				Name:        "addresses",
+				Type:        proto.ColumnType_JSON,
+				Description: "Addresses requested for the gateway.",
+				Transform:   transform.FromField("Description.Gateway.Spec.Addresses"),
+			},
+			{
+				Name:        "status_addresses",
+				Type:        proto.ColumnType_JSON,
+				Description: "Addresses assigned to the gateway.",
+				Transform:   transform.FromField("Description.Gateway.Status.Addresses"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the gateway, e.g. Accepted and Programmed.",
+				Transform:   transform.FromField("Description.Gateway.Status.Conditions"),
+			},
+			{
+				Name:        "listener_statuses",
+				Type:        proto.ColumnType_JSON,
+				Description: "Status of each listener, including the number of attached routes.",
+				Transform:   transform.FromField("Description.Gateway.Status.Listeners"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformGatewayTags),
+			},
+		}),
+	}
+}
+
+func transformGatewayTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesGateway).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
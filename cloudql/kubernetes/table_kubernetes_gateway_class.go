@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesGatewayClass(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_gateway_class",
+		Description: "GatewayClass describes a class of Gateways available to the users of the cluster (Gateway API).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesGatewayClass,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesGatewayClass,
+		},
+		// GatewayClass, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "controller_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the controller managing the Gateways of the class.",
+				Transform:   transform.FromField("Description.GatewayClass.Spec.ControllerName"),
+			},
+			{
+				Name:        "parameters_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Reference to the resource holding additional configuration of the class.",
+				Transform:   transform.FromField("Description.GatewayClass.Spec.ParametersRef"),
+			},
+			{
+				Name:        "class_description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the class.",
+				Transform:   transform.FromField("Description.GatewayClass.Spec.Description"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the class, e.g. Accepted.",
+				Transform:   transform.FromField("Description.GatewayClass.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformGatewayClassTags),
+			},
+		}),
+	}
+}
+
+func transformGatewayClassTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesGatewayClass).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,105 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesHelmRelease(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_helm_release",
+		Description: "A Helm release is an instance of a chart deployed to the cluster. Values stored under sensitive keys are redacted.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesHelmRelease,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesHelmRelease,
+		},
+		Columns: commonGeneralColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the release.",
+				Transform:   transform.FromField("Description.HelmRelease.Name"),
+			},
+			{
+				Name:        "namespace",
+				Type:        proto.ColumnType_STRING,
+				Description: "Namespace the release is deployed to.",
+				Transform:   transform.FromField("Description.HelmRelease.Namespace"),
+			},
+			{
+				Name:        "revision",
+				Type:        proto.ColumnType_INT,
+				Description: "Revision of the release.",
+				Transform:   transform.FromField("Description.HelmRelease.Revision"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the release, e.g. deployed, failed, pending-upgrade.",
+				Transform:   transform.FromField("Description.HelmRelease.Status"),
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Human-friendly description of the last operation on the release.",
+				Transform:   transform.FromField("Description.HelmRelease.Description"),
+			},
+			{
+				Name:        "first_deployed",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the release was first deployed.",
+				Transform:   transform.FromField("Description.HelmRelease.FirstDeployed").NullIfZero(),
+			},
+			{
+				Name:        "last_deployed",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the release was last deployed.",
+				Transform:   transform.FromField("Description.HelmRelease.LastDeployed").NullIfZero(),
+			},
+			{
+				Name:        "chart_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the deployed chart.",
+				Transform:   transform.FromField("Description.HelmRelease.ChartName"),
+			},
+			{
+				Name:        "chart_version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of the deployed chart.",
+				Transform:   transform.FromField("Description.HelmRelease.ChartVersion"),
+			},
+			{
+				Name:        "app_version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of the application packaged by the chart.",
+				Transform:   transform.FromField("Description.HelmRelease.AppVersion"),
+			},
+			{
+				Name:        "values",
+				Type:        proto.ColumnType_JSON,
+				Description: "User supplied values of the release, values stored under sensitive keys are redacted.",
+				Transform:   transform.FromField("Description.HelmRelease.Values"),
+			},
+			{
+				Name:        "labels",
+				Type:        proto.ColumnType_JSON,
+				Description: "Labels of the release stored by the helm storage driver.",
+				Transform:   transform.FromField("Description.HelmRelease.Labels"),
+			},
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.HelmRelease.Name"),
+			},
+		}),
+	}
+}
@@ -15,6 +15,10 @@ func tableKubernetesHorizontalPodAutoscaler(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesHorizontalPodAutoscaler,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesHorizontalPodAutoscaler,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "scale_target_ref",
@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesHTTPRoute(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_http_route",
+		Description: "HTTPRoute routes HTTP requests from a Gateway listener to backends such as Services (Gateway API).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesHTTPRoute,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesHTTPRoute,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "parent_refs",
+				Type:        proto.ColumnType_JSON,
+				Description: "Gateways (or other parents) the route attaches to.",
+				Transform:   transform.FromField("Description.HTTPRoute.Spec.ParentRefs"),
+			},
+			{
+				Name:        "hostnames",
+				Type:        proto.ColumnType_JSON,
+				Description: "Hostnames matched against the Host header of the requests.",
+				Transform:   transform.FromField("Description.HTTPRoute.Spec.Hostnames"),
+			},
+			{
+				Name:        "rules",
+				Type:        proto.ColumnType_JSON,
+				Description: "Rules of the route with their matches, filters and backends.",
+				Transform:   transform.FromField("Description.HTTPRoute.Spec.Rules"),
+			},
+			{
+				Name:        "backend_refs",
+				Type:        proto.ColumnType_JSON,
+				Description: "Backends of every rule of the route, a backend without kind or namespace is a Service in the namespace of the route.",
+				Transform:   transform.FromField("Description.BackendRefs"),
+			},
+			{
+				Name:        "parent_statuses",
+				Type:        proto.ColumnType_JSON,
+				Description: "Status of the route for each of its parents.",
+				Transform:   transform.FromField("Description.HTTPRoute.Status.Parents"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformHTTPRouteTags),
+			},
+		}),
+	}
+}
+
+func transformHTTPRouteTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesHTTPRoute).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
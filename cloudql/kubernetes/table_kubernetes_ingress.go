@@ -15,6 +15,10 @@ func tableKubernetesIngress(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesIngress,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesIngress,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "ingress_class_name",
@@ -0,0 +1,73 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesIngressClass(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_ingress_class",
+		Description: "IngressClass represents the class of an Ingress, referenced by the Ingress spec, and the controller implementing it.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesIngressClass,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesIngressClass,
+		},
+		// IngressClass, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "controller",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the controller that implements the class, e.g. k8s.io/ingress-nginx.",
+				Transform:   transform.FromField("Description.IngressClass.Spec.Controller"),
+			},
+			{
+				Name:        "parameters",
+				Type:        proto.ColumnType_JSON,
+				Description: "Reference to the resource holding additional configuration of the controller.",
+				Transform:   transform.FromField("Description.IngressClass.Spec.Parameters"),
+			},
+			{
+				Name:        "is_default",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the class is the default class of the cluster, i.e. assigned to Ingresses that don't specify one.",
+				Transform:   transform.FromField("Description.MetaObject.Annotations").Transform(isDefaultIngressClass),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformIngressClassTags),
+			},
+		}),
+	}
+}
+
+func transformIngressClassTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesIngressClass).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
+
+// isDefaultIngressClass returns true if the ingressclass.kubernetes.io/is-default-class annotation is set to true
+func isDefaultIngressClass(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	annotations, ok := d.Value.(map[string]string)
+	if !ok {
+		return false, nil
+	}
+	return annotations["ingressclass.kubernetes.io/is-default-class"] == "true", nil
+}
@@ -15,6 +15,10 @@ func tableKubernetesJob(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesJob,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesJob,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "parallelism",
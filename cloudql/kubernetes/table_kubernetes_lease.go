@@ -0,0 +1,99 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesLease(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_lease",
+		Description: "Lease is a lightweight lock, used for node heartbeats in kube-node-lease and for the leader election of control-plane components.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesLease,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesLease,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "holder_identity",
+				Type:        proto.ColumnType_STRING,
+				Description: "Identity of the current holder of the lease, e.g. the node name or the leader instance.",
+				Transform:   transform.FromField("Description.Lease.Spec.HolderIdentity"),
+			},
+			{
+				Name:        "lease_duration_seconds",
+				Type:        proto.ColumnType_INT,
+				Description: "Duration candidates wait before taking over the lease if it isn't renewed.",
+				Transform:   transform.FromField("Description.Lease.Spec.LeaseDurationSeconds"),
+			},
+			{
+				Name:        "acquire_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the lease was acquired by its current holder.",
+				Transform:   transform.FromField("Description.Lease.Spec.AcquireTime"),
+			},
+			{
+				Name:        "renew_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last time the holder renewed the lease.",
+				Transform:   transform.FromField("Description.Lease.Spec.RenewTime"),
+			},
+			{
+				Name:        "lease_transitions",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of times the lease changed holder.",
+				Transform:   transform.FromField("Description.Lease.Spec.LeaseTransitions"),
+			},
+			{
+				Name:        "strategy",
+				Type:        proto.ColumnType_STRING,
+				Description: "Strategy used to pick the holder of a coordinated leader election lease.",
+				Transform:   transform.FromField("Description.Lease.Spec.Strategy"),
+			},
+			{
+				Name:        "preferred_holder",
+				Type:        proto.ColumnType_STRING,
+				Description: "Holder the lease should move to, for coordinated leader election.",
+				Transform:   transform.FromField("Description.Lease.Spec.PreferredHolder"),
+			},
+			{
+				Name:        "expires_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the lease expires if it isn't renewed, the renew time plus the lease duration.",
+				Transform:   transform.FromField("Description.ExpiresAt"),
+			},
+			{
+				Name:        "stale",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the lease had no holder or wasn't renewed within its duration when it was described.",
+				Transform:   transform.FromField("Description.Stale"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformLeaseTags),
+			},
+		}),
+	}
+}
+
+func transformLeaseTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesLease).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
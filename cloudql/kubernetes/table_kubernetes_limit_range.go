@@ -15,6 +15,10 @@ func tableKubernetesLimitRange(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesLimitRange,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesLimitRange,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "spec_limits",
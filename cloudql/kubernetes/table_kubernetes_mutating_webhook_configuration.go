@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesMutatingWebhookConfiguration(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_mutating_webhook_configuration",
+		Description: "MutatingWebhookConfiguration describes the configuration of an admission webhook that accepts or rejects and may change objects.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesMutatingWebhookConfiguration,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesMutatingWebhookConfiguration,
+		},
+		// MutatingWebhookConfiguration, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "webhooks",
+				Type:        proto.ColumnType_JSON,
+				Description: "Webhooks of the configuration, each with its rules, failure policy, match policy, namespace and object selectors, side effects, timeout, reinvocation policy and the service or URL backing it.",
+				Transform:   transform.FromField("Description.MutatingWebhookConfiguration.Webhooks"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformMutatingWebhookConfigurationTags),
+			},
+		}),
+	}
+}
+
+func transformMutatingWebhookConfigurationTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesMutatingWebhookConfiguration).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
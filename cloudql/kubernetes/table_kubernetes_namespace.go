@@ -15,6 +15,10 @@ func tableKubernetesNamespace(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesNamespace,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesNamespace,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "spec_finalizers",
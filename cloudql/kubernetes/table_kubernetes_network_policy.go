@@ -15,6 +15,10 @@ func tableKubernetesNetworkPolicy(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesNetworkPolicy,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesNetworkPolicy,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "pod_selector",
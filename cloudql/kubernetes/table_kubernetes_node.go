@@ -16,6 +16,10 @@ func tableKubernetesNode(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesNode,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesNode,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "pod_cidr",
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesNodeMetric(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_node_metric",
+		Description: "NodeMetric is the CPU and memory usage of a node read from the metrics API (metrics.k8s.io, usually served by metrics-server) when it was described.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesNodeMetric,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesNodeMetric,
+		},
+		// NodeMetric, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "timestamp",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "End of the window the usage was collected over.",
+				Transform:   transform.FromField("Description.NodeMetric.Timestamp"),
+			},
+			{
+				Name:        "window",
+				Type:        proto.ColumnType_STRING,
+				Description: "Duration of the window the usage was collected over, e.g. 20s.",
+				Transform:   transform.FromField("Description.NodeMetric.Window"),
+			},
+			{
+				Name:        "cpu_millicores",
+				Type:        proto.ColumnType_INT,
+				Description: "CPU usage of the node in millicores.",
+				Transform:   transform.FromField("Description.NodeMetric.CPUMillicores"),
+			},
+			{
+				Name:        "memory_bytes",
+				Type:        proto.ColumnType_INT,
+				Description: "Memory usage (working set) of the node in bytes.",
+				Transform:   transform.FromField("Description.NodeMetric.MemoryBytes"),
+			},
+			{
+				Name:        "usage",
+				Type:        proto.ColumnType_JSON,
+				Description: "Usage of the node as reported by the metrics API.",
+				Transform:   transform.FromField("Description.NodeMetric.Usage"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformNodeMetricTags),
+			},
+		}),
+	}
+}
+
+func transformNodeMetricTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesNodeMetric).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
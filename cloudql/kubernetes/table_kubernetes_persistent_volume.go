@@ -16,6 +16,10 @@ func tableKubernetesPersistentVolume(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesPersistentVolume,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesPersistentVolume,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			//// PersistentVolumeSpec columns
 			{
@@ -16,6 +16,10 @@ func tableKubernetesPersistentVolumeClaim(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesPersistentVolumeClaim,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPersistentVolumeClaim,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "volume_name",
@@ -15,6 +15,10 @@ func tableKubernetesPod(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesPod,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPod,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			//// PodSpec Columns
 			{
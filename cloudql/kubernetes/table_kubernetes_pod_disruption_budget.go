@@ -15,6 +15,10 @@ func tableKubernetesPDB(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesPodDisruptionBudget,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPodDisruptionBudget,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "min_available",
@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesPodMetric(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_pod_metric",
+		Description: "PodMetric is the CPU and memory usage of a pod and its containers read from the metrics API (metrics.k8s.io, usually served by metrics-server) when it was described.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesPodMetric,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPodMetric,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "timestamp",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "End of the window the usage was collected over.",
+				Transform:   transform.FromField("Description.PodMetric.Timestamp"),
+			},
+			{
+				Name:        "window",
+				Type:        proto.ColumnType_STRING,
+				Description: "Duration of the window the usage was collected over, e.g. 20s.",
+				Transform:   transform.FromField("Description.PodMetric.Window"),
+			},
+			{
+				Name:        "cpu_millicores",
+				Type:        proto.ColumnType_INT,
+				Description: "CPU usage of all the containers of the pod in millicores.",
+				Transform:   transform.FromField("Description.PodMetric.CPUMillicores"),
+			},
+			{
+				Name:        "memory_bytes",
+				Type:        proto.ColumnType_INT,
+				Description: "Memory usage (working set) of all the containers of the pod in bytes.",
+				Transform:   transform.FromField("Description.PodMetric.MemoryBytes"),
+			},
+			{
+				Name:        "containers",
+				Type:        proto.ColumnType_JSON,
+				Description: "Usage of each container of the pod, with its Name, CPUMillicores and MemoryBytes.",
+				Transform:   transform.FromField("Description.PodMetric.Containers"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformPodMetricTags),
+			},
+		}),
+	}
+}
+
+func transformPodMetricTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesPodMetric).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
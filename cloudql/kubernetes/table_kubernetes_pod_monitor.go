@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesPodMonitor(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_pod_monitor",
+		Description: "PodMonitor declares the pods scraped by Prometheus (Prometheus Operator).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesPodMonitor,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPodMonitor,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Label selector of the monitored pods.",
+				Transform:   transform.FromField("Description.PodMonitor.Spec.Selector"),
+			},
+			{
+				Name:        "selector_query",
+				Type:        proto.ColumnType_STRING,
+				Description: "A query string representation of the selector of the monitored pods.",
+				Transform:   transform.FromField("Description.LabelSelectorString"),
+			},
+			{
+				Name:        "namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Namespaces the monitored pods are selected in, the namespace of the monitor if empty.",
+				Transform:   transform.FromField("Description.PodMonitor.Spec.NamespaceSelector"),
+			},
+			{
+				Name:        "pod_metrics_endpoints",
+				Type:        proto.ColumnType_JSON,
+				Description: "Scraped endpoints of the monitored pods, with their port, path, scheme and interval.",
+				Transform:   transform.FromField("Description.PodMonitor.Spec.PodMetricsEndpoints"),
+			},
+			{
+				Name:        "job_label",
+				Type:        proto.ColumnType_STRING,
+				Description: "Label of the pods used as the job name of the scraped metrics.",
+				Transform:   transform.FromField("Description.PodMonitor.Spec.JobLabel"),
+			},
+			{
+				Name:        "pod_target_labels",
+				Type:        proto.ColumnType_JSON,
+				Description: "Labels of the pods copied to the scraped metrics.",
+				Transform:   transform.FromField("Description.PodMonitor.Spec.PodTargetLabels"),
+			},
+			{
+				Name:        "sample_limit",
+				Type:        proto.ColumnType_INT,
+				Description: "Maximum number of samples accepted per scrape.",
+				Transform:   transform.FromField("Description.PodMonitor.Spec.SampleLimit"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformPodMonitorTags),
+			},
+		}),
+	}
+}
+
+func transformPodMonitorTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesPodMonitor).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -15,6 +15,10 @@ func tableKubernetesPodTemplate(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesPodTemplate,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPodTemplate,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "template",
@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesPriorityClass(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_priority_class",
+		Description: "PriorityClass maps a priority class name, referenced by the pod spec, to the scheduling priority of the pods.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesPriorityClass,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesPriorityClass,
+		},
+		// PriorityClass, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "value",
+				Type:        proto.ColumnType_INT,
+				Description: "Priority of the pods of the class, the higher the value the higher the priority.",
+				Transform:   transform.FromField("Description.PriorityClass.Value"),
+			},
+			{
+				Name:        "global_default",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the class is assigned to the pods that don't specify a priority class.",
+				Transform:   transform.FromField("Description.PriorityClass.GlobalDefault"),
+			},
+			{
+				Name:        "class_description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of when the class should be used.",
+				Transform:   transform.FromField("Description.PriorityClass.Description"),
+			},
+			{
+				Name:        "preemption_policy",
+				Type:        proto.ColumnType_STRING,
+				Description: "Whether the pods of the class may preempt pods of lower priority (PreemptLowerPriority) or not (Never).",
+				Transform:   transform.FromField("Description.PriorityClass.PreemptionPolicy"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformPriorityClassTags),
+			},
+		}),
+	}
+}
+
+func transformPriorityClassTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesPriorityClass).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
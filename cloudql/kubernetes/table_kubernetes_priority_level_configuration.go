@@ -0,0 +1,100 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesPriorityLevelConfiguration(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_priority_level_configuration",
+		Description: "PriorityLevelConfiguration sets the share of the API server concurrency of a priority level and how requests over its limit are handled (API Priority and Fairness).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesPriorityLevelConfiguration,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesPriorityLevelConfiguration,
+		},
+		// PriorityLevelConfiguration, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Exempt if the requests of the level are never throttled, Limited otherwise.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Type"),
+			},
+			{
+				Name:        "nominal_concurrency_shares",
+				Type:        proto.ColumnType_INT,
+				Description: "Share of the concurrency limit of the API server given to the level.",
+				Transform:   transform.FromField("Description.NominalConcurrencyShares"),
+			},
+			{
+				Name:        "lendable_percent",
+				Type:        proto.ColumnType_INT,
+				Description: "Percentage of the concurrency of a Limited level that other levels may borrow.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Limited.LendablePercent"),
+			},
+			{
+				Name:        "borrowing_limit_percent",
+				Type:        proto.ColumnType_INT,
+				Description: "Limit, as a percentage of its nominal concurrency, of the concurrency a Limited level may borrow.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Limited.BorrowingLimitPercent"),
+			},
+			{
+				Name:        "limit_response_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Whether requests over the limit of a Limited level are queued (Queue) or rejected with HTTP 429 (Reject).",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Limited.LimitResponse.Type"),
+			},
+			{
+				Name:        "queuing",
+				Type:        proto.ColumnType_JSON,
+				Description: "Number of queues, hand size and queue length limit of a Limited level that queues requests.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Limited.LimitResponse.Queuing"),
+			},
+			{
+				Name:        "limited",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of a Limited level.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Limited"),
+			},
+			{
+				Name:        "exempt",
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of an Exempt level.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Spec.Exempt"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the level.",
+				Transform:   transform.FromField("Description.PriorityLevelConfiguration.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformPriorityLevelConfigurationTags),
+			},
+		}),
+	}
+}
+
+func transformPriorityLevelConfigurationTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesPriorityLevelConfiguration).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
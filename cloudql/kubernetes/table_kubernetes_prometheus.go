@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesPrometheus(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_prometheus",
+		Description: "Prometheus is a Prometheus deployment managed by the Prometheus Operator, with the selectors of the monitors and rules it loads.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesPrometheus,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPrometheus,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of Prometheus.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.Version"),
+			},
+			{
+				Name:        "replicas",
+				Type:        proto.ColumnType_INT,
+				Description: "Requested number of replicas of each shard.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.Replicas"),
+			},
+			{
+				Name:        "shards",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of shards the targets are distributed across.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.Shards"),
+			},
+			{
+				Name:        "service_account_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service account the Prometheus pods run as.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.ServiceAccountName"),
+			},
+			{
+				Name:        "service_monitor_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the ServiceMonitors loaded, null selects none.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.ServiceMonitorSelector"),
+			},
+			{
+				Name:        "service_monitor_namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the namespaces the ServiceMonitors are loaded from, null selects the namespace of the Prometheus.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.ServiceMonitorNamespaceSelector"),
+			},
+			{
+				Name:        "pod_monitor_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the PodMonitors loaded, null selects none.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.PodMonitorSelector"),
+			},
+			{
+				Name:        "pod_monitor_namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the namespaces the PodMonitors are loaded from, null selects the namespace of the Prometheus.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.PodMonitorNamespaceSelector"),
+			},
+			{
+				Name:        "probe_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the Probes loaded, null selects none.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.ProbeSelector"),
+			},
+			{
+				Name:        "probe_namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the namespaces the Probes are loaded from, null selects the namespace of the Prometheus.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.ProbeNamespaceSelector"),
+			},
+			{
+				Name:        "rule_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the PrometheusRules loaded, null selects none.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.RuleSelector"),
+			},
+			{
+				Name:        "rule_namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Selector of the namespaces the PrometheusRules are loaded from, null selects the namespace of the Prometheus.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.RuleNamespaceSelector"),
+			},
+			{
+				Name:        "external_labels",
+				Type:        proto.ColumnType_JSON,
+				Description: "Labels added to the time series and alerts sent to external systems.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.ExternalLabels"),
+			},
+			{
+				Name:        "retention",
+				Type:        proto.ColumnType_STRING,
+				Description: "How long the samples are retained.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.Retention"),
+			},
+			{
+				Name:        "retention_size",
+				Type:        proto.ColumnType_STRING,
+				Description: "Maximum size of the retained samples.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.RetentionSize"),
+			},
+			{
+				Name:        "alerting",
+				Type:        proto.ColumnType_JSON,
+				Description: "Alertmanagers the alerts are sent to.",
+				Transform:   transform.FromField("Description.Prometheus.Spec.Alerting"),
+			},
+			{
+				Name:        "available_replicas",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of available pods.",
+				Transform:   transform.FromField("Description.Prometheus.Status.AvailableReplicas"),
+			},
+			{
+				Name:        "unavailable_replicas",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of unavailable pods.",
+				Transform:   transform.FromField("Description.Prometheus.Status.UnavailableReplicas"),
+			},
+			{
+				Name:        "paused",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the reconciliation of the Prometheus is paused.",
+				Transform:   transform.FromField("Description.Prometheus.Status.Paused"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the Prometheus, e.g. Available and Reconciled.",
+				Transform:   transform.FromField("Description.Prometheus.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformPrometheusTags),
+			},
+		}),
+	}
+}
+
+func transformPrometheusTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesPrometheus).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,57 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesPrometheusRule(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_prometheus_rule",
+		Description: "PrometheusRule declares recording and alerting rules loaded by Prometheus (Prometheus Operator).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesPrometheusRule,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesPrometheusRule,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "groups",
+				Type:        proto.ColumnType_JSON,
+				Description: "Rule groups, each with its name, evaluation interval and rules.",
+				Transform:   transform.FromField("Description.PrometheusRule.Spec.Groups"),
+			},
+			{
+				Name:        "alerts",
+				Type:        proto.ColumnType_JSON,
+				Description: "Names of the alerting rules of every group.",
+				Transform:   transform.FromField("Description.Alerts"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformPrometheusRuleTags),
+			},
+		}),
+	}
+}
+
+func transformPrometheusRuleTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesPrometheusRule).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
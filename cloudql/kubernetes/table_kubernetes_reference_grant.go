@@ -0,0 +1,57 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesReferenceGrant(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_reference_grant",
+		Description: "ReferenceGrant allows objects of other namespaces to reference objects of the namespace of the grant (Gateway API).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesReferenceGrant,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesReferenceGrant,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "from",
+				Type:        proto.ColumnType_JSON,
+				Description: "Group, kind and namespace of the objects allowed to reference the objects of the grant.",
+				Transform:   transform.FromField("Description.ReferenceGrant.Spec.From"),
+			},
+			{
+				Name:        "to",
+				Type:        proto.ColumnType_JSON,
+				Description: "Group, kind and optional name of the objects that may be referenced.",
+				Transform:   transform.FromField("Description.ReferenceGrant.Spec.To"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformReferenceGrantTags),
+			},
+		}),
+	}
+}
+
+func transformReferenceGrantTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesReferenceGrant).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
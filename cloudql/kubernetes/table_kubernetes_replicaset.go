@@ -15,6 +15,10 @@ func tableKubernetesReplicaSet(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesReplicaSet,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesReplicaSet,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "replicas",
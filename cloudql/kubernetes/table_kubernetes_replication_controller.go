@@ -15,6 +15,10 @@ func tableKubernetesReplicaController(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesReplicationController,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesReplicationController,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "replicas",
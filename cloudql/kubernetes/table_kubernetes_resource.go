@@ -16,6 +16,10 @@ func tableKubernetesResource(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesResource,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("uid"),
+			Hydrate:    opengovernance.GetKubernetesResource,
+		},
 		Columns: commonGeneralColumns([]*plugin.Column{
 			{
 				Name:        "kind",
@@ -65,6 +69,54 @@ func tableKubernetesResource(ctx context.Context) *plugin.Table {
 				Description: "creation timestamp.",
 				Transform:   transform.FromField("Description.ApiVersion"),
 			},
+			{
+				Name:        "group",
+				Type:        proto.ColumnType_STRING,
+				Description: "API group of the resource, empty for the core group.",
+				Transform:   transform.FromField("Description.Group"),
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "API version the resource was listed in.",
+				Transform:   transform.FromField("Description.Version"),
+			},
+			{
+				Name:        "resource",
+				Type:        proto.ColumnType_STRING,
+				Description: "Plural resource name, e.g. deployments.",
+				Transform:   transform.FromField("Description.Resource"),
+			},
+			{
+				Name:        "namespaced",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the resource is namespaced.",
+				Transform:   transform.FromField("Description.Namespaced"),
+			},
+			{
+				Name:        "metadata",
+				Type:        proto.ColumnType_JSON,
+				Description: "Object metadata, only captured if capture_metadata is enabled for the integration.",
+				Transform:   transform.FromField("Description.Metadata"),
+			},
+			{
+				Name:        "spec",
+				Type:        proto.ColumnType_JSON,
+				Description: "Object spec, only captured if capture_spec is enabled for the integration.",
+				Transform:   transform.FromField("Description.Spec"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_JSON,
+				Description: "Object status, only captured if capture_status is enabled for the integration.",
+				Transform:   transform.FromField("Description.Status"),
+			},
+			{
+				Name:        "truncated",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the spec and status were dropped because they exceed capture_max_bytes.",
+				Transform:   transform.FromField("Description.Truncated"),
+			},
 		}),
 	}
 }
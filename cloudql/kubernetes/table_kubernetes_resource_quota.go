@@ -15,6 +15,10 @@ func tableKubernetesResourceQuota(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesResourceQuota,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesResourceQuota,
+		},
 		Columns: commonColumns([]*plugin.Column{
 
 			//// ResourceQuotaSpec Columns
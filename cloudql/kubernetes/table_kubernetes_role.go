@@ -15,6 +15,10 @@ func tableKubernetesRole(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesRole,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesRole,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "rules",
@@ -15,6 +15,10 @@ func tableKubernetesRoleBinding(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesRoleBinding,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesRoleBinding,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "subjects",
@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesRuntimeClass(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_runtime_class",
+		Description: "RuntimeClass defines a container runtime configuration, referenced by the pod spec, e.g. a sandboxed runtime such as gVisor or Kata Containers.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesRuntimeClass,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesRuntimeClass,
+		},
+		// RuntimeClass, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "handler",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the runtime configuration of the container runtime (CRI) that runs the pods of the class.",
+				Transform:   transform.FromField("Description.RuntimeClass.Handler"),
+			},
+			{
+				Name:        "overhead",
+				Type:        proto.ColumnType_JSON,
+				Description: "Resources consumed by the runtime of each pod in addition to its containers.",
+				Transform:   transform.FromField("Description.RuntimeClass.Overhead.PodFixed"),
+			},
+			{
+				Name:        "node_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Labels of the nodes supporting the class, the pods of the class are only scheduled on them.",
+				Transform:   transform.FromField("Description.RuntimeClass.Scheduling.NodeSelector"),
+			},
+			{
+				Name:        "tolerations",
+				Type:        proto.ColumnType_JSON,
+				Description: "Tolerations added to the pods of the class.",
+				Transform:   transform.FromField("Description.RuntimeClass.Scheduling.Tolerations"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformRuntimeClassTags),
+			},
+		}),
+	}
+}
+
+func transformRuntimeClassTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesRuntimeClass).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -15,6 +15,10 @@ func tableKubernetesSecret(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesSecret,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesSecret,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "immutable",
@@ -15,6 +15,10 @@ func tableKubernetesService(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesService,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesService,
+		},
 		// Service is namespaced resource.
 		Columns: commonColumns([]*plugin.Column{
 			{
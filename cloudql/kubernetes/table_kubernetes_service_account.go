@@ -15,6 +15,10 @@ func tableKubernetesServiceAccount(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesServiceAccount,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesServiceAccount,
+		},
 		// Service Account, is namespaced resource.
 		Columns: commonColumns([]*plugin.Column{
 			{
@@ -0,0 +1,93 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesServiceMonitor(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_service_monitor",
+		Description: "ServiceMonitor declares the services whose endpoints are scraped by Prometheus (Prometheus Operator).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesServiceMonitor,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesServiceMonitor,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Label selector of the monitored services.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.Selector"),
+			},
+			{
+				Name:        "selector_query",
+				Type:        proto.ColumnType_STRING,
+				Description: "A query string representation of the selector of the monitored services.",
+				Transform:   transform.FromField("Description.LabelSelectorString"),
+			},
+			{
+				Name:        "namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Namespaces the monitored services are selected in, the namespace of the monitor if empty.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.NamespaceSelector"),
+			},
+			{
+				Name:        "endpoints",
+				Type:        proto.ColumnType_JSON,
+				Description: "Scraped endpoints of the monitored services, with their port or target port, path, scheme and interval.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.Endpoints"),
+			},
+			{
+				Name:        "job_label",
+				Type:        proto.ColumnType_STRING,
+				Description: "Label of the services used as the job name of the scraped metrics.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.JobLabel"),
+			},
+			{
+				Name:        "target_labels",
+				Type:        proto.ColumnType_JSON,
+				Description: "Labels of the services copied to the scraped metrics.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.TargetLabels"),
+			},
+			{
+				Name:        "pod_target_labels",
+				Type:        proto.ColumnType_JSON,
+				Description: "Labels of the pods copied to the scraped metrics.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.PodTargetLabels"),
+			},
+			{
+				Name:        "sample_limit",
+				Type:        proto.ColumnType_INT,
+				Description: "Maximum number of samples accepted per scrape.",
+				Transform:   transform.FromField("Description.ServiceMonitor.Spec.SampleLimit"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformServiceMonitorTags),
+			},
+		}),
+	}
+}
+
+func transformServiceMonitorTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesServiceMonitor).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
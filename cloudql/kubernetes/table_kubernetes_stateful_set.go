@@ -15,6 +15,10 @@ func tableKubernetesStatefulSet(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesStatefulSet,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesStatefulSet,
+		},
 		// StatefulSet, is namespaced resource.
 		Columns: commonColumns([]*plugin.Column{
 			{
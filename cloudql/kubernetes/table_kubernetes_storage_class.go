@@ -15,6 +15,10 @@ func tableKubernetesStorageClass(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: opengovernance.ListKubernetesStorageClass,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesStorageClass,
+		},
 		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "provisioner",
@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesValidatingAdmissionPolicy(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_validating_admission_policy",
+		Description: "ValidatingAdmissionPolicy describes an in-process admission policy written in CEL that accepts or rejects objects.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesValidatingAdmissionPolicy,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesValidatingAdmissionPolicy,
+		},
+		// ValidatingAdmissionPolicy, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "failure_policy",
+				Type:        proto.ColumnType_STRING,
+				Description: "How failures of the admission policy are handled, Ignore or Fail.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.FailurePolicy"),
+			},
+			{
+				Name:        "param_kind",
+				Type:        proto.ColumnType_JSON,
+				Description: "Kind of the resources used to parameterize the policy.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.ParamKind"),
+			},
+			{
+				Name:        "match_constraints",
+				Type:        proto.ColumnType_JSON,
+				Description: "Resources the policy applies to.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.MatchConstraints"),
+			},
+			{
+				Name:        "namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Label selector of the namespaces of the objects the policy applies to.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.MatchConstraints.NamespaceSelector"),
+			},
+			{
+				Name:        "object_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Label selector of the objects the policy applies to.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.MatchConstraints.ObjectSelector"),
+			},
+			{
+				Name:        "validations",
+				Type:        proto.ColumnType_JSON,
+				Description: "CEL expressions used to validate the objects.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.Validations"),
+			},
+			{
+				Name:        "audit_annotations",
+				Type:        proto.ColumnType_JSON,
+				Description: "CEL expressions producing audit annotations for the requests.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.AuditAnnotations"),
+			},
+			{
+				Name:        "match_conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions a request must match to be validated.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.MatchConditions"),
+			},
+			{
+				Name:        "variables",
+				Type:        proto.ColumnType_JSON,
+				Description: "Variables available to the other expressions of the policy.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Spec.Variables"),
+			},
+			{
+				Name:        "observed_generation",
+				Type:        proto.ColumnType_INT,
+				Description: "The generation observed by the controller.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Status.ObservedGeneration"),
+			},
+			{
+				Name:        "type_checking",
+				Type:        proto.ColumnType_JSON,
+				Description: "Warnings of the type checking of the expressions.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Status.TypeChecking"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the policy.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicy.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformValidatingAdmissionPolicyTags),
+			},
+		}),
+	}
+}
+
+func transformValidatingAdmissionPolicyTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesValidatingAdmissionPolicy).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesValidatingAdmissionPolicyBinding(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_validating_admission_policy_binding",
+		Description: "ValidatingAdmissionPolicyBinding binds a ValidatingAdmissionPolicy to parameters and scopes it to a set of resources.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesValidatingAdmissionPolicyBinding,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesValidatingAdmissionPolicyBinding,
+		},
+		// ValidatingAdmissionPolicyBinding, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "policy_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the ValidatingAdmissionPolicy the binding binds to.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicyBinding.Spec.PolicyName"),
+			},
+			{
+				Name:        "param_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Resource used as the parameters of the policy.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicyBinding.Spec.ParamRef"),
+			},
+			{
+				Name:        "match_resources",
+				Type:        proto.ColumnType_JSON,
+				Description: "Resources the binding applies the policy to, on top of the match constraints of the policy.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources"),
+			},
+			{
+				Name:        "namespace_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Label selector of the namespaces of the objects the binding applies to.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources.NamespaceSelector"),
+			},
+			{
+				Name:        "object_selector",
+				Type:        proto.ColumnType_JSON,
+				Description: "Label selector of the objects the binding applies to.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources.ObjectSelector"),
+			},
+			{
+				Name:        "validation_actions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Actions taken when a validation of the policy fails, Deny, Warn or Audit.",
+				Transform:   transform.FromField("Description.ValidatingAdmissionPolicyBinding.Spec.ValidationActions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformValidatingAdmissionPolicyBindingTags),
+			},
+		}),
+	}
+}
+
+func transformValidatingAdmissionPolicyBindingTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesValidatingAdmissionPolicyBinding).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesValidatingWebhookConfiguration(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_validating_webhook_configuration",
+		Description: "ValidatingWebhookConfiguration describes the configuration of an admission webhook that accepts or rejects objects without changing them.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesValidatingWebhookConfiguration,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesValidatingWebhookConfiguration,
+		},
+		// ValidatingWebhookConfiguration, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "webhooks",
+				Type:        proto.ColumnType_JSON,
+				Description: "Webhooks of the configuration, each with its rules, failure policy, match policy, namespace and object selectors, side effects, timeout and the service or URL backing it.",
+				Transform:   transform.FromField("Description.ValidatingWebhookConfiguration.Webhooks"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformValidatingWebhookConfigurationTags),
+			},
+		}),
+	}
+}
+
+func transformValidatingWebhookConfigurationTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesValidatingWebhookConfiguration).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
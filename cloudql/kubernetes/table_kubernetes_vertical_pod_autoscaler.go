@@ -0,0 +1,105 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesVerticalPodAutoscaler(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_vertical_pod_autoscaler",
+		Description: "VerticalPodAutoscaler recommends, and optionally applies, CPU and memory requests for the containers of a workload (Kubernetes autoscaler).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesVerticalPodAutoscaler,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesVerticalPodAutoscaler,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "target_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Workload whose pods are autoscaled, with its kind, name and API version.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.TargetRef"),
+			},
+			{
+				Name:        "target_kind",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kind of the autoscaled workload, e.g. Deployment.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.TargetRef.Kind"),
+			},
+			{
+				Name:        "target_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the autoscaled workload, in the namespace of the autoscaler.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.TargetRef.Name"),
+			},
+			{
+				Name:        "update_mode",
+				Type:        proto.ColumnType_STRING,
+				Description: "Whether the recommendations are applied: Off, Initial, Recreate or Auto. Null means Auto.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.UpdatePolicy.UpdateMode"),
+			},
+			{
+				Name:        "update_policy",
+				Type:        proto.ColumnType_JSON,
+				Description: "Policy used when updating the pods.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.UpdatePolicy"),
+			},
+			{
+				Name:        "resource_policy",
+				Type:        proto.ColumnType_JSON,
+				Description: "Per-container constraints of the recommendations.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.ResourcePolicy"),
+			},
+			{
+				Name:        "recommenders",
+				Type:        proto.ColumnType_JSON,
+				Description: "Recommenders providing the recommendations, the default recommender if empty.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Spec.Recommenders"),
+			},
+			{
+				Name:        "container_recommendations",
+				Type:        proto.ColumnType_JSON,
+				Description: "Target, lower bound, upper bound and uncapped target of each container in CPU millicores and memory bytes.",
+				Transform:   transform.FromField("Description.ContainerRecommendations"),
+			},
+			{
+				Name:        "recommendation",
+				Type:        proto.ColumnType_JSON,
+				Description: "Recommendations of each container as reported by the recommender.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Status.Recommendation"),
+			},
+			{
+				Name:        "conditions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Conditions of the autoscaler, e.g. RecommendationProvided.",
+				Transform:   transform.FromField("Description.VerticalPodAutoscaler.Status.Conditions"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformVerticalPodAutoscalerTags),
+			},
+		}),
+	}
+}
+
+func transformVerticalPodAutoscalerTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesVerticalPodAutoscaler).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
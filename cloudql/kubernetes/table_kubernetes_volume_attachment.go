@@ -0,0 +1,94 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesVolumeAttachment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_volume_attachment",
+		Description: "VolumeAttachment captures the intent to attach or detach a volume to or from a node.",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesVolumeAttachment,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesVolumeAttachment,
+		},
+		// VolumeAttachment, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "attacher",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the CSI driver handling the attachment.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Spec.Attacher"),
+			},
+			{
+				Name:        "node_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the node the volume is attached to.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Spec.NodeName"),
+			},
+			{
+				Name:        "persistent_volume_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the attached persistent volume.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Spec.Source.PersistentVolumeName"),
+			},
+			{
+				Name:        "inline_volume_spec",
+				Type:        proto.ColumnType_JSON,
+				Description: "Spec of the attached inline volume, for in-tree volumes of pods migrated to a CSI driver.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Spec.Source.InlineVolumeSpec"),
+			},
+			{
+				Name:        "attached",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the attach operation completed successfully.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Status.Attached"),
+			},
+			{
+				Name:        "attachment_metadata",
+				Type:        proto.ColumnType_JSON,
+				Description: "Information returned by the attach operation.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Status.AttachmentMetadata"),
+			},
+			{
+				Name:        "attach_error",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last error encountered during the attach operation.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Status.AttachError"),
+			},
+			{
+				Name:        "detach_error",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last error encountered during the detach operation.",
+				Transform:   transform.FromField("Description.VolumeAttachment.Status.DetachError"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformVolumeAttachmentTags),
+			},
+		}),
+	}
+}
+
+func transformVolumeAttachmentTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesVolumeAttachment).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,93 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesVolumeSnapshot(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_volume_snapshot",
+		Description: "VolumeSnapshot is a user's request for a snapshot of a persistent volume claim (CSI external-snapshotter).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesVolumeSnapshot,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "namespace"}),
+			Hydrate:    opengovernance.GetKubernetesVolumeSnapshot,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "source_pvc_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the persistent volume claim the snapshot is taken from, in the namespace of the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Spec.Source.PersistentVolumeClaimName"),
+			},
+			{
+				Name:        "source_content_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the pre-existing VolumeSnapshotContent the snapshot represents.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Spec.Source.VolumeSnapshotContentName"),
+			},
+			{
+				Name:        "volume_snapshot_class_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the VolumeSnapshotClass requested by the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Spec.VolumeSnapshotClassName"),
+			},
+			{
+				Name:        "bound_content_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the VolumeSnapshotContent the snapshot is bound to.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Status.BoundVolumeSnapshotContentName"),
+			},
+			{
+				Name:        "creation_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the snapshot was taken by the storage system.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Status.CreationTime"),
+			},
+			{
+				Name:        "ready_to_use",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the snapshot is ready to be used to restore a volume.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Status.ReadyToUse"),
+			},
+			{
+				Name:        "restore_size",
+				Type:        proto.ColumnType_STRING,
+				Description: "Minimum size of a volume restored from the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Status.RestoreSize"),
+			},
+			{
+				Name:        "error",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last error encountered while taking the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshot.Status.Error"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformVolumeSnapshotTags),
+			},
+		}),
+	}
+}
+
+func transformVolumeSnapshotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesVolumeSnapshot).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesVolumeSnapshotClass(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_volume_snapshot_class",
+		Description: "VolumeSnapshotClass describes the parameters used by the storage system when taking volume snapshots (CSI external-snapshotter).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesVolumeSnapshotClass,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesVolumeSnapshotClass,
+		},
+		// VolumeSnapshotClass, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "driver",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the CSI driver taking the snapshots of the class.",
+				Transform:   transform.FromField("Description.VolumeSnapshotClass.Driver"),
+			},
+			{
+				Name:        "parameters",
+				Type:        proto.ColumnType_JSON,
+				Description: "Driver specific parameters of the snapshots of the class.",
+				Transform:   transform.FromField("Description.VolumeSnapshotClass.Parameters"),
+			},
+			{
+				Name:        "deletion_policy",
+				Type:        proto.ColumnType_STRING,
+				Description: "Whether the snapshots of the storage system are deleted (Delete) or kept (Retain) with their VolumeSnapshotContent.",
+				Transform:   transform.FromField("Description.VolumeSnapshotClass.DeletionPolicy"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformVolumeSnapshotClassTags),
+			},
+		}),
+	}
+}
+
+func transformVolumeSnapshotClassTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesVolumeSnapshotClass).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
@@ -0,0 +1,128 @@
+package kubernetes
+
+import (
+	"context"
+	opengovernance "github.com/opengovern/og-describer-kubernetes/discovery/pkg/es"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableKubernetesVolumeSnapshotContent(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "k8_volume_snapshot_content",
+		Description: "VolumeSnapshotContent represents the actual snapshot of the storage system a VolumeSnapshot is bound to (CSI external-snapshotter).",
+		List: &plugin.ListConfig{
+			Hydrate: opengovernance.ListKubernetesVolumeSnapshotContent,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    opengovernance.GetKubernetesVolumeSnapshotContent,
+		},
+		// VolumeSnapshotContent, is a non-namespaced resource.
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "volume_snapshot_ref",
+				Type:        proto.ColumnType_JSON,
+				Description: "Reference to the VolumeSnapshot bound to the content.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef"),
+			},
+			{
+				Name:        "volume_snapshot_namespace",
+				Type:        proto.ColumnType_STRING,
+				Description: "Namespace of the VolumeSnapshot bound to the content.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef.Namespace"),
+			},
+			{
+				Name:        "volume_snapshot_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the VolumeSnapshot bound to the content.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef.Name"),
+			},
+			{
+				Name:        "deletion_policy",
+				Type:        proto.ColumnType_STRING,
+				Description: "Whether the snapshot of the storage system is deleted (Delete) or kept (Retain) with the content.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.DeletionPolicy"),
+			},
+			{
+				Name:        "driver",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the CSI driver that took the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.Driver"),
+			},
+			{
+				Name:        "volume_snapshot_class_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the VolumeSnapshotClass of the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.VolumeSnapshotClassName"),
+			},
+			{
+				Name:        "volume_handle",
+				Type:        proto.ColumnType_STRING,
+				Description: "Handle of the snapshotted volume in the storage system.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Spec.Source.VolumeHandle"),
+			},
+			{
+				Name:        "snapshot_handle",
+				Type:        proto.ColumnType_STRING,
+				Description: "Handle of the snapshot in the storage system.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Status.SnapshotHandle"),
+			},
+			{
+				Name:        "creation_time",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Time the snapshot was taken by the storage system.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Status.CreationTime").Transform(unixNanoToTimestamp),
+			},
+			{
+				Name:        "ready_to_use",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the snapshot is ready to be used to restore a volume.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Status.ReadyToUse"),
+			},
+			{
+				Name:        "restore_size",
+				Type:        proto.ColumnType_INT,
+				Description: "Minimum size in bytes of a volume restored from the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Status.RestoreSize"),
+			},
+			{
+				Name:        "error",
+				Type:        proto.ColumnType_JSON,
+				Description: "Last error encountered while taking the snapshot.",
+				Transform:   transform.FromField("Description.VolumeSnapshotContent.Status.Error"),
+			},
+
+			//// Steampipe Standard Columns
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: ColumnDescriptionTitle,
+				Transform:   transform.FromField("Description.MetaObject.Name"),
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: ColumnDescriptionTags,
+				Transform:   transform.From(transformVolumeSnapshotContentTags),
+			},
+		}),
+	}
+}
+
+func transformVolumeSnapshotContentTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	obj := d.HydrateItem.(opengovernance.KubernetesVolumeSnapshotContent).Description.MetaObject
+	return mergeTags(obj.Labels, obj.Annotations), nil
+}
+
+// unixNanoToTimestamp converts the creation time of a VolumeSnapshotContent, in nanoseconds since the epoch, to a timestamp
+func unixNanoToTimestamp(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	nanoseconds, ok := d.Value.(*int64)
+	if !ok || nanoseconds == nil {
+		return nil, nil
+	}
+	return time.Unix(0, *nanoseconds).UTC(), nil
+}
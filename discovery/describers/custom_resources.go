@@ -0,0 +1,103 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// customResourceClient returns the dynamic client of the custom resources of an optional CRD (e.g. gateways.gateway.networking.k8s.io),
+// in the version they are listed in, and whether they are namespaced. A nil client is returned if the CRD is not installed or serves no version.
+func customResourceClient(ctx context.Context, client model.Client, crdName string) (dynamic.NamespaceableResourceInterface, bool, error) {
+	if client.Namespaces.IsScoped() {
+		return scopedCustomResourceClient(ctx, client, crdName)
+	}
+	customResourceDefinition, err := client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crdName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	version := customResourceDefinitionListVersion(customResourceDefinition)
+	if version == "" {
+		return nil, false, nil
+	}
+	return client.DynamicClient.Resource(schema.GroupVersionResource{
+		Group:    customResourceDefinition.Spec.Group,
+		Version:  version,
+		Resource: customResourceDefinition.Spec.Names.Plural,
+	}), customResourceDefinition.Spec.Scope == apiextensionsv1.NamespaceScoped, nil
+}
+
+// scopedCustomResourceClient is customResourceClient for credentials restricted to a set of namespaces, they can't get
+// the cluster-scoped CRD so its custom resources are looked up in the discovery information of the cluster instead
+func scopedCustomResourceClient(ctx context.Context, client model.Client, crdName string) (dynamic.NamespaceableResourceInterface, bool, error) {
+	plural, group, _ := strings.Cut(crdName, ".")
+	cached, err := clusterDiscoveryCache.get(ctx, client.RestConfig)
+	if err != nil {
+		return nil, false, err
+	}
+	gvk, err := cached.restMapper.KindFor(schema.GroupVersionResource{Group: group, Resource: plural})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	mapping, err := cached.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return client.DynamicClient.Resource(mapping.Resource), mapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+// listCustomResources lists the custom resources of an optional CRD and calls handle once for every item in the integration scope.
+// Custom resources are only described if their CRD is installed in the cluster, nothing is listed otherwise.
+func listCustomResources(ctx context.Context, client model.Client, crdName string, handle func(*unstructured.Unstructured) error) error {
+	dynamicClient, namespaced, err := customResourceClient(ctx, client, crdName)
+	if err != nil || dynamicClient == nil {
+		return err
+	}
+
+	scopedHandle := func(item *unstructured.Unstructured) error {
+		if !client.Namespaces.Allows(item.GetNamespace()) {
+			return nil
+		}
+		return handle(item)
+	}
+	if namespaced {
+		return listNamespacedPaged(ctx, client.Namespaces, dynamicClient.Namespace, scopedHandle)
+	}
+	return listClusterPaged(ctx, client.Namespaces, dynamicClient.List, scopedHandle)
+}
+
+// getCustomResource gets a custom resource of an optional CRD, namespace is empty for cluster-scoped custom resources
+func getCustomResource(ctx context.Context, client model.Client, crdName string, namespace string, name string) (*unstructured.Unstructured, error) {
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+	dynamicClient, _, err := customResourceClient(ctx, client, crdName)
+	if err != nil {
+		return nil, err
+	}
+	if dynamicClient == nil {
+		return nil, fmt.Errorf("custom resource definition %s is not installed", crdName)
+	}
+	if namespace != "" {
+		return dynamicClient.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	return dynamicClient.Get(ctx, name, metav1.GetOptions{})
+}
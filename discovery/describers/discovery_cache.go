@@ -0,0 +1,146 @@
+package describers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+// discoveryCacheTTL is how long the discovery information of a cluster is reused before being fetched again
+const discoveryCacheTTL = 10 * time.Minute
+
+// crdsCheckInterval is how often the custom resource definitions of a cached cluster are checked for changes,
+// listing them on every describe call would cost as much as the discovery the cache saves
+const crdsCheckInterval = time.Minute
+
+var customResourceDefinitionsResource = apiextensionsv1.SchemeGroupVersion.WithResource("customresourcedefinitions")
+
+// clusterDiscovery is the cached discovery information of a single cluster
+type clusterDiscovery struct {
+	client          discovery.CachedDiscoveryInterface
+	restMapper      meta.RESTMapper
+	crdsFingerprint string
+	crdsCheckDue    time.Time
+	expiresAt       time.Time
+}
+
+// discoveryCache caches the discovery information and RESTMapper of every cluster described by the process.
+// Entries are keyed by API server and credentials, they expire after the TTL and are dropped as soon as
+// the custom resource definitions of their cluster are found to have changed, which is checked at most once per check interval.
+type discoveryCache struct {
+	mu                sync.Mutex
+	ttl               time.Duration
+	crdsCheckInterval time.Duration
+	clusters          map[string]*clusterDiscovery
+}
+
+var clusterDiscoveryCache = &discoveryCache{
+	ttl:               discoveryCacheTTL,
+	crdsCheckInterval: crdsCheckInterval,
+	clusters:          make(map[string]*clusterDiscovery),
+}
+
+// get returns the discovery information of the cluster of config, fetching it again if it expired or the CRDs changed.
+// If the CRDs cannot be listed the entry is only refreshed when it expires.
+func (c *discoveryCache) get(ctx context.Context, config *rest.Config) (*clusterDiscovery, error) {
+	key := discoveryCacheKey(config)
+
+	c.mu.Lock()
+	now := time.Now()
+	for k, entry := range c.clusters {
+		if now.After(entry.expiresAt) {
+			delete(c.clusters, k)
+		}
+	}
+	if entry, ok := c.clusters[key]; ok && now.Before(entry.crdsCheckDue) {
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.mu.Unlock()
+
+	fingerprint, fingerprintErr := crdsFingerprint(ctx, config)
+	if fingerprintErr != nil {
+		log.Printf("Warning: could not fingerprint custom resource definitions of %s, discovery is refreshed every %v only: %v", config.Host, c.ttl, fingerprintErr)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now = time.Now()
+	if entry, ok := c.clusters[key]; ok {
+		if fingerprintErr != nil || entry.crdsFingerprint == fingerprint {
+			entry.crdsCheckDue = now.Add(c.crdsCheckInterval)
+			return entry, nil
+		}
+		log.Printf("Custom resource definitions of %s changed, invalidating its discovery cache", config.Host)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery client: %w", err)
+	}
+	cachedDiscoveryClient := memory.NewMemCacheClient(discoveryClient)
+	entry := &clusterDiscovery{
+		client:          cachedDiscoveryClient,
+		restMapper:      restmapper.NewDeferredDiscoveryRESTMapper(cachedDiscoveryClient),
+		crdsFingerprint: fingerprint,
+		crdsCheckDue:    now.Add(c.crdsCheckInterval),
+		expiresAt:       now.Add(c.ttl),
+	}
+	c.clusters[key] = entry
+	return entry, nil
+}
+
+// discoveryCacheKey identifies a cluster by its API server and the credentials used to reach it,
+// so integrations of the same server with different permissions or rotated credentials don't share an entry
+func discoveryCacheKey(config *rest.Config) string {
+	h := sha256.New()
+	for _, part := range []string{
+		config.BearerToken, config.BearerTokenFile, config.Username,
+		string(config.CertData), config.CertFile, string(config.CAData), config.CAFile,
+		fmt.Sprintf("%v", config.ExecProvider), fmt.Sprintf("%v", config.AuthProvider),
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return config.Host + "/" + hex.EncodeToString(h.Sum(nil))
+}
+
+// crdsFingerprint lists the metadata of the custom resource definitions of the cluster and hashes their names and resource versions
+func crdsFingerprint(ctx context.Context, config *rest.Config) (string, error) {
+	metadataClient, err := metadata.NewForConfig(config)
+	if err != nil {
+		return "", err
+	}
+
+	var versions []string
+	err = listPaged(ctx, metadataClient.Resource(customResourceDefinitionsResource).List, func(crd *metav1.PartialObjectMetadata) error {
+		versions = append(versions, crd.Name+"@"+crd.ResourceVersion)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(versions)
+
+	h := sha256.New()
+	for _, version := range versions {
+		h.Write([]byte(version))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,83 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// fakeCRDServer serves the metadata of a single custom resource definition at the returned resource version
+// and counts the CRD list calls it receives
+func fakeCRDServer(t *testing.T, resourceVersion *atomic.Int64, calls *atomic.Int64) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/apiextensions.k8s.io/v1/customresourcedefinitions" {
+			http.NotFound(w, r)
+			return
+		}
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"apiVersion":"meta.k8s.io/v1","kind":"PartialObjectMetadataList","metadata":{},"items":[`+
+			`{"apiVersion":"meta.k8s.io/v1","kind":"PartialObjectMetadata","metadata":{"name":"widgets.example.com","resourceVersion":"%d"}}]}`,
+			resourceVersion.Load())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDiscoveryCacheChecksCRDsOncePerInterval(t *testing.T) {
+	var resourceVersion, calls atomic.Int64
+	resourceVersion.Store(1)
+	server := fakeCRDServer(t, &resourceVersion, &calls)
+	config := &rest.Config{Host: server.URL}
+
+	cache := &discoveryCache{
+		ttl:               time.Hour,
+		crdsCheckInterval: time.Hour,
+		clusters:          make(map[string]*clusterDiscovery),
+	}
+	first, err := cache.get(context.Background(), config)
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		got, err := cache.get(context.Background(), config)
+		if err != nil {
+			t.Fatalf("get() error = %v", err)
+		}
+		if got != first {
+			t.Fatalf("get() returned a new entry within the check interval")
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("CRDs listed %d times, want 1", got)
+	}
+
+	// once the check is due, an unchanged fingerprint keeps the entry and a changed one replaces it
+	cache.clusters[discoveryCacheKey(config)].crdsCheckDue = time.Time{}
+	got, err := cache.get(context.Background(), config)
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if got != first {
+		t.Errorf("get() replaced the entry although the CRDs didn't change")
+	}
+
+	resourceVersion.Store(2)
+	cache.clusters[discoveryCacheKey(config)].crdsCheckDue = time.Time{}
+	got, err = cache.get(context.Background(), config)
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	if got == first {
+		t.Errorf("get() kept the entry although the CRDs changed")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("CRDs listed %d times, want 3", got)
+	}
+}
@@ -4,28 +4,50 @@ package describers
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
+
+	helmclient "github.com/mittwald/go-helm-client"
 	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
 	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
 	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
 func KubernetesResources(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
 	var allValues []models.Resource
 
-	resources, err := GetKubernetesResources(client.KubeConfig)
+	resources, err := GetKubernetesResources(client.RestConfig, client.Namespaces, client.Capture)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, r := range resources {
-		resource := models.Resource{
-			ID:          r.UID,
-			Name:        r.ObjectName,
-			Description: r,
-		}
-
+		resource := kubernetesResourceResource(r)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
 				return allValues, fmt.Errorf("error streaming resource: %w", err)
@@ -38,1181 +60,3126 @@ func KubernetesResources(ctx context.Context, client model.Client, extra string,
 	return allValues, nil
 }
 
-func KubernetesCluster(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	cluster, err := DoDiscovery(client.KubeConfig)
+func GetKubernetesResource(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	gvr, namespace, name, err := parseResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
-	resource := models.Resource{
-		ID:          fmt.Sprintf("cluster/%s", cluster.ContextName),
-		Name:        cluster.ContextName,
-		Description: cluster,
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+	if gvr.GroupResource() == namespacesGroupResource && !client.Namespaces.Allows(name) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", name)
 	}
 
-	if stream != nil {
-		if err := (*stream)(resource); err != nil {
-			return allValues, fmt.Errorf("error streaming resource: %w", err)
-		}
+	var item *unstructured.Unstructured
+	if namespace != "" {
+		item, err = client.DynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 	} else {
-		allValues = append(allValues, resource)
+		item, err = client.DynamicClient.Resource(gvr).Get(ctx, name, metav1.GetOptions{})
 	}
-	return allValues, nil
-}
-
-func KubernetesClusterRole(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	clusterRoles, err := client.KubernetesClient.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, clusterRole := range clusterRoles.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		clusterRole.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("clusterrole/%s", clusterRole.Name),
-			Name: clusterRole.Name,
-			Description: model.KubernetesClusterRoleDescription{
-				MetaObject: helpers.ConvertObjectMeta(&clusterRole.ObjectMeta),
-				ClusterRole: helpers.ClusterRole{
-					TypeMeta:        helpers.ConvertTypeMeta(clusterRole.TypeMeta),
-					ObjectMeta:      helpers.ConvertObjectMeta(&clusterRole.ObjectMeta),
-					Rules:           helpers.ConvertPolicyRules(clusterRole.Rules),
-					AggregationRule: helpers.ConvertAggregationRule(clusterRole.AggregationRule),
-				},
-			},
-		}
-
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	resource := kubernetesResourceResource(kubernetesResourceDescription(item, gvr, namespace != "", client.Capture))
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
 }
 
-func KubernetesClusterRoleBinding(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+func kubernetesResourceResource(r model.KubernetesResourceDescription) models.Resource {
+	groupResource := r.Resource
+	if r.Group != "" {
+		groupResource = fmt.Sprintf("%s.%s", r.Resource, r.Group)
+	}
+	return models.Resource{
+		ID:          fmt.Sprintf("resource/%s/%s/%s/%s", groupResource, r.Version, r.Namespace, r.ObjectName),
+		Name:        r.ObjectName,
+		Description: r,
+	}
+}
+
+func KubernetesCluster(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
 	var allValues []models.Resource
 
-	clusterRoleBindings, err := client.KubernetesClient.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	cluster, err := describeCluster(ctx, client)
 	if err != nil {
 		return nil, err
 	}
+	resource := kubernetesClusterResource(cluster)
 
-	for _, clusterRoleBinding := range clusterRoleBindings.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		clusterRoleBinding.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("clusterrolebinding/%s", clusterRoleBinding.Name),
-			Name: clusterRoleBinding.Name,
-			Description: model.KubernetesClusterRoleBindingDescription{
-				MetaObject: helpers.ConvertObjectMeta(&clusterRoleBinding.ObjectMeta),
-				ClusterRoleBinding: helpers.ClusterRoleBinding{
-					TypeMeta:   helpers.ConvertTypeMeta(clusterRoleBinding.TypeMeta),
-					ObjectMeta: helpers.ConvertObjectMeta(&clusterRoleBinding.ObjectMeta),
-					Subjects:   helpers.ConvertSubjects(clusterRoleBinding.Subjects),
-					RoleRef:    helpers.ConvertRoleRef(clusterRoleBinding.RoleRef),
-				},
-			},
-		}
-
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return allValues, fmt.Errorf("error streaming resource: %w", err)
 		}
+	} else {
+		allValues = append(allValues, resource)
 	}
-
 	return allValues, nil
 }
 
-func KubernetesConfigMap(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	configMaps, err := client.KubernetesClient.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{})
+func GetKubernetesCluster(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	contextName, err := parseClusterContextResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, configMap := range configMaps.Items {
-		var resource models.Resource
-
-		configMap.BinaryData = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("configmap/%s/%s", configMap.Namespace, configMap.Name),
-			Name: fmt.Sprintf("%s/%s", configMap.Namespace, configMap.Name),
-			Description: model.KubernetesConfigMapDescription{
-				MetaObject: helpers.ConvertObjectMeta(&configMap.ObjectMeta),
-				ConfigMap: helpers.ConfigMap{
-					TypeMeta:   helpers.ConvertTypeMeta(configMap.TypeMeta),
-					ObjectMeta: helpers.ConvertObjectMeta(&configMap.ObjectMeta),
-					Immutable:  configMap.Immutable,
-					Data:       configMap.Data,
-				},
-			},
-		}
+	cluster, err := describeCluster(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.ContextName != contextName {
+		return nil, fmt.Errorf("cluster %s not found, the integration describes cluster %s", contextName, cluster.ContextName)
+	}
 
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	resource := kubernetesClusterResource(cluster)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
 }
 
-func KubernetesCronJob(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	cronJobs, err := client.KubernetesClient.BatchV1().CronJobs("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+func kubernetesClusterResource(cluster *model.KubernetesClusterDescription) models.Resource {
+	return models.Resource{
+		ID:          fmt.Sprintf("cluster/%s", cluster.ContextName),
+		Name:        cluster.ContextName,
+		Description: cluster,
 	}
+}
 
-	for _, cronJob := range cronJobs.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		cronJob.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("cronjob/%s/%s", cronJob.Namespace, cronJob.Name),
-			Name: fmt.Sprintf("%s/%s", cronJob.Namespace, cronJob.Name),
-			Description: model.KubernetesCronJobDescription{
-				MetaObject: helpers.ConvertObjectMeta(&cronJob.ObjectMeta),
-				CronJob:    helpers.ConvertCronJob(&cronJob),
-			},
-		}
+func KubernetesCertificateSigningRequest(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.CertificatesV1().CertificateSigningRequests().List, func(csr *certificatesv1.CertificateSigningRequest) error {
+		resource := kubernetesCertificateSigningRequestResource(csr)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesCustomResource(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	// list crds
-	customResourceDefinitions, err := client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+func GetKubernetesCertificateSigningRequest(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "certificatesigningrequest")
 	if err != nil {
 		return nil, err
 	}
 
-	for _, customResourceDefinition := range customResourceDefinitions.Items {
-		// use dynamic client to list resources of this crd
-		for _, version := range customResourceDefinition.Spec.Versions {
-			dynamicClient := client.DynamicClient.Resource(schema.GroupVersionResource{
-				Group:    customResourceDefinition.Spec.Group,
-				Version:  version.Name,
-				Resource: customResourceDefinition.Spec.Names.Plural,
-			})
-
-			resources, err := dynamicClient.List(ctx, metav1.ListOptions{})
-			if err != nil {
-				return nil, err
-			}
-
-			for _, item := range resources.Items {
-				var resource models.Resource
-				// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-				item.SetManagedFields(nil)
-				resource = models.Resource{
-					ID:   fmt.Sprintf("customresource/%s.%s/%s/%s", item.GetKind(), item.GetAPIVersion(), item.GetNamespace(), item.GetName()),
-					Name: fmt.Sprintf("%s/%s/%s", customResourceDefinition.Name, item.GetNamespace(), item.GetName()),
-					Description: model.KubernetesCustomResourceDescription{
-						MetaObject: helpers.ObjectMeta{
-							Name:                       item.GetName(),
-							GenerateName:               item.GetGenerateName(),
-							Namespace:                  item.GetNamespace(),
-							SelfLink:                   item.GetSelfLink(),
-							UID:                        item.GetUID(),
-							ResourceVersion:            item.GetResourceVersion(),
-							Generation:                 item.GetGeneration(),
-							CreationTimestamp:          helpers.ConvertTime(item.GetCreationTimestamp()),
-							DeletionTimestamp:          helpers.ConvertTimePtr(item.GetDeletionTimestamp()),
-							DeletionGracePeriodSeconds: item.GetDeletionGracePeriodSeconds(),
-							Labels:                     item.GetLabels(),
-							Annotations:                item.GetAnnotations(),
-							OwnerReferences:            helpers.ConvertOwnerReferences(item.GetOwnerReferences()),
-							Finalizers:                 item.GetFinalizers(),
-						},
-						CustomResource:     item,
-						FullyQualifiedName: fmt.Sprintf("%s.%s", item.GetKind(), item.GetAPIVersion()),
-					},
-				}
+	csr, err := client.KubernetesClient.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
 
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return allValues, fmt.Errorf("error streaming resource: %w", err)
-					}
-				} else {
-					allValues = append(allValues, resource)
-				}
-			}
+	resource := kubernetesCertificateSigningRequestResource(csr)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
 }
 
-func KubernetesCustomResourceDefinition(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	customResourceDefinitions, err := client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+func kubernetesCertificateSigningRequestResource(csr *certificatesv1.CertificateSigningRequest) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	csr.ManagedFields = nil
+	certificateSigningRequest := helpers.ConvertCertificateSigningRequest(csr)
+	return models.Resource{
+		ID:   fmt.Sprintf("certificatesigningrequest/%s", csr.Name),
+		Name: csr.Name,
+		Description: model.KubernetesCertificateSigningRequestDescription{
+			MetaObject:                helpers.ConvertObjectMeta(&csr.ObjectMeta),
+			CertificateSigningRequest: certificateSigningRequest,
+			ApprovalStatus:            certificateSigningRequest.ApprovalStatus(),
+		},
 	}
+}
 
-	for _, customResourceDefinition := range customResourceDefinitions.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		customResourceDefinition.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("customresourcedefinition/%s", customResourceDefinition.Name),
-			Name: customResourceDefinition.Name,
-			Description: model.KubernetesCustomResourceDefinitionDescription{
-				MetaObject:               helpers.ConvertObjectMeta(&customResourceDefinition.ObjectMeta),
-				CustomResourceDefinition: helpers.ConvertCustomResourceDefinition(&customResourceDefinition),
-			},
-		}
+func KubernetesClusterRole(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.RbacV1().ClusterRoles().List, func(clusterRole *rbacv1.ClusterRole) error {
+		resource := kubernetesClusterRoleResource(clusterRole)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesDaemonSet(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesClusterRole(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "clusterrole")
+	if err != nil {
+		return nil, err
+	}
 
-	daemonSets, err := client.KubernetesClient.AppsV1().DaemonSets("").List(ctx, metav1.ListOptions{})
+	clusterRole, err := client.KubernetesClient.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, daemonSet := range daemonSets.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		daemonSet.ManagedFields = nil
-		labelSelectorString := ""
-		ss, err := metav1.LabelSelectorAsSelector(daemonSet.Spec.Selector)
-		if err == nil {
-			labelSelectorString = ss.String()
+	resource := kubernetesClusterRoleResource(clusterRole)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
-		resource = models.Resource{
-			ID:   fmt.Sprintf("daemonset/%s/%s", daemonSet.Namespace, daemonSet.Name),
-			Name: fmt.Sprintf("%s/%s", daemonSet.Namespace, daemonSet.Name),
-			Description: model.KubernetesDaemonSetDescription{
-				MetaObject:          helpers.ConvertObjectMeta(&daemonSet.ObjectMeta),
-				DaemonSet:           helpers.ConvertDaemonSet(&daemonSet),
-				LabelSelectorString: labelSelectorString,
+	}
+
+	return &resource, nil
+}
+
+func kubernetesClusterRoleResource(clusterRole *rbacv1.ClusterRole) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	clusterRole.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("clusterrole/%s", clusterRole.Name),
+		Name: clusterRole.Name,
+		Description: model.KubernetesClusterRoleDescription{
+			MetaObject: helpers.ConvertObjectMeta(&clusterRole.ObjectMeta),
+			ClusterRole: helpers.ClusterRole{
+				TypeMeta:        helpers.ConvertTypeMeta(clusterRole.TypeMeta),
+				ObjectMeta:      helpers.ConvertObjectMeta(&clusterRole.ObjectMeta),
+				Rules:           helpers.ConvertPolicyRules(clusterRole.Rules),
+				AggregationRule: helpers.ConvertAggregationRule(clusterRole.AggregationRule),
 			},
-		}
+		},
+	}
+}
+
+func KubernetesClusterRoleBinding(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.RbacV1().ClusterRoleBindings().List, func(clusterRoleBinding *rbacv1.ClusterRoleBinding) error {
+		resource := kubernetesClusterRoleBindingResource(clusterRoleBinding)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesDeployment(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesClusterRoleBinding(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "clusterrolebinding")
+	if err != nil {
+		return nil, err
+	}
 
-	deployments, err := client.KubernetesClient.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+	clusterRoleBinding, err := client.KubernetesClient.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, deployment := range deployments.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		deployment.ManagedFields = nil
-		labelSelectorString := ""
-		ss, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
-		if err == nil {
-			labelSelectorString = ss.String()
+	resource := kubernetesClusterRoleBindingResource(clusterRoleBinding)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
-		resource = models.Resource{
-			ID:   fmt.Sprintf("deployment/%s/%s", deployment.Namespace, deployment.Name),
-			Name: fmt.Sprintf("%s/%s", deployment.Namespace, deployment.Name),
-			Description: model.KubernetesDeploymentDescription{
-				MetaObject:          helpers.ConvertObjectMeta(&deployment.ObjectMeta),
-				Deployment:          helpers.ConvertDeployment(&deployment),
-				LabelSelectorString: labelSelectorString,
+	}
+
+	return &resource, nil
+}
+
+func kubernetesClusterRoleBindingResource(clusterRoleBinding *rbacv1.ClusterRoleBinding) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	clusterRoleBinding.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("clusterrolebinding/%s", clusterRoleBinding.Name),
+		Name: clusterRoleBinding.Name,
+		Description: model.KubernetesClusterRoleBindingDescription{
+			MetaObject: helpers.ConvertObjectMeta(&clusterRoleBinding.ObjectMeta),
+			ClusterRoleBinding: helpers.ClusterRoleBinding{
+				TypeMeta:   helpers.ConvertTypeMeta(clusterRoleBinding.TypeMeta),
+				ObjectMeta: helpers.ConvertObjectMeta(&clusterRoleBinding.ObjectMeta),
+				Subjects:   helpers.ConvertSubjects(clusterRoleBinding.Subjects),
+				RoleRef:    helpers.ConvertRoleRef(clusterRoleBinding.RoleRef),
 			},
-		}
+		},
+	}
+}
+
+func KubernetesConfigMap(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().ConfigMaps, func(configMap *corev1.ConfigMap) error {
+		if !client.Namespaces.Allows(configMap.Namespace) {
+			return nil
+		}
+		resource := kubernetesConfigMapResource(configMap)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesEndpointSlice(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesConfigMap(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "configmap")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	endpointSlices, err := client.KubernetesClient.DiscoveryV1().EndpointSlices("").List(ctx, metav1.ListOptions{})
+	configMap, err := client.KubernetesClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, endpointSlice := range endpointSlices.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		endpointSlice.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("endpointslice/%s/%s", endpointSlice.Namespace, endpointSlice.Name),
-			Name: fmt.Sprintf("%s/%s", endpointSlice.Namespace, endpointSlice.Name),
-			Description: model.KubernetesEndpointSliceDescription{
-				MetaObject:    helpers.ConvertObjectMeta(&endpointSlice.ObjectMeta),
-				EndpointSlice: helpers.ConvertEndpointSlice(&endpointSlice),
-			},
-		}
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	resource := kubernetesConfigMapResource(configMap)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
 }
 
-func KubernetesEndpoint(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	endpoints, err := client.KubernetesClient.CoreV1().Endpoints("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+func kubernetesConfigMapResource(configMap *corev1.ConfigMap) models.Resource {
+	configMap.BinaryData = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("configmap/%s/%s", configMap.Namespace, configMap.Name),
+		Name: fmt.Sprintf("%s/%s", configMap.Namespace, configMap.Name),
+		Description: model.KubernetesConfigMapDescription{
+			MetaObject: helpers.ConvertObjectMeta(&configMap.ObjectMeta),
+			ConfigMap: helpers.ConfigMap{
+				TypeMeta:   helpers.ConvertTypeMeta(configMap.TypeMeta),
+				ObjectMeta: helpers.ConvertObjectMeta(&configMap.ObjectMeta),
+				Immutable:  configMap.Immutable,
+				Data:       configMap.Data,
+			},
+		},
 	}
+}
 
-	for _, endpoint := range endpoints.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		endpoint.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("endpoint/%s/%s", endpoint.Namespace, endpoint.Name),
-			Name: fmt.Sprintf("%s/%s", endpoint.Namespace, endpoint.Name),
-			Description: model.KubernetesEndpointDescription{
-				MetaObject: helpers.ConvertObjectMeta(&endpoint.ObjectMeta),
-				Endpoint:   helpers.ConvertEndpoints(&endpoint),
-			},
+func KubernetesCronJob(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.BatchV1().CronJobs, func(cronJob *batchv1.CronJob) error {
+		if !client.Namespaces.Allows(cronJob.Namespace) {
+			return nil
 		}
+		resource := kubernetesCronJobResource(cronJob)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesEvent(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesCronJob(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "cronjob")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	events, err := client.KubernetesClient.CoreV1().Events("").List(ctx, metav1.ListOptions{})
+	cronJob, err := client.KubernetesClient.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, event := range events.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		event.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("event/%s/%s", event.Namespace, event.Name),
-			Name: fmt.Sprintf("%s/%s", event.Namespace, event.Name),
-			Description: model.KubernetesEventDescription{
-				MetaObject: helpers.ConvertObjectMeta(&event.ObjectMeta),
-				Event:      helpers.ConvertEvent(&event),
-			},
-		}
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	resource := kubernetesCronJobResource(cronJob)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
 }
 
-func KubernetesHorizontalPodAutoscaler(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	horizontalPodAutoscalers, err := client.KubernetesClient.AutoscalingV1().HorizontalPodAutoscalers("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+func kubernetesCronJobResource(cronJob *batchv1.CronJob) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	cronJob.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("cronjob/%s/%s", cronJob.Namespace, cronJob.Name),
+		Name: fmt.Sprintf("%s/%s", cronJob.Namespace, cronJob.Name),
+		Description: model.KubernetesCronJobDescription{
+			MetaObject: helpers.ConvertObjectMeta(&cronJob.ObjectMeta),
+			CronJob:    helpers.ConvertCronJob(cronJob),
+		},
 	}
+}
 
-	for _, horizontalPodAutoscaler := range horizontalPodAutoscalers.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		horizontalPodAutoscaler.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("horizontalpodautoscaler/%s/%s", horizontalPodAutoscaler.Namespace, horizontalPodAutoscaler.Name),
-			Name: fmt.Sprintf("%s/%s", horizontalPodAutoscaler.Namespace, horizontalPodAutoscaler.Name),
-			Description: model.KubernetesHorizontalPodAutoscalerDescription{
-				MetaObject:              helpers.ConvertObjectMeta(&horizontalPodAutoscaler.ObjectMeta),
-				HorizontalPodAutoscaler: helpers.ConvertHorizontalPodAutoscaler(&horizontalPodAutoscaler),
-			},
-		}
+func KubernetesCSIDriver(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.StorageV1().CSIDrivers().List, func(csiDriver *storagev1.CSIDriver) error {
+		resource := kubernetesCSIDriverResource(csiDriver)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesIngress(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesCSIDriver(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "csidriver")
+	if err != nil {
+		return nil, err
+	}
 
-	ingresses, err := client.KubernetesClient.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{})
+	csiDriver, err := client.KubernetesClient.StorageV1().CSIDrivers().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, ingress := range ingresses.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		ingress.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("ingress/%s/%s", ingress.Namespace, ingress.Name),
-			Name: fmt.Sprintf("%s/%s", ingress.Namespace, ingress.Name),
-			Description: model.KubernetesIngressDescription{
-				MetaObject: helpers.ConvertObjectMeta(&ingress.ObjectMeta),
-				Ingress:    helpers.ConvertIngress(&ingress),
-			},
-		}
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	resource := kubernetesCSIDriverResource(csiDriver)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
 }
 
-func KubernetesJob(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	jobs, err := client.KubernetesClient.BatchV1().Jobs("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+func kubernetesCSIDriverResource(csiDriver *storagev1.CSIDriver) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	csiDriver.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("csidriver/%s", csiDriver.Name),
+		Name: csiDriver.Name,
+		Description: model.KubernetesCSIDriverDescription{
+			MetaObject: helpers.ConvertObjectMeta(&csiDriver.ObjectMeta),
+			CSIDriver:  helpers.ConvertCSIDriver(csiDriver),
+		},
 	}
+}
 
-	for _, job := range jobs.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
-		job.ManagedFields = nil
-		labelSelectorString := ""
-		ss, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
-		if err == nil {
-			labelSelectorString = ss.String()
-		}
-		resource = models.Resource{
-			ID:   fmt.Sprintf("job/%s/%s", job.Namespace, job.Name),
-			Name: fmt.Sprintf("%s/%s", job.Namespace, job.Name),
-			Description: model.KubernetesJobDescription{
-				MetaObject:          helpers.ConvertObjectMeta(&job.ObjectMeta),
-				Job:                 job,
-				LabelSelectorString: labelSelectorString,
-			},
-		}
+func KubernetesCSINode(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.StorageV1().CSINodes().List, func(csiNode *storagev1.CSINode) error {
+		resource := kubernetesCSINodeResource(csiNode)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesLimitRange(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	limitRanges, err := client.KubernetesClient.CoreV1().LimitRanges("").List(ctx, metav1.ListOptions{})
+func GetKubernetesCSINode(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "csinode")
 	if err != nil {
 		return nil, err
 	}
 
-	for _, limitRange := range limitRanges.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		limitRange.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("limitrange/%s/%s", limitRange.Namespace, limitRange.Name),
-			Name: fmt.Sprintf("%s/%s", limitRange.Namespace, limitRange.Name),
-			Description: model.KubernetesLimitRangeDescription{
-				MetaObject: helpers.ConvertObjectMeta(&limitRange.ObjectMeta),
-				LimitRange: helpers.ConvertLimitRange(&limitRange),
-			},
+	csiNode, err := client.KubernetesClient.StorageV1().CSINodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesCSINodeResource(csiNode)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesCSINodeResource(csiNode *storagev1.CSINode) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	csiNode.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("csinode/%s", csiNode.Name),
+		Name: csiNode.Name,
+		Description: model.KubernetesCSINodeDescription{
+			MetaObject: helpers.ConvertObjectMeta(&csiNode.ObjectMeta),
+			CSINode:    helpers.ConvertCSINode(csiNode),
+		},
+	}
+}
+
+func KubernetesCustomResource(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+	failures := make(map[string]string)
+
+	err := listClusterPaged(ctx, client.Namespaces, client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().List, func(customResourceDefinition *apiextensionsv1.CustomResourceDefinition) error {
+		version := customResourceDefinitionListVersion(customResourceDefinition)
+		if version == "" {
+			return nil
+		}
+		// use dynamic client to list resources of this crd
+		dynamicClient := client.DynamicClient.Resource(schema.GroupVersionResource{
+			Group:    customResourceDefinition.Spec.Group,
+			Version:  version,
+			Resource: customResourceDefinition.Spec.Names.Plural,
+		})
+
+		var streamErr error
+		handle := func(item *unstructured.Unstructured) error {
+			if !client.Namespaces.Allows(item.GetNamespace()) {
+				return nil
+			}
+			resource := kubernetesCustomResourceResource(customResourceDefinition.Name, item)
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					streamErr = fmt.Errorf("error streaming resource: %w", err)
+					return streamErr
+				}
+			} else {
+				allValues = append(allValues, resource)
+			}
+			return nil
+		}
+		var err error
+		if customResourceDefinition.Spec.Scope == apiextensionsv1.NamespaceScoped {
+			err = listNamespacedPaged(ctx, client.Namespaces, dynamicClient.Namespace, handle)
+		} else {
+			err = listPaged(ctx, dynamicClient.List, handle)
+		}
+		if streamErr != nil || ctx.Err() != nil {
+			return err
+		}
+		if err != nil {
+			// a single broken crd (e.g. a failing conversion webhook) must not hide the custom resources of the others
+			GetLoggerFromContext(ctx).Warn("failed to list custom resources, skipping the custom resource definition",
+				zap.String("crd", customResourceDefinition.Name), zap.String("version", version), zap.Error(err))
+			failures[customResourceDefinition.Name] = err.Error()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(failures) > 0 {
+		return allValues, &models.PartialDescribeError{Errors: failures}
+	}
+	return allValues, nil
+}
+
+// customResourceDefinitionListVersion returns the version the custom resources of a crd are listed in,
+// the storage version if it is served, otherwise the served version the API server prefers.
+// An empty string is returned if no version is served.
+func customResourceDefinitionListVersion(customResourceDefinition *apiextensionsv1.CustomResourceDefinition) string {
+	preferred := ""
+	for _, version := range customResourceDefinition.Spec.Versions {
+		if !version.Served {
+			continue
+		}
+		if version.Storage {
+			return version.Name
+		}
+		if preferred == "" || k8sversion.CompareKubeAwareVersionStrings(version.Name, preferred) > 0 {
+			preferred = version.Name
+		}
+	}
+	return preferred
+}
+
+func GetKubernetesCustomResource(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	kind, group, version, namespace, name, err := parseCustomResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	// find the crd of the custom resource to get its plural name
+	customResourceDefinitions, err := client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var customResourceDefinition *apiextensionsv1.CustomResourceDefinition
+	for i := range customResourceDefinitions.Items {
+		if customResourceDefinitions.Items[i].Spec.Group == group && customResourceDefinitions.Items[i].Spec.Names.Kind == kind {
+			customResourceDefinition = &customResourceDefinitions.Items[i]
+			break
+		}
+	}
+	if customResourceDefinition == nil {
+		return nil, fmt.Errorf("custom resource definition of kind %s in group %s not found", kind, group)
+	}
+
+	dynamicClient := client.DynamicClient.Resource(schema.GroupVersionResource{
+		Group:    group,
+		Version:  version,
+		Resource: customResourceDefinition.Spec.Names.Plural,
+	})
+	var item *unstructured.Unstructured
+	if namespace != "" {
+		item, err = dynamicClient.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		item, err = dynamicClient.Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesCustomResourceResource(customResourceDefinition.Name, item)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesCustomResourceResource(customResourceDefinitionName string, item *unstructured.Unstructured) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	item.SetManagedFields(nil)
+	// spec and status are conventions only, they are left empty if the object doesn't follow them
+	spec, _ := item.Object["spec"].(map[string]any)
+	status, _ := item.Object["status"].(map[string]any)
+	return models.Resource{
+		ID:   fmt.Sprintf("customresource/%s.%s/%s/%s", item.GetKind(), item.GetAPIVersion(), item.GetNamespace(), item.GetName()),
+		Name: fmt.Sprintf("%s/%s/%s", customResourceDefinitionName, item.GetNamespace(), item.GetName()),
+		Description: model.KubernetesCustomResourceDescription{
+			MetaObject:         helpers.ConvertUnstructuredObjectMeta(item),
+			CustomResource:     *item,
+			FullyQualifiedName: fmt.Sprintf("%s.%s", item.GetKind(), item.GetAPIVersion()),
+			Spec:               spec,
+			Status:             status,
+		},
+	}
+}
+
+func KubernetesCustomResourceDefinition(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().List, func(customResourceDefinition *apiextensionsv1.CustomResourceDefinition) error {
+		resource := kubernetesCustomResourceDefinitionResource(customResourceDefinition)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesCustomResourceDefinition(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "customresourcedefinition")
+	if err != nil {
+		return nil, err
+	}
+
+	customResourceDefinition, err := client.CrdsClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesCustomResourceDefinitionResource(customResourceDefinition)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesCustomResourceDefinitionResource(customResourceDefinition *apiextensionsv1.CustomResourceDefinition) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	customResourceDefinition.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("customresourcedefinition/%s", customResourceDefinition.Name),
+		Name: customResourceDefinition.Name,
+		Description: model.KubernetesCustomResourceDefinitionDescription{
+			MetaObject:               helpers.ConvertObjectMeta(&customResourceDefinition.ObjectMeta),
+			CustomResourceDefinition: helpers.ConvertCustomResourceDefinition(customResourceDefinition),
+		},
+	}
+}
+
+func KubernetesDaemonSet(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.AppsV1().DaemonSets, func(daemonSet *appsv1.DaemonSet) error {
+		if !client.Namespaces.Allows(daemonSet.Namespace) {
+			return nil
+		}
+		resource := kubernetesDaemonSetResource(daemonSet)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesDaemonSet(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "daemonset")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	daemonSet, err := client.KubernetesClient.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesDaemonSetResource(daemonSet)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesDaemonSetResource(daemonSet *appsv1.DaemonSet) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	daemonSet.ManagedFields = nil
+	labelSelectorString := ""
+	ss, err := metav1.LabelSelectorAsSelector(daemonSet.Spec.Selector)
+	if err == nil {
+		labelSelectorString = ss.String()
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("daemonset/%s/%s", daemonSet.Namespace, daemonSet.Name),
+		Name: fmt.Sprintf("%s/%s", daemonSet.Namespace, daemonSet.Name),
+		Description: model.KubernetesDaemonSetDescription{
+			MetaObject:          helpers.ConvertObjectMeta(&daemonSet.ObjectMeta),
+			DaemonSet:           helpers.ConvertDaemonSet(daemonSet),
+			LabelSelectorString: labelSelectorString,
+		},
+	}
+}
+
+func KubernetesDeployment(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.AppsV1().Deployments, func(deployment *appsv1.Deployment) error {
+		if !client.Namespaces.Allows(deployment.Namespace) {
+			return nil
+		}
+		resource := kubernetesDeploymentResource(deployment)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesDeployment(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "deployment")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	deployment, err := client.KubernetesClient.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesDeploymentResource(deployment)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesDeploymentResource(deployment *appsv1.Deployment) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	deployment.ManagedFields = nil
+	labelSelectorString := ""
+	ss, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err == nil {
+		labelSelectorString = ss.String()
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("deployment/%s/%s", deployment.Namespace, deployment.Name),
+		Name: fmt.Sprintf("%s/%s", deployment.Namespace, deployment.Name),
+		Description: model.KubernetesDeploymentDescription{
+			MetaObject:          helpers.ConvertObjectMeta(&deployment.ObjectMeta),
+			Deployment:          helpers.ConvertDeployment(deployment),
+			LabelSelectorString: labelSelectorString,
+		},
+	}
+}
+
+func KubernetesEndpointSlice(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.DiscoveryV1().EndpointSlices, func(endpointSlice *discoveryv1.EndpointSlice) error {
+		if !client.Namespaces.Allows(endpointSlice.Namespace) {
+			return nil
+		}
+		resource := kubernetesEndpointSliceResource(endpointSlice)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesEndpointSlice(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "endpointslice")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	endpointSlice, err := client.KubernetesClient.DiscoveryV1().EndpointSlices(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesEndpointSliceResource(endpointSlice)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesEndpointSliceResource(endpointSlice *discoveryv1.EndpointSlice) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	endpointSlice.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("endpointslice/%s/%s", endpointSlice.Namespace, endpointSlice.Name),
+		Name: fmt.Sprintf("%s/%s", endpointSlice.Namespace, endpointSlice.Name),
+		Description: model.KubernetesEndpointSliceDescription{
+			MetaObject:    helpers.ConvertObjectMeta(&endpointSlice.ObjectMeta),
+			EndpointSlice: helpers.ConvertEndpointSlice(endpointSlice),
+		},
+	}
+}
+
+func KubernetesEndpoint(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().Endpoints, func(endpoint *corev1.Endpoints) error {
+		if !client.Namespaces.Allows(endpoint.Namespace) {
+			return nil
+		}
+		resource := kubernetesEndpointResource(endpoint)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesEndpoint(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "endpoint")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	endpoint, err := client.KubernetesClient.CoreV1().Endpoints(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesEndpointResource(endpoint)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesEndpointResource(endpoint *corev1.Endpoints) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	endpoint.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("endpoint/%s/%s", endpoint.Namespace, endpoint.Name),
+		Name: fmt.Sprintf("%s/%s", endpoint.Namespace, endpoint.Name),
+		Description: model.KubernetesEndpointDescription{
+			MetaObject: helpers.ConvertObjectMeta(&endpoint.ObjectMeta),
+			Endpoint:   helpers.ConvertEndpoints(endpoint),
+		},
+	}
+}
+
+func KubernetesEvent(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().Events, func(event *corev1.Event) error {
+		if !client.Namespaces.Allows(event.Namespace) {
+			return nil
+		}
+		resource := kubernetesEventResource(event)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesEvent(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "event")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	event, err := client.KubernetesClient.CoreV1().Events(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesEventResource(event)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesEventResource(event *corev1.Event) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	event.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("event/%s/%s", event.Namespace, event.Name),
+		Name: fmt.Sprintf("%s/%s", event.Namespace, event.Name),
+		Description: model.KubernetesEventDescription{
+			MetaObject: helpers.ConvertObjectMeta(&event.ObjectMeta),
+			Event:      helpers.ConvertEvent(event),
+		},
+	}
+}
+
+func KubernetesFlowSchema(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.FlowcontrolV1().FlowSchemas().List, func(flowSchema *flowcontrolv1.FlowSchema) error {
+		resource := kubernetesFlowSchemaResource(flowSchema)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		// the API is not served by clusters older than the version it was introduced in
+		if apierrors.IsNotFound(err) {
+			return allValues, nil
+		}
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesFlowSchema(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "flowschema")
+	if err != nil {
+		return nil, err
+	}
+
+	flowSchema, err := client.KubernetesClient.FlowcontrolV1().FlowSchemas().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesFlowSchemaResource(flowSchema)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesFlowSchemaResource(flowSchema *flowcontrolv1.FlowSchema) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	flowSchema.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("flowschema/%s", flowSchema.Name),
+		Name: flowSchema.Name,
+		Description: model.KubernetesFlowSchemaDescription{
+			MetaObject: helpers.ConvertObjectMeta(&flowSchema.ObjectMeta),
+			FlowSchema: helpers.ConvertFlowSchema(flowSchema),
+		},
+	}
+}
+
+func KubernetesHelmRelease(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	helmReleases, err := listHelmReleases(client.HelmClient, client.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, helmRelease := range helmReleases {
+		if !client.Namespaces.Allows(helmRelease.Namespace) {
+			continue
+		}
+		resource := kubernetesHelmReleaseResource(helmRelease)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return allValues, fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesHelmRelease(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "helmrelease")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	actionConfig, err := newHelmActionConfig(client.HelmClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	helmRelease, err := action.NewGet(actionConfig).Run(name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesHelmReleaseResource(helmRelease)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesHelmReleaseResource(helmRelease *release.Release) models.Resource {
+	return models.Resource{
+		ID:   fmt.Sprintf("helmrelease/%s/%s", helmRelease.Namespace, helmRelease.Name),
+		Name: fmt.Sprintf("%s/%s", helmRelease.Namespace, helmRelease.Name),
+		Description: model.KubernetesHelmReleaseDescription{
+			HelmRelease: helpers.ConvertHelmRelease(helmRelease),
+		},
+	}
+}
+
+// newHelmActionConfig builds a helm action configuration bound to the namespace, an empty namespace means all namespaces.
+// The helm client storage is bound to a single namespace, so a fresh action configuration
+// is built from the client's rest client getter.
+func newHelmActionConfig(client helmclient.Client, namespace string) (*action.Configuration, error) {
+	helmClient, ok := client.(*helmclient.HelmClient)
+	if !ok {
+		return nil, fmt.Errorf("unsupported helm client type %T", client)
+	}
+
+	restClientGetter, ok := helmClient.ActionConfig.RESTClientGetter.(genericclioptions.RESTClientGetter)
+	if !ok {
+		return nil, fmt.Errorf("unsupported helm rest client getter type %T", helmClient.ActionConfig.RESTClientGetter)
+	}
+
+	actionConfig := new(action.Configuration)
+	err := actionConfig.Init(restClientGetter, namespace, os.Getenv("HELM_DRIVER"), helmClient.DebugLog)
+	if err != nil {
+		return nil, err
+	}
+	return actionConfig, nil
+}
+
+// listHelmReleases returns the latest revision of every helm release in the cluster,
+// or in each namespace of the scope if the credential is restricted to a set of namespaces.
+func listHelmReleases(client helmclient.Client, namespaces model.NamespaceFilter) ([]*release.Release, error) {
+	var releases []*release.Release
+	for _, namespace := range namespaces.ListNamespaces() {
+		actionConfig, err := newHelmActionConfig(client, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		listClient := action.NewList(actionConfig)
+		listClient.AllNamespaces = namespace == ""
+		listClient.StateMask = action.ListAll
+
+		namespaceReleases, err := listClient.Run()
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, namespaceReleases...)
+	}
+	return releases, nil
+}
+
+func KubernetesHorizontalPodAutoscaler(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.AutoscalingV1().HorizontalPodAutoscalers, func(horizontalPodAutoscaler *autoscalingv1.HorizontalPodAutoscaler) error {
+		if !client.Namespaces.Allows(horizontalPodAutoscaler.Namespace) {
+			return nil
+		}
+		resource := kubernetesHorizontalPodAutoscalerResource(horizontalPodAutoscaler)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesHorizontalPodAutoscaler(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "horizontalpodautoscaler")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	horizontalPodAutoscaler, err := client.KubernetesClient.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesHorizontalPodAutoscalerResource(horizontalPodAutoscaler)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesHorizontalPodAutoscalerResource(horizontalPodAutoscaler *autoscalingv1.HorizontalPodAutoscaler) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	horizontalPodAutoscaler.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("horizontalpodautoscaler/%s/%s", horizontalPodAutoscaler.Namespace, horizontalPodAutoscaler.Name),
+		Name: fmt.Sprintf("%s/%s", horizontalPodAutoscaler.Namespace, horizontalPodAutoscaler.Name),
+		Description: model.KubernetesHorizontalPodAutoscalerDescription{
+			MetaObject:              helpers.ConvertObjectMeta(&horizontalPodAutoscaler.ObjectMeta),
+			HorizontalPodAutoscaler: helpers.ConvertHorizontalPodAutoscaler(horizontalPodAutoscaler),
+		},
+	}
+}
+
+func KubernetesIngress(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.NetworkingV1().Ingresses, func(ingress *networkingv1.Ingress) error {
+		if !client.Namespaces.Allows(ingress.Namespace) {
+			return nil
+		}
+		resource := kubernetesIngressResource(ingress)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesIngress(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "ingress")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	ingress, err := client.KubernetesClient.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesIngressResource(ingress)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesIngressResource(ingress *networkingv1.Ingress) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	ingress.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("ingress/%s/%s", ingress.Namespace, ingress.Name),
+		Name: fmt.Sprintf("%s/%s", ingress.Namespace, ingress.Name),
+		Description: model.KubernetesIngressDescription{
+			MetaObject: helpers.ConvertObjectMeta(&ingress.ObjectMeta),
+			Ingress:    helpers.ConvertIngress(ingress),
+		},
+	}
+}
+
+func KubernetesIngressClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.NetworkingV1().IngressClasses().List, func(ingressClass *networkingv1.IngressClass) error {
+		resource := kubernetesIngressClassResource(ingressClass)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesIngressClass(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "ingressclass")
+	if err != nil {
+		return nil, err
+	}
+
+	ingressClass, err := client.KubernetesClient.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesIngressClassResource(ingressClass)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesIngressClassResource(ingressClass *networkingv1.IngressClass) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	ingressClass.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("ingressclass/%s", ingressClass.Name),
+		Name: ingressClass.Name,
+		Description: model.KubernetesIngressClassDescription{
+			MetaObject:   helpers.ConvertObjectMeta(&ingressClass.ObjectMeta),
+			IngressClass: helpers.ConvertIngressClass(ingressClass),
+		},
+	}
+}
+
+func KubernetesJob(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.BatchV1().Jobs, func(job *batchv1.Job) error {
+		if !client.Namespaces.Allows(job.Namespace) {
+			return nil
+		}
+		resource := kubernetesJobResource(job)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesJob(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "job")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	job, err := client.KubernetesClient.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesJobResource(job)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesJobResource(job *batchv1.Job) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
+	job.ManagedFields = nil
+	labelSelectorString := ""
+	ss, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err == nil {
+		labelSelectorString = ss.String()
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("job/%s/%s", job.Namespace, job.Name),
+		Name: fmt.Sprintf("%s/%s", job.Namespace, job.Name),
+		Description: model.KubernetesJobDescription{
+			MetaObject:          helpers.ConvertObjectMeta(&job.ObjectMeta),
+			Job:                 *job,
+			LabelSelectorString: labelSelectorString,
+		},
+	}
+}
+
+func KubernetesLease(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoordinationV1().Leases, func(lease *coordinationv1.Lease) error {
+		if !client.Namespaces.Allows(lease.Namespace) {
+			return nil
+		}
+		resource := kubernetesLeaseResource(lease)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesLease(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "lease")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	lease, err := client.KubernetesClient.CoordinationV1().Leases(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesLeaseResource(lease)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesLeaseResource(lease *coordinationv1.Lease) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	lease.ManagedFields = nil
+	convertedLease := helpers.ConvertLease(lease)
+	return models.Resource{
+		ID:   fmt.Sprintf("lease/%s/%s", lease.Namespace, lease.Name),
+		Name: fmt.Sprintf("%s/%s", lease.Namespace, lease.Name),
+		Description: model.KubernetesLeaseDescription{
+			MetaObject: helpers.ConvertObjectMeta(&lease.ObjectMeta),
+			Lease:      convertedLease,
+			ExpiresAt:  convertedLease.ExpiresAt(),
+			Stale:      convertedLease.IsStale(time.Now()),
+		},
+	}
+}
+
+func KubernetesLimitRange(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().LimitRanges, func(limitRange *corev1.LimitRange) error {
+		if !client.Namespaces.Allows(limitRange.Namespace) {
+			return nil
+		}
+		resource := kubernetesLimitRangeResource(limitRange)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesLimitRange(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "limitrange")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	limitRange, err := client.KubernetesClient.CoreV1().LimitRanges(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesLimitRangeResource(limitRange)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesLimitRangeResource(limitRange *corev1.LimitRange) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	limitRange.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("limitrange/%s/%s", limitRange.Namespace, limitRange.Name),
+		Name: fmt.Sprintf("%s/%s", limitRange.Namespace, limitRange.Name),
+		Description: model.KubernetesLimitRangeDescription{
+			MetaObject: helpers.ConvertObjectMeta(&limitRange.ObjectMeta),
+			LimitRange: helpers.ConvertLimitRange(limitRange),
+		},
+	}
+}
+
+func KubernetesMutatingWebhookConfiguration(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.AdmissionregistrationV1().MutatingWebhookConfigurations().List, func(mutatingWebhookConfiguration *admissionregistrationv1.MutatingWebhookConfiguration) error {
+		resource := kubernetesMutatingWebhookConfigurationResource(mutatingWebhookConfiguration)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesMutatingWebhookConfiguration(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "mutatingwebhookconfiguration")
+	if err != nil {
+		return nil, err
+	}
+
+	mutatingWebhookConfiguration, err := client.KubernetesClient.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesMutatingWebhookConfigurationResource(mutatingWebhookConfiguration)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesMutatingWebhookConfigurationResource(mutatingWebhookConfiguration *admissionregistrationv1.MutatingWebhookConfiguration) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	mutatingWebhookConfiguration.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("mutatingwebhookconfiguration/%s", mutatingWebhookConfiguration.Name),
+		Name: mutatingWebhookConfiguration.Name,
+		Description: model.KubernetesMutatingWebhookConfigurationDescription{
+			MetaObject:                   helpers.ConvertObjectMeta(&mutatingWebhookConfiguration.ObjectMeta),
+			MutatingWebhookConfiguration: helpers.ConvertMutatingWebhookConfiguration(mutatingWebhookConfiguration),
+		},
+	}
+}
+
+func KubernetesNamespace(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	handle := func(namespace *corev1.Namespace) error {
+		if !client.Namespaces.Allows(namespace.Name) {
+			return nil
+		}
+		resource := kubernetesNamespaceResource(namespace)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	}
+
+	// A credential restricted to a set of namespaces can't list the namespaces, they are got one by one
+	if client.Namespaces.IsScoped() {
+		for _, name := range client.Namespaces.ListNamespaces() {
+			namespace, err := client.KubernetesClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			if err := handle(namespace); err != nil {
+				return nil, err
+			}
+		}
+		return allValues, nil
+	}
+
+	err := listPaged(ctx, client.KubernetesClient.CoreV1().Namespaces().List, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesNamespace(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "namespace")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(name) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", name)
+	}
+
+	namespace, err := client.KubernetesClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesNamespaceResource(namespace)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesNamespaceResource(namespace *corev1.Namespace) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	namespace.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("namespace/%s", namespace.Name),
+		Name: namespace.Name,
+		Description: model.KubernetesNamespaceDescription{
+			MetaObject: helpers.ConvertObjectMeta(&namespace.ObjectMeta),
+			Namespace:  helpers.ConvertNamespace(namespace),
+		},
+	}
+}
+
+func KubernetesNetworkPolicy(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.NetworkingV1().NetworkPolicies, func(networkPolicy *networkingv1.NetworkPolicy) error {
+		if !client.Namespaces.Allows(networkPolicy.Namespace) {
+			return nil
+		}
+		resource := kubernetesNetworkPolicyResource(networkPolicy)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesNetworkPolicy(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "networkpolicy")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	networkPolicy, err := client.KubernetesClient.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesNetworkPolicyResource(networkPolicy)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesNetworkPolicyResource(networkPolicy *networkingv1.NetworkPolicy) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	networkPolicy.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("networkpolicy/%s/%s", networkPolicy.Namespace, networkPolicy.Name),
+		Name: fmt.Sprintf("%s/%s", networkPolicy.Namespace, networkPolicy.Name),
+		Description: model.KubernetesNetworkPolicyDescription{
+			MetaObject:    helpers.ConvertObjectMeta(&networkPolicy.ObjectMeta),
+			NetworkPolicy: helpers.ConvertNetworkPolicy(networkPolicy),
+		},
+	}
+}
+
+func KubernetesNode(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().Nodes().List, func(node *corev1.Node) error {
+		resource := kubernetesNodeResource(node)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesNode(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "node")
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := client.KubernetesClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesNodeResource(node)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesNodeResource(node *corev1.Node) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	node.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("node/%s", node.Name),
+		Name: node.Name,
+		Description: model.KubernetesNodeDescription{
+			MetaObject: helpers.ConvertObjectMeta(&node.ObjectMeta),
+			Node:       helpers.ConvertNode(node),
+		},
+	}
+}
+
+func KubernetesPersistentVolume(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().PersistentVolumes().List, func(pv *corev1.PersistentVolume) error {
+		resource := kubernetesPersistentVolumeResource(pv)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesPersistentVolume(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "persistentvolume")
+	if err != nil {
+		return nil, err
+	}
+
+	pv, err := client.KubernetesClient.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesPersistentVolumeResource(pv)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPersistentVolumeResource(pv *corev1.PersistentVolume) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	pv.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("persistentvolume/%s", pv.Name),
+		Name: pv.Name,
+		Description: model.KubernetesPersistentVolumeDescription{
+			MetaObject: helpers.ConvertObjectMeta(&pv.ObjectMeta),
+			PV:         helpers.ConvertPersistentVolume(pv),
+		},
+	}
+}
+
+func KubernetesPersistentVolumeClaim(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().PersistentVolumeClaims, func(pvc *corev1.PersistentVolumeClaim) error {
+		if !client.Namespaces.Allows(pvc.Namespace) {
+			return nil
+		}
+		resource := kubernetesPersistentVolumeClaimResource(pvc)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesNamespace(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesPersistentVolumeClaim(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "persistentvolumeclaim")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	namespaces, err := client.KubernetesClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	pvc, err := client.KubernetesClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, namespace := range namespaces.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		namespace.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("namespace/%s", namespace.Name),
-			Name: namespace.Name,
-			Description: model.KubernetesNamespaceDescription{
-				MetaObject: helpers.ConvertObjectMeta(&namespace.ObjectMeta),
-				Namespace:  helpers.ConvertNamespace(&namespace),
-			},
+	resource := kubernetesPersistentVolumeClaimResource(pvc)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPersistentVolumeClaimResource(pvc *corev1.PersistentVolumeClaim) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	pvc.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("persistentvolumeclaim/%s/%s", pvc.Namespace, pvc.Name),
+		Name: fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name),
+		Description: model.KubernetesPersistentVolumeClaimDescription{
+			MetaObject: helpers.ConvertObjectMeta(&pvc.ObjectMeta),
+			PVC:        helpers.ConvertPersistentVolumeClaim(pvc),
+		},
+	}
+}
+
+func KubernetesPod(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().Pods, func(pod *corev1.Pod) error {
+		if !client.Namespaces.Allows(pod.Namespace) {
+			return nil
 		}
+		resource := kubernetesPodResource(pod)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesNetworkPolicy(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesPod(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "pod")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	networkPolicies, err := client.KubernetesClient.NetworkingV1().NetworkPolicies("").List(ctx, metav1.ListOptions{})
+	pod, err := client.KubernetesClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, networkPolicy := range networkPolicies.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		networkPolicy.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("networkpolicy/%s/%s", networkPolicy.Namespace, networkPolicy.Name),
-			Name: fmt.Sprintf("%s/%s", networkPolicy.Namespace, networkPolicy.Name),
-			Description: model.KubernetesNetworkPolicyDescription{
-				MetaObject:    helpers.ConvertObjectMeta(&networkPolicy.ObjectMeta),
-				NetworkPolicy: helpers.ConvertNetworkPolicy(&networkPolicy),
-			},
+	resource := kubernetesPodResource(pod)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPodResource(pod *corev1.Pod) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	pod.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("pod/%s/%s", pod.Namespace, pod.Name),
+		Name: fmt.Sprintf("%s/%s", pod.Namespace, pod.Name),
+		Description: model.KubernetesPodDescription{
+			MetaObject: helpers.ConvertObjectMeta(&pod.ObjectMeta),
+			Pod:        helpers.ConvertPod(pod),
+		},
+	}
+}
+
+func KubernetesPodDisruptionBudget(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.PolicyV1().PodDisruptionBudgets, func(podDisruptionBudget *policyv1.PodDisruptionBudget) error {
+		if !client.Namespaces.Allows(podDisruptionBudget.Namespace) {
+			return nil
 		}
+		resource := kubernetesPodDisruptionBudgetResource(podDisruptionBudget)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesNode(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesPodDisruptionBudget(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "poddisruptionbudget")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	// list nodes
-	nodes, err := client.KubernetesClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	podDisruptionBudget, err := client.KubernetesClient.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, node := range nodes.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		node.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("node/%s", node.Name),
-			Name: node.Name,
-			Description: model.KubernetesNodeDescription{
-				MetaObject: helpers.ConvertObjectMeta(&node.ObjectMeta),
-				Node:       helpers.ConvertNode(&node),
-			},
+	resource := kubernetesPodDisruptionBudgetResource(podDisruptionBudget)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
 
+func kubernetesPodDisruptionBudgetResource(podDisruptionBudget *policyv1.PodDisruptionBudget) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
+	podDisruptionBudget.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("poddisruptionbudget/%s/%s", podDisruptionBudget.Namespace, podDisruptionBudget.Name),
+		Name: fmt.Sprintf("%s/%s", podDisruptionBudget.Namespace, podDisruptionBudget.Name),
+		Description: model.KubernetesPodDisruptionBudgetDescription{
+			MetaObject:          helpers.ConvertObjectMeta(&podDisruptionBudget.ObjectMeta),
+			PodDisruptionBudget: helpers.ConvertPodDisruptionBudget(podDisruptionBudget),
+		},
+	}
+}
+
+func KubernetesPodTemplate(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().PodTemplates, func(podTemplate *corev1.PodTemplate) error {
+		if !client.Namespaces.Allows(podTemplate.Namespace) {
+			return nil
+		}
+		resource := kubernetesPodTemplateResource(podTemplate)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesPersistentVolume(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+func GetKubernetesPodTemplate(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "podtemplate")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	podTemplate, err := client.KubernetesClient.CoreV1().PodTemplates(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesPodTemplateResource(podTemplate)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPodTemplateResource(podTemplate *corev1.PodTemplate) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
+	podTemplate.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("podtemplate/%s/%s", podTemplate.Namespace, podTemplate.Name),
+		Name: fmt.Sprintf("%s/%s", podTemplate.Namespace, podTemplate.Name),
+		Description: model.KubernetesPodTemplateDescription{
+			MetaObject:  helpers.ConvertObjectMeta(&podTemplate.ObjectMeta),
+			PodTemplate: helpers.ConvertPodTemplate(podTemplate),
+		},
+	}
+}
+
+func KubernetesPriorityClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
 	var allValues []models.Resource
 
-	// list persistent volumes
-	pvs, err := client.KubernetesClient.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.SchedulingV1().PriorityClasses().List, func(priorityClass *schedulingv1.PriorityClass) error {
+		resource := kubernetesPriorityClassResource(priorityClass)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, pv := range pvs.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		pv.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("persistentvolume/%s", pv.Name),
-			Name: pv.Name,
-			Description: model.KubernetesPersistentVolumeDescription{
-				MetaObject: helpers.ConvertObjectMeta(&pv.ObjectMeta),
-				PV:         helpers.ConvertPersistentVolume(&pv),
-			},
+	return allValues, nil
+}
+
+func GetKubernetesPriorityClass(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "priorityclass")
+	if err != nil {
+		return nil, err
+	}
+
+	priorityClass, err := client.KubernetesClient.SchedulingV1().PriorityClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesPriorityClassResource(priorityClass)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPriorityClassResource(priorityClass *schedulingv1.PriorityClass) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	priorityClass.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("priorityclass/%s", priorityClass.Name),
+		Name: priorityClass.Name,
+		Description: model.KubernetesPriorityClassDescription{
+			MetaObject:    helpers.ConvertObjectMeta(&priorityClass.ObjectMeta),
+			PriorityClass: helpers.ConvertPriorityClass(priorityClass),
+		},
+	}
+}
 
+func KubernetesPriorityLevelConfiguration(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.FlowcontrolV1().PriorityLevelConfigurations().List, func(priorityLevelConfiguration *flowcontrolv1.PriorityLevelConfiguration) error {
+		resource := kubernetesPriorityLevelConfigurationResource(priorityLevelConfiguration)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		// the API is not served by clusters older than the version it was introduced in
+		if apierrors.IsNotFound(err) {
+			return allValues, nil
+		}
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesPersistentVolumeClaim(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+func GetKubernetesPriorityLevelConfiguration(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "prioritylevelconfiguration")
+	if err != nil {
+		return nil, err
+	}
+
+	priorityLevelConfiguration, err := client.KubernetesClient.FlowcontrolV1().PriorityLevelConfigurations().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesPriorityLevelConfigurationResource(priorityLevelConfiguration)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPriorityLevelConfigurationResource(priorityLevelConfiguration *flowcontrolv1.PriorityLevelConfiguration) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	priorityLevelConfiguration.ManagedFields = nil
+	convertedPriorityLevelConfiguration := helpers.ConvertPriorityLevelConfiguration(priorityLevelConfiguration)
+	return models.Resource{
+		ID:   fmt.Sprintf("prioritylevelconfiguration/%s", priorityLevelConfiguration.Name),
+		Name: priorityLevelConfiguration.Name,
+		Description: model.KubernetesPriorityLevelConfigurationDescription{
+			MetaObject:                 helpers.ConvertObjectMeta(&priorityLevelConfiguration.ObjectMeta),
+			PriorityLevelConfiguration: convertedPriorityLevelConfiguration,
+			NominalConcurrencyShares:   convertedPriorityLevelConfiguration.NominalConcurrencyShares(),
+		},
+	}
+}
+
+func KubernetesReplicaSet(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
 	var allValues []models.Resource
 
-	pvcs, err := client.KubernetesClient.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{})
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.AppsV1().ReplicaSets, func(replicaSet *appsv1.ReplicaSet) error {
+		if !client.Namespaces.Allows(replicaSet.Namespace) {
+			return nil
+		}
+		resource := kubernetesReplicaSetResource(replicaSet)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesReplicaSet(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "replicaset")
 	if err != nil {
 		return nil, err
 	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	for _, pvc := range pvcs.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		pvc.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("persistentvolumeclaim/%s/%s", pvc.Namespace, pvc.Name),
-			Name: fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name),
-			Description: model.KubernetesPersistentVolumeClaimDescription{
-				MetaObject: helpers.ConvertObjectMeta(&pvc.ObjectMeta),
-				PVC:        helpers.ConvertPersistentVolumeClaim(&pvc),
-			},
+	replicaSet, err := client.KubernetesClient.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesReplicaSetResource(replicaSet)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesReplicaSetResource(replicaSet *appsv1.ReplicaSet) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
+	replicaSet.ManagedFields = nil
+	labelSelectorString := ""
+	ss, err := metav1.LabelSelectorAsSelector(replicaSet.Spec.Selector)
+	if err == nil {
+		labelSelectorString = ss.String()
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("replicaset/%s/%s", replicaSet.Namespace, replicaSet.Name),
+		Name: fmt.Sprintf("%s/%s", replicaSet.Namespace, replicaSet.Name),
+		Description: model.KubernetesReplicaSetDescription{
+			MetaObject:          helpers.ConvertObjectMeta(&replicaSet.ObjectMeta),
+			ReplicaSet:          helpers.ConvertReplicaSet(replicaSet),
+			LabelSelectorString: labelSelectorString,
+		},
+	}
+}
+
+func KubernetesReplicationController(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().ReplicationControllers, func(replicationController *corev1.ReplicationController) error {
+		if !client.Namespaces.Allows(replicationController.Namespace) {
+			return nil
+		}
+		resource := kubernetesReplicationControllerResource(replicationController)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesPod(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+func GetKubernetesReplicationController(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "replicationcontroller")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	replicationController, err := client.KubernetesClient.CoreV1().ReplicationControllers(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesReplicationControllerResource(replicationController)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesReplicationControllerResource(replicationController *corev1.ReplicationController) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
+	replicationController.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("replicationcontroller/%s/%s", replicationController.Namespace, replicationController.Name),
+		Name: fmt.Sprintf("%s/%s", replicationController.Namespace, replicationController.Name),
+		Description: model.KubernetesReplicationControllerDescription{
+			MetaObject:            helpers.ConvertObjectMeta(&replicationController.ObjectMeta),
+			ReplicationController: helpers.ConvertReplicationController(replicationController),
+		},
+	}
+}
+
+func KubernetesResourceQuota(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
 	var allValues []models.Resource
 
-	pods, err := client.KubernetesClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().ResourceQuotas, func(resourceQuota *corev1.ResourceQuota) error {
+		if !client.Namespaces.Allows(resourceQuota.Namespace) {
+			return nil
+		}
+		resource := kubernetesResourceQuotaResource(resourceQuota)
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesResourceQuota(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "resourcequota")
 	if err != nil {
 		return nil, err
 	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	resourceQuota, err := client.KubernetesClient.CoreV1().ResourceQuotas(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource := kubernetesResourceQuotaResource(resourceQuota)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesResourceQuotaResource(resourceQuota *corev1.ResourceQuota) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
+	resourceQuota.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("resourcequota/%s/%s", resourceQuota.Namespace, resourceQuota.Name),
+		Name: fmt.Sprintf("%s/%s", resourceQuota.Namespace, resourceQuota.Name),
+		Description: model.KubernetesResourceQuotaDescription{
+			MetaObject:    helpers.ConvertObjectMeta(&resourceQuota.ObjectMeta),
+			ResourceQuota: helpers.ConvertResourceQuota(resourceQuota),
+		},
+	}
+}
+
+func KubernetesRole(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
-	for _, pod := range pods.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		pod.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("pod/%s/%s", pod.Namespace, pod.Name),
-			Name: fmt.Sprintf("%s/%s", pod.Namespace, pod.Name),
-			Description: model.KubernetesPodDescription{
-				MetaObject: helpers.ConvertObjectMeta(&pod.ObjectMeta),
-				Pod:        helpers.ConvertPod(&pod),
-			},
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.RbacV1().Roles, func(role *rbacv1.Role) error {
+		if !client.Namespaces.Allows(role.Namespace) {
+			return nil
 		}
-
+		resource := kubernetesRoleResource(role)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesPodDisruptionBudget(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesRole(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "role")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	podDisruptionBudgets, err := client.KubernetesClient.PolicyV1().PodDisruptionBudgets("").List(ctx, metav1.ListOptions{})
+	role, err := client.KubernetesClient.RbacV1().Roles(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, podDisruptionBudget := range podDisruptionBudgets.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
-		podDisruptionBudget.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("poddisruptionbudget/%s/%s", podDisruptionBudget.Namespace, podDisruptionBudget.Name),
-			Name: fmt.Sprintf("%s/%s", podDisruptionBudget.Namespace, podDisruptionBudget.Name),
-			Description: model.KubernetesPodDisruptionBudgetDescription{
-				MetaObject:          helpers.ConvertObjectMeta(&podDisruptionBudget.ObjectMeta),
-				PodDisruptionBudget: helpers.ConvertPodDisruptionBudget(&podDisruptionBudget),
-			},
+	resource := kubernetesRoleResource(role)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesRoleResource(role *rbacv1.Role) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search
+	role.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("role/%s/%s", role.Namespace, role.Name),
+		Name: fmt.Sprintf("%s/%s", role.Namespace, role.Name),
+		Description: model.KubernetesRoleDescription{
+			MetaObject: helpers.ConvertObjectMeta(&role.ObjectMeta),
+			Role:       helpers.ConvertRole(role),
+		},
+	}
+}
+
+func KubernetesRoleBinding(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.RbacV1().RoleBindings, func(roleBinding *rbacv1.RoleBinding) error {
+		if !client.Namespaces.Allows(roleBinding.Namespace) {
+			return nil
+		}
+		resource := kubernetesRoleBindingResource(roleBinding)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesPodTemplate(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesRoleBinding(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "rolebinding")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	podTemplates, err := client.KubernetesClient.CoreV1().PodTemplates("").List(ctx, metav1.ListOptions{})
+	roleBinding, err := client.KubernetesClient.RbacV1().RoleBindings(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, podTemplate := range podTemplates.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
-		podTemplate.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("podtemplate/%s/%s", podTemplate.Namespace, podTemplate.Name),
-			Name: fmt.Sprintf("%s/%s", podTemplate.Namespace, podTemplate.Name),
-			Description: model.KubernetesPodTemplateDescription{
-				MetaObject:  helpers.ConvertObjectMeta(&podTemplate.ObjectMeta),
-				PodTemplate: helpers.ConvertPodTemplate(&podTemplate),
-			},
+	resource := kubernetesRoleBindingResource(roleBinding)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesRoleBindingResource(roleBinding *rbacv1.RoleBinding) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search
+	roleBinding.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("rolebinding/%s/%s", roleBinding.Namespace, roleBinding.Name),
+		Name: fmt.Sprintf("%s/%s", roleBinding.Namespace, roleBinding.Name),
+		Description: model.KubernetesRoleBindingDescription{
+			MetaObject:  helpers.ConvertObjectMeta(&roleBinding.ObjectMeta),
+			RoleBinding: helpers.ConvertRoleBinding(roleBinding),
+		},
+	}
+}
 
+func KubernetesRuntimeClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.NodeV1().RuntimeClasses().List, func(runtimeClass *nodev1.RuntimeClass) error {
+		resource := kubernetesRuntimeClassResource(runtimeClass)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesReplicaSet(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesRuntimeClass(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "runtimeclass")
+	if err != nil {
+		return nil, err
+	}
 
-	replicaSets, err := client.KubernetesClient.AppsV1().ReplicaSets("").List(ctx, metav1.ListOptions{})
+	runtimeClass, err := client.KubernetesClient.NodeV1().RuntimeClasses().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, replicaSet := range replicaSets.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
-		replicaSet.ManagedFields = nil
-		labelSelectorString := ""
-		ss, err := metav1.LabelSelectorAsSelector(replicaSet.Spec.Selector)
-		if err == nil {
-			labelSelectorString = ss.String()
-		}
-		resource = models.Resource{
-			ID:   fmt.Sprintf("replicaset/%s/%s", replicaSet.Namespace, replicaSet.Name),
-			Name: fmt.Sprintf("%s/%s", replicaSet.Namespace, replicaSet.Name),
-			Description: model.KubernetesReplicaSetDescription{
-				MetaObject:          helpers.ConvertObjectMeta(&replicaSet.ObjectMeta),
-				ReplicaSet:          helpers.ConvertReplicaSet(&replicaSet),
-				LabelSelectorString: labelSelectorString,
-			},
+	resource := kubernetesRuntimeClassResource(runtimeClass)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesRuntimeClassResource(runtimeClass *nodev1.RuntimeClass) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	runtimeClass.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("runtimeclass/%s", runtimeClass.Name),
+		Name: runtimeClass.Name,
+		Description: model.KubernetesRuntimeClassDescription{
+			MetaObject:   helpers.ConvertObjectMeta(&runtimeClass.ObjectMeta),
+			RuntimeClass: helpers.ConvertRuntimeClass(runtimeClass),
+		},
+	}
+}
 
+func KubernetesSecret(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().Secrets, func(secret *corev1.Secret) error {
+		if !client.Namespaces.Allows(secret.Namespace) {
+			return nil
+		}
+		resource := kubernetesSecretResource(secret)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesReplicationController(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesSecret(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "secret")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	replicationControllers, err := client.KubernetesClient.CoreV1().ReplicationControllers("").List(ctx, metav1.ListOptions{})
+	secret, err := client.KubernetesClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, replicationController := range replicationControllers.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
-		replicationController.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("replicationcontroller/%s/%s", replicationController.Namespace, replicationController.Name),
-			Name: fmt.Sprintf("%s/%s", replicationController.Namespace, replicationController.Name),
-			Description: model.KubernetesReplicationControllerDescription{
-				MetaObject:            helpers.ConvertObjectMeta(&replicationController.ObjectMeta),
-				ReplicationController: helpers.ConvertReplicationController(&replicationController),
-			},
+	resource := kubernetesSecretResource(secret)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesSecretResource(secret *corev1.Secret) models.Resource {
+	secret.StringData = nil
+	dataNumber := len(secret.Data)
+	secret.Data = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("secret/%s/%s", secret.Namespace, secret.Name),
+		Name: fmt.Sprintf("%s/%s", secret.Namespace, secret.Name),
+		Description: model.KubernetesSecretDescription{
+			MetaObject: helpers.ConvertObjectMeta(&secret.ObjectMeta),
+			Secret:     helpers.ConvertSecret(secret),
+			DataNumber: dataNumber,
+		},
+	}
+}
+
+func KubernetesService(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().Services, func(service *corev1.Service) error {
+		if !client.Namespaces.Allows(service.Namespace) {
+			return nil
+		}
+		resource := kubernetesServiceResource(service)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesResourceQuota(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesService(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "service")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	resourceQuotas, err := client.KubernetesClient.CoreV1().ResourceQuotas("").List(ctx, metav1.ListOptions{})
+	service, err := client.KubernetesClient.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, resourceQuota := range resourceQuotas.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping
-		resourceQuota.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("resourcequota/%s/%s", resourceQuota.Namespace, resourceQuota.Name),
-			Name: fmt.Sprintf("%s/%s", resourceQuota.Namespace, resourceQuota.Name),
-			Description: model.KubernetesResourceQuotaDescription{
-				MetaObject:    helpers.ConvertObjectMeta(&resourceQuota.ObjectMeta),
-				ResourceQuota: helpers.ConvertResourceQuota(&resourceQuota),
-			},
+	resource := kubernetesServiceResource(service)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesServiceResource(service *corev1.Service) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	service.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("service/%s/%s", service.Namespace, service.Name),
+		Name: fmt.Sprintf("%s/%s", service.Namespace, service.Name),
+		Description: model.KubernetesServiceDescription{
+			MetaObject: helpers.ConvertObjectMeta(&service.ObjectMeta),
+			Service:    helpers.ConvertService(service),
+		},
+	}
+}
 
+func KubernetesServiceAccount(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.CoreV1().ServiceAccounts, func(serviceAccount *corev1.ServiceAccount) error {
+		if !client.Namespaces.Allows(serviceAccount.Namespace) {
+			return nil
+		}
+		resource := kubernetesServiceAccountResource(serviceAccount)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesRole(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesServiceAccount(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "serviceaccount")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	roles, err := client.KubernetesClient.RbacV1().Roles("").List(ctx, metav1.ListOptions{})
+	serviceAccount, err := client.KubernetesClient.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, role := range roles.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search
-		role.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("role/%s/%s", role.Namespace, role.Name),
-			Name: fmt.Sprintf("%s/%s", role.Namespace, role.Name),
-			Description: model.KubernetesRoleDescription{
-				MetaObject: helpers.ConvertObjectMeta(&role.ObjectMeta),
-				Role:       helpers.ConvertRole(&role),
-			},
+	resource := kubernetesServiceAccountResource(serviceAccount)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesServiceAccountResource(serviceAccount *corev1.ServiceAccount) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	serviceAccount.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("serviceaccount/%s/%s", serviceAccount.Namespace, serviceAccount.Name),
+		Name: fmt.Sprintf("%s/%s", serviceAccount.Namespace, serviceAccount.Name),
+		Description: model.KubernetesServiceAccountDescription{
+			MetaObject:     helpers.ConvertObjectMeta(&serviceAccount.ObjectMeta),
+			ServiceAccount: helpers.ConvertServiceAccount(serviceAccount),
+		},
+	}
+}
+
+func KubernetesStatefulSet(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listNamespacedPaged(ctx, client.Namespaces, client.KubernetesClient.AppsV1().StatefulSets, func(statefulSet *appsv1.StatefulSet) error {
+		if !client.Namespaces.Allows(statefulSet.Namespace) {
+			return nil
+		}
+		resource := kubernetesStatefulSetResource(statefulSet)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesRoleBinding(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesStatefulSet(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "statefulset")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
 
-	roleBindings, err := client.KubernetesClient.RbacV1().RoleBindings("").List(ctx, metav1.ListOptions{})
+	statefulSet, err := client.KubernetesClient.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, roleBinding := range roleBindings.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search
-		roleBinding.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("rolebinding/%s/%s", roleBinding.Namespace, roleBinding.Name),
-			Name: fmt.Sprintf("%s/%s", roleBinding.Namespace, roleBinding.Name),
-			Description: model.KubernetesRoleBindingDescription{
-				MetaObject:  helpers.ConvertObjectMeta(&roleBinding.ObjectMeta),
-				RoleBinding: helpers.ConvertRoleBinding(&roleBinding),
-			},
+	resource := kubernetesStatefulSetResource(statefulSet)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesStatefulSetResource(statefulSet *appsv1.StatefulSet) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	statefulSet.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("statefulset/%s/%s", statefulSet.Namespace, statefulSet.Name),
+		Name: fmt.Sprintf("%s/%s", statefulSet.Namespace, statefulSet.Name),
+		Description: model.KubernetesStatefulSetDescription{
+			MetaObject:  helpers.ConvertObjectMeta(&statefulSet.ObjectMeta),
+			StatefulSet: helpers.ConvertStatefulSet(statefulSet),
+		},
+	}
+}
 
+func KubernetesStorageClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.StorageV1().StorageClasses().List, func(storageClass *storagev1.StorageClass) error {
+		resource := kubernetesStorageClassResource(storageClass)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesSecret(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesStorageClass(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "storageclass")
+	if err != nil {
+		return nil, err
+	}
 
-	secrets, err := client.KubernetesClient.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
+	storageClass, err := client.KubernetesClient.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, secret := range secrets.Items {
-		secret.StringData = nil
-		dataNumber := len(secret.Data)
-		secret.Data = nil
-		var resource models.Resource
-		resource = models.Resource{
-			ID:   fmt.Sprintf("secret/%s/%s", secret.Namespace, secret.Name),
-			Name: fmt.Sprintf("%s/%s", secret.Namespace, secret.Name),
-			Description: model.KubernetesSecretDescription{
-				MetaObject: helpers.ConvertObjectMeta(&secret.ObjectMeta),
-				Secret:     helpers.ConvertSecret(&secret),
-				DataNumber: dataNumber,
-			},
+	resource := kubernetesStorageClassResource(storageClass)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesStorageClassResource(storageClass *storagev1.StorageClass) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search
+	storageClass.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("storageclass/%s", storageClass.Name),
+		Name: storageClass.Name,
+		Description: model.KubernetesStorageClassDescription{
+			MetaObject:   helpers.ConvertObjectMeta(&storageClass.ObjectMeta),
+			StorageClass: helpers.ConvertStorageClass(storageClass),
+		},
+	}
+}
 
+func KubernetesValidatingAdmissionPolicy(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.AdmissionregistrationV1().ValidatingAdmissionPolicies().List, func(validatingAdmissionPolicy *admissionregistrationv1.ValidatingAdmissionPolicy) error {
+		resource := kubernetesValidatingAdmissionPolicyResource(validatingAdmissionPolicy)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		// the API is not served by clusters older than the version it was introduced in
+		if apierrors.IsNotFound(err) {
+			return allValues, nil
+		}
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesService(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesValidatingAdmissionPolicy(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "validatingadmissionpolicy")
+	if err != nil {
+		return nil, err
+	}
 
-	services, err := client.KubernetesClient.CoreV1().Services("").List(ctx, metav1.ListOptions{})
+	validatingAdmissionPolicy, err := client.KubernetesClient.AdmissionregistrationV1().ValidatingAdmissionPolicies().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, service := range services.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		service.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("service/%s/%s", service.Namespace, service.Name),
-			Name: fmt.Sprintf("%s/%s", service.Namespace, service.Name),
-			Description: model.KubernetesServiceDescription{
-				MetaObject: helpers.ConvertObjectMeta(&service.ObjectMeta),
-				Service:    helpers.ConvertService(&service),
-			},
+	resource := kubernetesValidatingAdmissionPolicyResource(validatingAdmissionPolicy)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesValidatingAdmissionPolicyResource(validatingAdmissionPolicy *admissionregistrationv1.ValidatingAdmissionPolicy) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	validatingAdmissionPolicy.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("validatingadmissionpolicy/%s", validatingAdmissionPolicy.Name),
+		Name: validatingAdmissionPolicy.Name,
+		Description: model.KubernetesValidatingAdmissionPolicyDescription{
+			MetaObject:                helpers.ConvertObjectMeta(&validatingAdmissionPolicy.ObjectMeta),
+			ValidatingAdmissionPolicy: helpers.ConvertValidatingAdmissionPolicy(validatingAdmissionPolicy),
+		},
+	}
+}
 
+func KubernetesValidatingAdmissionPolicyBinding(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().List, func(validatingAdmissionPolicyBinding *admissionregistrationv1.ValidatingAdmissionPolicyBinding) error {
+		resource := kubernetesValidatingAdmissionPolicyBindingResource(validatingAdmissionPolicyBinding)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		// the API is not served by clusters older than the version it was introduced in
+		if apierrors.IsNotFound(err) {
+			return allValues, nil
+		}
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesServiceAccount(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesValidatingAdmissionPolicyBinding(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "validatingadmissionpolicybinding")
+	if err != nil {
+		return nil, err
+	}
 
-	serviceAccounts, err := client.KubernetesClient.CoreV1().ServiceAccounts("").List(ctx, metav1.ListOptions{})
+	validatingAdmissionPolicyBinding, err := client.KubernetesClient.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, serviceAccount := range serviceAccounts.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		serviceAccount.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("serviceaccount/%s/%s", serviceAccount.Namespace, serviceAccount.Name),
-			Name: fmt.Sprintf("%s/%s", serviceAccount.Namespace, serviceAccount.Name),
-			Description: model.KubernetesServiceAccountDescription{
-				MetaObject:     helpers.ConvertObjectMeta(&serviceAccount.ObjectMeta),
-				ServiceAccount: helpers.ConvertServiceAccount(&serviceAccount),
-			},
+	resource := kubernetesValidatingAdmissionPolicyBindingResource(validatingAdmissionPolicyBinding)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesValidatingAdmissionPolicyBindingResource(validatingAdmissionPolicyBinding *admissionregistrationv1.ValidatingAdmissionPolicyBinding) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	validatingAdmissionPolicyBinding.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("validatingadmissionpolicybinding/%s", validatingAdmissionPolicyBinding.Name),
+		Name: validatingAdmissionPolicyBinding.Name,
+		Description: model.KubernetesValidatingAdmissionPolicyBindingDescription{
+			MetaObject:                       helpers.ConvertObjectMeta(&validatingAdmissionPolicyBinding.ObjectMeta),
+			ValidatingAdmissionPolicyBinding: helpers.ConvertValidatingAdmissionPolicyBinding(validatingAdmissionPolicyBinding),
+		},
+	}
+}
 
+func KubernetesValidatingWebhookConfiguration(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().List, func(validatingWebhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+		resource := kubernetesValidatingWebhookConfigurationResource(validatingWebhookConfiguration)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesStatefulSet(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
+func GetKubernetesValidatingWebhookConfiguration(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "validatingwebhookconfiguration")
+	if err != nil {
+		return nil, err
+	}
 
-	statefulSets, err := client.KubernetesClient.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{})
+	validatingWebhookConfiguration, err := client.KubernetesClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, statefulSet := range statefulSets.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
-		statefulSet.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("statefulset/%s/%s", statefulSet.Namespace, statefulSet.Name),
-			Name: fmt.Sprintf("%s/%s", statefulSet.Namespace, statefulSet.Name),
-			Description: model.KubernetesStatefulSetDescription{
-				MetaObject:  helpers.ConvertObjectMeta(&statefulSet.ObjectMeta),
-				StatefulSet: helpers.ConvertStatefulSet(&statefulSet),
-			},
+	resource := kubernetesValidatingWebhookConfigurationResource(validatingWebhookConfiguration)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesValidatingWebhookConfigurationResource(validatingWebhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	validatingWebhookConfiguration.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("validatingwebhookconfiguration/%s", validatingWebhookConfiguration.Name),
+		Name: validatingWebhookConfiguration.Name,
+		Description: model.KubernetesValidatingWebhookConfigurationDescription{
+			MetaObject:                     helpers.ConvertObjectMeta(&validatingWebhookConfiguration.ObjectMeta),
+			ValidatingWebhookConfiguration: helpers.ConvertValidatingWebhookConfiguration(validatingWebhookConfiguration),
+		},
+	}
+}
+
+func KubernetesVolumeAttachment(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
 
+	err := listClusterPaged(ctx, client.Namespaces, client.KubernetesClient.StorageV1().VolumeAttachments().List, func(volumeAttachment *storagev1.VolumeAttachment) error {
+		resource := kubernetesVolumeAttachmentResource(volumeAttachment)
 		if stream != nil {
 			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
+				return fmt.Errorf("error streaming resource: %w", err)
 			}
 		} else {
 			allValues = append(allValues, resource)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allValues, nil
 }
 
-func KubernetesStorageClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
-	var allValues []models.Resource
-
-	storageClasses, err := client.KubernetesClient.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+func GetKubernetesVolumeAttachment(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "volumeattachment")
 	if err != nil {
 		return nil, err
 	}
 
-	for _, storageClass := range storageClasses.Items {
-		var resource models.Resource
-		// We don't need to include the managed fields in the description, also it causes issues in elastic search
-		storageClass.ManagedFields = nil
-		resource = models.Resource{
-			ID:   fmt.Sprintf("storageclass/%s", storageClass.Name),
-			Name: storageClass.Name,
-			Description: model.KubernetesStorageClassDescription{
-				MetaObject:   helpers.ConvertObjectMeta(&storageClass.ObjectMeta),
-				StorageClass: helpers.ConvertStorageClass(&storageClass),
-			},
-		}
+	volumeAttachment, err := client.KubernetesClient.StorageV1().VolumeAttachments().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
 
-		if stream != nil {
-			if err := (*stream)(resource); err != nil {
-				return allValues, fmt.Errorf("error streaming resource: %w", err)
-			}
-		} else {
-			allValues = append(allValues, resource)
+	resource := kubernetesVolumeAttachmentResource(volumeAttachment)
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
 		}
 	}
 
-	return allValues, nil
+	return &resource, nil
+}
+
+func kubernetesVolumeAttachmentResource(volumeAttachment *storagev1.VolumeAttachment) models.Resource {
+	// We don't need to include the managed fields in the description, also it causes issues in elastic search mapping generation
+	volumeAttachment.ManagedFields = nil
+	return models.Resource{
+		ID:   fmt.Sprintf("volumeattachment/%s", volumeAttachment.Name),
+		Name: volumeAttachment.Name,
+		Description: model.KubernetesVolumeAttachmentDescription{
+			MetaObject:       helpers.ConvertObjectMeta(&volumeAttachment.ObjectMeta),
+			VolumeAttachment: helpers.ConvertVolumeAttachment(volumeAttachment),
+		},
+	}
 }
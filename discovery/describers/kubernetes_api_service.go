@@ -0,0 +1,85 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// apiServicesGVR is listed with the dynamic client, the typed apiregistration.k8s.io client isn't part of client-go
+var apiServicesGVR = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+
+func KubernetesAPIService(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listClusterPaged(ctx, client.Namespaces, client.DynamicClient.Resource(apiServicesGVR).List, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesAPIServiceResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert api service, skipping it",
+				zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesAPIService(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "apiservice")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := client.DynamicClient.Resource(apiServicesGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesAPIServiceResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesAPIServiceResource(item *unstructured.Unstructured) (models.Resource, error) {
+	apiService, err := helpers.ConvertAPIService(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	availableCondition := apiService.AvailableCondition()
+	return models.Resource{
+		ID:   fmt.Sprintf("apiservice/%s", apiService.Name),
+		Name: apiService.Name,
+		Description: model.KubernetesAPIServiceDescription{
+			MetaObject:         apiService.ObjectMeta,
+			APIService:         apiService,
+			Available:          availableCondition != nil && availableCondition.Status == "True",
+			AvailableCondition: availableCondition,
+		},
+	}, nil
+}
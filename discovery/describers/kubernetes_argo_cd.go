@@ -0,0 +1,147 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	argoApplicationsCRD = "applications.argoproj.io"
+	argoAppProjectsCRD  = "appprojects.argoproj.io"
+)
+
+func KubernetesArgoApplication(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, argoApplicationsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesArgoApplicationResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Argo CD application, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesArgoApplication(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "argoapplication")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, argoApplicationsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesArgoApplicationResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesArgoApplicationResource(item *unstructured.Unstructured) (models.Resource, error) {
+	application, err := helpers.ConvertArgoApplication(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("argoapplication/%s/%s", application.Namespace, application.Name),
+		Name: fmt.Sprintf("%s/%s", application.Namespace, application.Name),
+		Description: model.KubernetesArgoApplicationDescription{
+			MetaObject:      application.ObjectMeta,
+			ArgoApplication: application,
+		},
+	}, nil
+}
+
+func KubernetesArgoAppProject(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, argoAppProjectsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesArgoAppProjectResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Argo CD project, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesArgoAppProject(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "argoappproject")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, argoAppProjectsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesArgoAppProjectResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesArgoAppProjectResource(item *unstructured.Unstructured) (models.Resource, error) {
+	appProject, err := helpers.ConvertArgoAppProject(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("argoappproject/%s/%s", appProject.Namespace, appProject.Name),
+		Name: fmt.Sprintf("%s/%s", appProject.Namespace, appProject.Name),
+		Description: model.KubernetesArgoAppProjectDescription{
+			MetaObject:     appProject.ObjectMeta,
+			ArgoAppProject: appProject,
+		},
+	}, nil
+}
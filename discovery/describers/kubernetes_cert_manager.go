@@ -0,0 +1,293 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	certificatesCRD        = "certificates.cert-manager.io"
+	certificateRequestsCRD = "certificaterequests.cert-manager.io"
+	issuersCRD             = "issuers.cert-manager.io"
+	clusterIssuersCRD      = "clusterissuers.cert-manager.io"
+)
+
+// certManagerReadyCondition is the condition cert-manager sets on all its resources
+const certManagerReadyCondition = "Ready"
+
+func KubernetesCertificate(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, certificatesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesCertificateResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert certificate, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesCertificate(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "certificate")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, certificatesCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesCertificateResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesCertificateResource(item *unstructured.Unstructured) (models.Resource, error) {
+	certificate, err := helpers.ConvertCertificate(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("certificate/%s/%s", certificate.Namespace, certificate.Name),
+		Name: fmt.Sprintf("%s/%s", certificate.Namespace, certificate.Name),
+		Description: model.KubernetesCertificateDescription{
+			MetaObject:     certificate.ObjectMeta,
+			Certificate:    certificate,
+			Ready:          meta.IsStatusConditionTrue(certificate.Status.Conditions, certManagerReadyCondition),
+			ReadyCondition: meta.FindStatusCondition(certificate.Status.Conditions, certManagerReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesCertificateRequest(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, certificateRequestsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesCertificateRequestResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert certificate request, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesCertificateRequest(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "certificaterequest")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, certificateRequestsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesCertificateRequestResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesCertificateRequestResource(item *unstructured.Unstructured) (models.Resource, error) {
+	certificateRequest, err := helpers.ConvertCertificateRequest(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("certificaterequest/%s/%s", certificateRequest.Namespace, certificateRequest.Name),
+		Name: fmt.Sprintf("%s/%s", certificateRequest.Namespace, certificateRequest.Name),
+		Description: model.KubernetesCertificateRequestDescription{
+			MetaObject:         certificateRequest.ObjectMeta,
+			CertificateRequest: certificateRequest,
+			Ready:              meta.IsStatusConditionTrue(certificateRequest.Status.Conditions, certManagerReadyCondition),
+			ReadyCondition:     meta.FindStatusCondition(certificateRequest.Status.Conditions, certManagerReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesIssuer(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, issuersCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesIssuerResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert issuer, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesIssuer(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "issuer")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, issuersCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesIssuerResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesIssuerResource(item *unstructured.Unstructured) (models.Resource, error) {
+	issuer, err := helpers.ConvertIssuer(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("issuer/%s/%s", issuer.Namespace, issuer.Name),
+		Name: fmt.Sprintf("%s/%s", issuer.Namespace, issuer.Name),
+		Description: model.KubernetesIssuerDescription{
+			MetaObject:     issuer.ObjectMeta,
+			Issuer:         issuer,
+			IssuerType:     issuer.Spec.Type(),
+			Ready:          meta.IsStatusConditionTrue(issuer.Status.Conditions, certManagerReadyCondition),
+			ReadyCondition: meta.FindStatusCondition(issuer.Status.Conditions, certManagerReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesClusterIssuer(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, clusterIssuersCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesClusterIssuerResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert cluster issuer, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesClusterIssuer(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "clusterissuer")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, clusterIssuersCRD, "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesClusterIssuerResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesClusterIssuerResource(item *unstructured.Unstructured) (models.Resource, error) {
+	clusterIssuer, err := helpers.ConvertClusterIssuer(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("clusterissuer/%s", clusterIssuer.Name),
+		Name: clusterIssuer.Name,
+		Description: model.KubernetesClusterIssuerDescription{
+			MetaObject:     clusterIssuer.ObjectMeta,
+			ClusterIssuer:  clusterIssuer,
+			IssuerType:     clusterIssuer.Spec.Type(),
+			Ready:          meta.IsStatusConditionTrue(clusterIssuer.Status.Conditions, certManagerReadyCondition),
+			ReadyCondition: meta.FindStatusCondition(clusterIssuer.Status.Conditions, certManagerReadyCondition),
+		},
+	}, nil
+}
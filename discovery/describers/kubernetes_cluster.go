@@ -76,29 +76,21 @@ func createErrorJSON(message string, detailErr error, logger *zap.Logger) string
 
 // --- Kubeconfig Validation Function ---
 
-// ValidateKubeconfig reads, parses, and performs structural validation on a kubeconfig file.
+// ValidateKubeconfig parses and performs structural validation on in-memory kubeconfig data, nothing is written to disk.
 // It returns the parsed Kubeconfig structure (api.Config), the REST config, and nil error on success.
 // On failure, it logs the error (if logger is enabled) and returns nil configs along with the error.
-func ValidateKubeconfig(ctx context.Context, kubeconfigPath string, logger *zap.Logger) (*api.Config, *rest.Config, error) {
-	l := logger.With(zap.String("kubeconfig_path", kubeconfigPath))
+func ValidateKubeconfig(ctx context.Context, kubeconfigBytes []byte, logger *zap.Logger) (*api.Config, *rest.Config, error) {
+	l := logger
 
-	// 1. Read File
-	kubeconfigBytes, err := os.ReadFile(kubeconfigPath)
-	if err != nil {
-		wrappedErr := xerrors.Errorf("failed to read kubeconfig file '%s': %w", kubeconfigPath, err)
-		l.Error("Cannot read input file", zap.Error(wrappedErr)) // Logged at Error level
-		return nil, nil, wrappedErr
-	}
-
-	// 2. Parse Structure (Lean Check)
+	// 1. Parse Structure (Lean Check)
 	configAPI, err := clientcmd.Load(kubeconfigBytes)
 	if err != nil {
-		wrappedErr := xerrors.Errorf("failed to parse kubeconfig data from '%s': %w", kubeconfigPath, err)
+		wrappedErr := xerrors.Errorf("failed to parse kubeconfig data: %w", err)
 		l.Error("Cannot parse kubeconfig data (is it valid YAML/JSON?)", zap.Error(wrappedErr)) // Logged at Error level
 		return nil, nil, wrappedErr
 	}
 
-	// 3. Deeper Validation
+	// 2. Deeper Validation
 	if configAPI.CurrentContext == "" {
 		errMsg := "kubeconfig validation failed: current-context is not set"
 		err := xerrors.New(errMsg)
@@ -146,11 +138,9 @@ func ValidateKubeconfig(ctx context.Context, kubeconfigPath string, logger *zap.
 	}
 	l = l.With(zap.String("auth_info_name", authInfoNameLog))
 
-	// 4. Build REST Config
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	loadingRules.ExplicitPath = kubeconfigPath
+	// 3. Build REST Config
 	configOverrides := &clientcmd.ConfigOverrides{}
-	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+	clientConfig := clientcmd.NewNonInteractiveClientConfig(*configAPI, configAPI.CurrentContext, configOverrides, nil)
 
 	restConfig, err := clientConfig.ClientConfig()
 	if err != nil {
@@ -224,16 +214,29 @@ func GetClusterInfo(ctx context.Context, configAPI *api.Config, restConfig *rest
 	output.AuthMethod = authMethod
 	l = l.With(zap.String("determined_auth_method", authMethod))
 
-	// 2. Create Clientset
+	// 2. Get Server Version
+	serverVersion, err := fetchServerVersion(ctx, restConfig, l)
+	if err != nil {
+		return nil, err
+	}
+	output.ServerVersion = serverVersion
+
+	// 3. Return Success JSON
+	return &output, nil
+}
+
+// fetchServerVersion connects to the Kubernetes cluster and retrieves its server version, retrying on failures.
+func fetchServerVersion(ctx context.Context, restConfig *rest.Config, l *zap.Logger) (string, error) {
+	// 1. Create Clientset
 	clientRestConfig := *restConfig
 	clientRestConfig.Timeout = RequestTimeout
 
 	clientset, err := kubernetes.NewForConfig(&clientRestConfig)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	// 3. Get Server Version (with Retries)
+	// 2. Get Server Version (with Retries)
 	var serverVersionStr string
 	var lastErr error
 	var contextCancelled bool
@@ -283,18 +286,16 @@ func GetClusterInfo(ctx context.Context, configAPI *api.Config, restConfig *rest
 		}
 
 		serverVersionStr = versionInfo.GitVersion
-		output.ServerVersion = serverVersionStr
 		lastErr = nil
 		l.Info("Successfully retrieved server version", zap.String("server_version", serverVersionStr)) // Logged at Info level
 		break
 	}
 
 	if lastErr != nil {
-		return nil, lastErr
+		return "", lastErr
 	}
 
-	// 5. Return Success JSON
-	return &output, nil
+	return serverVersionStr, nil
 }
 
 func DoDiscovery(kubeConfig string) (*model.KubernetesClusterDescription, error) {
@@ -311,45 +312,24 @@ func DoDiscovery(kubeConfig string) (*model.KubernetesClusterDescription, error)
 		_ = logger.Sync() // Flush logs before exit
 	}()
 
-	kubeconfigPath := "./kubeconfig.yaml"
-
-	// Write the string into the file with 0600 permissions
-	err := os.WriteFile(kubeconfigPath, []byte(kubeConfig), 0600)
-	if err != nil {
-		return nil, err
-	}
-
-	fmt.Println("kubeconfigPath", kubeconfigPath)
-	time.Sleep(2 * time.Second)
-
-	// --- Basic File Existence Check ---
-	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
-		return nil, err
-	}
-
 	// --- Create Root Context ---
 	ctx := context.Background()
 
 	// --- Validate Kubeconfig (Common Step) ---
-	logger.Info("Validating kubeconfig file...", zap.String("path", kubeconfigPath)) // Logged at Info level
-	configAPI, restConfig, err := ValidateKubeconfig(ctx, kubeconfigPath, logger)
+	logger.Info("Validating kubeconfig...") // Logged at Info level
+	configAPI, restConfig, err := ValidateKubeconfig(ctx, []byte(kubeConfig), logger)
 	if err != nil {
 		return nil, err
 	}
 
 	// --- Execute Action Based on Flag ---
 
-	logger.Info("Fetching cluster information...", zap.String("kubeconfig_path", kubeconfigPath)) // Logged at Info level
+	logger.Info("Fetching cluster information...", zap.String("context", configAPI.CurrentContext)) // Logged at Info level
 	result, err := GetClusterInfo(ctx, configAPI, restConfig, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	err = os.Remove(kubeconfigPath)
-	if err != nil {
-		return nil, err
-	}
-
 	return &model.KubernetesClusterDescription{
 		AuthMethod:            result.AuthMethod,
 		ContextName:           result.ContextName,
@@ -358,3 +338,24 @@ func DoDiscovery(kubeConfig string) (*model.KubernetesClusterDescription, error)
 		TLSServerVerification: result.TLSServerVerification,
 	}, nil
 }
+
+// describeCluster describes the cluster of the client,
+// clusters of credentials without a kubeconfig (bearer token) are described from the REST config of the client.
+func describeCluster(ctx context.Context, client model.Client) (*model.KubernetesClusterDescription, error) {
+	if client.KubeConfig != "" {
+		return DoDiscovery(client.KubeConfig)
+	}
+
+	serverVersion, err := fetchServerVersion(ctx, client.RestConfig, GetLoggerFromContext(ctx).With(zap.String("context", client.ContextName)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.KubernetesClusterDescription{
+		AuthMethod:            "Token",
+		ContextName:           client.ContextName,
+		Endpoint:              client.RestConfig.Host,
+		ServerVersion:         serverVersion,
+		TLSServerVerification: !client.RestConfig.Insecure,
+	}, nil
+}
@@ -0,0 +1,410 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+var (
+	fluxKustomizationKind  = schema.GroupKind{Group: "kustomize.toolkit.fluxcd.io", Kind: "Kustomization"}
+	fluxHelmReleaseKind    = schema.GroupKind{Group: "helm.toolkit.fluxcd.io", Kind: "HelmRelease"}
+	fluxGitRepositoryKind  = schema.GroupKind{Group: "source.toolkit.fluxcd.io", Kind: "GitRepository"}
+	fluxHelmRepositoryKind = schema.GroupKind{Group: "source.toolkit.fluxcd.io", Kind: "HelmRepository"}
+	fluxOCIRepositoryKind  = schema.GroupKind{Group: "source.toolkit.fluxcd.io", Kind: "OCIRepository"}
+)
+
+// fluxReadyCondition is the condition the Flux controllers set on the objects they reconcile
+const fluxReadyCondition = "Ready"
+
+// fluxResourceClient returns the dynamic client of a Flux kind in its preferred version,
+// a nil client is returned if the API group of the kind isn't served
+func fluxResourceClient(ctx context.Context, client model.Client, groupKind schema.GroupKind) (dynamic.NamespaceableResourceInterface, error) {
+	cached, err := clusterDiscoveryCache.get(ctx, client.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := cached.restMapper.RESTMapping(groupKind)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			GetLoggerFromContext(ctx).Info("Flux API is not available, skipping it",
+				zap.String("groupKind", groupKind.String()))
+			return nil, nil
+		}
+		return nil, err
+	}
+	return client.DynamicClient.Resource(mapping.Resource), nil
+}
+
+// listFluxResources lists the objects of a Flux kind and calls handle once for every item in the integration scope
+func listFluxResources(ctx context.Context, client model.Client, groupKind schema.GroupKind, handle func(*unstructured.Unstructured) error) error {
+	dynamicClient, err := fluxResourceClient(ctx, client, groupKind)
+	if err != nil || dynamicClient == nil {
+		return err
+	}
+	return listNamespacedPaged(ctx, client.Namespaces, dynamicClient.Namespace, func(item *unstructured.Unstructured) error {
+		if !client.Namespaces.Allows(item.GetNamespace()) {
+			return nil
+		}
+		return handle(item)
+	})
+}
+
+// getFluxResource gets an object of a Flux kind, all the Flux kinds are namespaced
+func getFluxResource(ctx context.Context, client model.Client, groupKind schema.GroupKind, namespace string, name string) (*unstructured.Unstructured, error) {
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+	dynamicClient, err := fluxResourceClient(ctx, client, groupKind)
+	if err != nil {
+		return nil, err
+	}
+	if dynamicClient == nil {
+		return nil, fmt.Errorf("%s is not served by the cluster", groupKind.String())
+	}
+	return dynamicClient.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func KubernetesFluxKustomization(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listFluxResources(ctx, client, fluxKustomizationKind, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesFluxKustomizationResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Flux kustomization, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesFluxKustomization(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "fluxkustomization")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getFluxResource(ctx, client, fluxKustomizationKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesFluxKustomizationResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesFluxKustomizationResource(item *unstructured.Unstructured) (models.Resource, error) {
+	kustomization, err := helpers.ConvertFluxKustomization(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("fluxkustomization/%s/%s", kustomization.Namespace, kustomization.Name),
+		Name: fmt.Sprintf("%s/%s", kustomization.Namespace, kustomization.Name),
+		Description: model.KubernetesFluxKustomizationDescription{
+			MetaObject:        kustomization.ObjectMeta,
+			FluxKustomization: kustomization,
+			Ready:             meta.IsStatusConditionTrue(kustomization.Status.Conditions, fluxReadyCondition),
+			ReadyCondition:    meta.FindStatusCondition(kustomization.Status.Conditions, fluxReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesFluxHelmRelease(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listFluxResources(ctx, client, fluxHelmReleaseKind, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesFluxHelmReleaseResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Flux Helm release, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesFluxHelmRelease(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "fluxhelmrelease")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getFluxResource(ctx, client, fluxHelmReleaseKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesFluxHelmReleaseResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesFluxHelmReleaseResource(item *unstructured.Unstructured) (models.Resource, error) {
+	helmRelease, err := helpers.ConvertFluxHelmRelease(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("fluxhelmrelease/%s/%s", helmRelease.Namespace, helmRelease.Name),
+		Name: fmt.Sprintf("%s/%s", helmRelease.Namespace, helmRelease.Name),
+		Description: model.KubernetesFluxHelmReleaseDescription{
+			MetaObject:      helmRelease.ObjectMeta,
+			FluxHelmRelease: helmRelease,
+			Ready:           meta.IsStatusConditionTrue(helmRelease.Status.Conditions, fluxReadyCondition),
+			ReadyCondition:  meta.FindStatusCondition(helmRelease.Status.Conditions, fluxReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesFluxGitRepository(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listFluxResources(ctx, client, fluxGitRepositoryKind, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesFluxGitRepositoryResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Flux Git repository, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesFluxGitRepository(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "fluxgitrepository")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getFluxResource(ctx, client, fluxGitRepositoryKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesFluxGitRepositoryResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesFluxGitRepositoryResource(item *unstructured.Unstructured) (models.Resource, error) {
+	gitRepository, err := helpers.ConvertFluxGitRepository(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("fluxgitrepository/%s/%s", gitRepository.Namespace, gitRepository.Name),
+		Name: fmt.Sprintf("%s/%s", gitRepository.Namespace, gitRepository.Name),
+		Description: model.KubernetesFluxGitRepositoryDescription{
+			MetaObject:        gitRepository.ObjectMeta,
+			FluxGitRepository: gitRepository,
+			Ready:             meta.IsStatusConditionTrue(gitRepository.Status.Conditions, fluxReadyCondition),
+			ReadyCondition:    meta.FindStatusCondition(gitRepository.Status.Conditions, fluxReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesFluxHelmRepository(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listFluxResources(ctx, client, fluxHelmRepositoryKind, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesFluxHelmRepositoryResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Flux Helm repository, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesFluxHelmRepository(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "fluxhelmrepository")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getFluxResource(ctx, client, fluxHelmRepositoryKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesFluxHelmRepositoryResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesFluxHelmRepositoryResource(item *unstructured.Unstructured) (models.Resource, error) {
+	helmRepository, err := helpers.ConvertFluxHelmRepository(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("fluxhelmrepository/%s/%s", helmRepository.Namespace, helmRepository.Name),
+		Name: fmt.Sprintf("%s/%s", helmRepository.Namespace, helmRepository.Name),
+		Description: model.KubernetesFluxHelmRepositoryDescription{
+			MetaObject:         helmRepository.ObjectMeta,
+			FluxHelmRepository: helmRepository,
+			Ready:              meta.IsStatusConditionTrue(helmRepository.Status.Conditions, fluxReadyCondition),
+			ReadyCondition:     meta.FindStatusCondition(helmRepository.Status.Conditions, fluxReadyCondition),
+		},
+	}, nil
+}
+
+func KubernetesFluxOCIRepository(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listFluxResources(ctx, client, fluxOCIRepositoryKind, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesFluxOCIRepositoryResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert Flux OCI repository, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesFluxOCIRepository(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "fluxocirepository")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getFluxResource(ctx, client, fluxOCIRepositoryKind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesFluxOCIRepositoryResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesFluxOCIRepositoryResource(item *unstructured.Unstructured) (models.Resource, error) {
+	ociRepository, err := helpers.ConvertFluxOCIRepository(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("fluxocirepository/%s/%s", ociRepository.Namespace, ociRepository.Name),
+		Name: fmt.Sprintf("%s/%s", ociRepository.Namespace, ociRepository.Name),
+		Description: model.KubernetesFluxOCIRepositoryDescription{
+			MetaObject:        ociRepository.ObjectMeta,
+			FluxOCIRepository: ociRepository,
+			Ready:             meta.IsStatusConditionTrue(ociRepository.Status.Conditions, fluxReadyCondition),
+			ReadyCondition:    meta.FindStatusCondition(ociRepository.Status.Conditions, fluxReadyCondition),
+		},
+	}, nil
+}
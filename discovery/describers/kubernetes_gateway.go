@@ -0,0 +1,347 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	gatewayClassesCRD  = "gatewayclasses.gateway.networking.k8s.io"
+	gatewaysCRD        = "gateways.gateway.networking.k8s.io"
+	httpRoutesCRD      = "httproutes.gateway.networking.k8s.io"
+	grpcRoutesCRD      = "grpcroutes.gateway.networking.k8s.io"
+	referenceGrantsCRD = "referencegrants.gateway.networking.k8s.io"
+)
+
+func KubernetesGatewayClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, gatewayClassesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesGatewayClassResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert gateway class, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesGatewayClass(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "gatewayclass")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, gatewayClassesCRD, "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesGatewayClassResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesGatewayClassResource(item *unstructured.Unstructured) (models.Resource, error) {
+	gatewayClass, err := helpers.ConvertGatewayClass(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("gatewayclass/%s", gatewayClass.Name),
+		Name: gatewayClass.Name,
+		Description: model.KubernetesGatewayClassDescription{
+			MetaObject:   gatewayClass.ObjectMeta,
+			GatewayClass: gatewayClass,
+		},
+	}, nil
+}
+
+func KubernetesGateway(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, gatewaysCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesGatewayResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert gateway, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesGateway(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "gateway")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, gatewaysCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesGatewayResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesGatewayResource(item *unstructured.Unstructured) (models.Resource, error) {
+	gateway, err := helpers.ConvertGateway(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("gateway/%s/%s", gateway.Namespace, gateway.Name),
+		Name: fmt.Sprintf("%s/%s", gateway.Namespace, gateway.Name),
+		Description: model.KubernetesGatewayDescription{
+			MetaObject: gateway.ObjectMeta,
+			Gateway:    gateway,
+		},
+	}, nil
+}
+
+func KubernetesHTTPRoute(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, httpRoutesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesHTTPRouteResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert http route, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesHTTPRoute(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "httproute")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, httpRoutesCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesHTTPRouteResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesHTTPRouteResource(item *unstructured.Unstructured) (models.Resource, error) {
+	httpRoute, err := helpers.ConvertHTTPRoute(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("httproute/%s/%s", httpRoute.Namespace, httpRoute.Name),
+		Name: fmt.Sprintf("%s/%s", httpRoute.Namespace, httpRoute.Name),
+		Description: model.KubernetesHTTPRouteDescription{
+			MetaObject:  httpRoute.ObjectMeta,
+			HTTPRoute:   httpRoute,
+			BackendRefs: httpRoute.BackendRefs(),
+		},
+	}, nil
+}
+
+func KubernetesGRPCRoute(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, grpcRoutesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesGRPCRouteResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert grpc route, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesGRPCRoute(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "grpcroute")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, grpcRoutesCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesGRPCRouteResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesGRPCRouteResource(item *unstructured.Unstructured) (models.Resource, error) {
+	grpcRoute, err := helpers.ConvertGRPCRoute(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("grpcroute/%s/%s", grpcRoute.Namespace, grpcRoute.Name),
+		Name: fmt.Sprintf("%s/%s", grpcRoute.Namespace, grpcRoute.Name),
+		Description: model.KubernetesGRPCRouteDescription{
+			MetaObject:  grpcRoute.ObjectMeta,
+			GRPCRoute:   grpcRoute,
+			BackendRefs: grpcRoute.BackendRefs(),
+		},
+	}, nil
+}
+
+func KubernetesReferenceGrant(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, referenceGrantsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesReferenceGrantResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert reference grant, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesReferenceGrant(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "referencegrant")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, referenceGrantsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesReferenceGrantResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesReferenceGrantResource(item *unstructured.Unstructured) (models.Resource, error) {
+	referenceGrant, err := helpers.ConvertReferenceGrant(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("referencegrant/%s/%s", referenceGrant.Namespace, referenceGrant.Name),
+		Name: fmt.Sprintf("%s/%s", referenceGrant.Namespace, referenceGrant.Name),
+		Description: model.KubernetesReferenceGrantDescription{
+			MetaObject:     referenceGrant.ObjectMeta,
+			ReferenceGrant: referenceGrant,
+		},
+	}, nil
+}
@@ -0,0 +1,191 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The metrics API is served by an aggregated API server, usually metrics-server. Its metrics are listed with the
+// dynamic client, the typed metrics.k8s.io client isn't part of client-go.
+var (
+	nodeMetricsGVR = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "nodes"}
+	podMetricsGVR  = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+)
+
+// metricsAPIAvailable returns false if the metrics API isn't registered or its APIService is unavailable,
+// the metrics describers return no resources instead of failing in that case
+func metricsAPIAvailable(ctx context.Context, client model.Client, gvr schema.GroupVersionResource) (bool, error) {
+	cached, err := clusterDiscoveryCache.get(ctx, client.RestConfig)
+	if err != nil {
+		return false, err
+	}
+	resources, err := cached.client.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		GetLoggerFromContext(ctx).Info("metrics API is not available, skipping metrics",
+			zap.String("groupVersion", gvr.GroupVersion().String()), zap.Error(err))
+		return false, nil
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == gvr.Resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func KubernetesNodeMetric(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	available, err := metricsAPIAvailable(ctx, client, nodeMetricsGVR)
+	if err != nil || !available {
+		return nil, err
+	}
+
+	err = listClusterPaged(ctx, client.Namespaces, client.DynamicClient.Resource(nodeMetricsGVR).List, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesNodeMetricResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert node metrics, skipping them",
+				zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesNodeMetric(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "nodemetric")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := client.DynamicClient.Resource(nodeMetricsGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesNodeMetricResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesNodeMetricResource(item *unstructured.Unstructured) (models.Resource, error) {
+	nodeMetric, err := helpers.ConvertNodeMetric(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("nodemetric/%s", nodeMetric.Name),
+		Name: nodeMetric.Name,
+		Description: model.KubernetesNodeMetricDescription{
+			MetaObject: nodeMetric.ObjectMeta,
+			NodeMetric: nodeMetric,
+		},
+	}, nil
+}
+
+func KubernetesPodMetric(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	available, err := metricsAPIAvailable(ctx, client, podMetricsGVR)
+	if err != nil || !available {
+		return nil, err
+	}
+
+	err = listNamespacedPaged(ctx, client.Namespaces, client.DynamicClient.Resource(podMetricsGVR).Namespace, func(item *unstructured.Unstructured) error {
+		if !client.Namespaces.Allows(item.GetNamespace()) {
+			return nil
+		}
+		resource, err := kubernetesPodMetricResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert pod metrics, skipping them",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesPodMetric(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "podmetric")
+	if err != nil {
+		return nil, err
+	}
+	if !client.Namespaces.Allows(namespace) {
+		return nil, fmt.Errorf("namespace %s is out of the integration scope", namespace)
+	}
+
+	item, err := client.DynamicClient.Resource(podMetricsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesPodMetricResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPodMetricResource(item *unstructured.Unstructured) (models.Resource, error) {
+	podMetric, err := helpers.ConvertPodMetric(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("podmetric/%s/%s", podMetric.Namespace, podMetric.Name),
+		Name: fmt.Sprintf("%s/%s", podMetric.Namespace, podMetric.Name),
+		Description: model.KubernetesPodMetricDescription{
+			MetaObject: podMetric.ObjectMeta,
+			PodMetric:  podMetric,
+		},
+	}, nil
+}
@@ -0,0 +1,282 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	serviceMonitorsCRD = "servicemonitors.monitoring.coreos.com"
+	podMonitorsCRD     = "podmonitors.monitoring.coreos.com"
+	prometheusRulesCRD = "prometheusrules.monitoring.coreos.com"
+	prometheusesCRD    = "prometheuses.monitoring.coreos.com"
+)
+
+func KubernetesServiceMonitor(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, serviceMonitorsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesServiceMonitorResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert service monitor, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesServiceMonitor(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "servicemonitor")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, serviceMonitorsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesServiceMonitorResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesServiceMonitorResource(item *unstructured.Unstructured) (models.Resource, error) {
+	serviceMonitor, err := helpers.ConvertServiceMonitor(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("servicemonitor/%s/%s", serviceMonitor.Namespace, serviceMonitor.Name),
+		Name: fmt.Sprintf("%s/%s", serviceMonitor.Namespace, serviceMonitor.Name),
+		Description: model.KubernetesServiceMonitorDescription{
+			MetaObject:          serviceMonitor.ObjectMeta,
+			ServiceMonitor:      serviceMonitor,
+			LabelSelectorString: serviceMonitor.Spec.Selector.String(),
+		},
+	}, nil
+}
+
+func KubernetesPodMonitor(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, podMonitorsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesPodMonitorResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert pod monitor, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesPodMonitor(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "podmonitor")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, podMonitorsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesPodMonitorResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPodMonitorResource(item *unstructured.Unstructured) (models.Resource, error) {
+	podMonitor, err := helpers.ConvertPodMonitor(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("podmonitor/%s/%s", podMonitor.Namespace, podMonitor.Name),
+		Name: fmt.Sprintf("%s/%s", podMonitor.Namespace, podMonitor.Name),
+		Description: model.KubernetesPodMonitorDescription{
+			MetaObject:          podMonitor.ObjectMeta,
+			PodMonitor:          podMonitor,
+			LabelSelectorString: podMonitor.Spec.Selector.String(),
+		},
+	}, nil
+}
+
+func KubernetesPrometheusRule(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, prometheusRulesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesPrometheusRuleResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert prometheus rule, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesPrometheusRule(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "prometheusrule")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, prometheusRulesCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesPrometheusRuleResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPrometheusRuleResource(item *unstructured.Unstructured) (models.Resource, error) {
+	prometheusRule, err := helpers.ConvertPrometheusRule(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("prometheusrule/%s/%s", prometheusRule.Namespace, prometheusRule.Name),
+		Name: fmt.Sprintf("%s/%s", prometheusRule.Namespace, prometheusRule.Name),
+		Description: model.KubernetesPrometheusRuleDescription{
+			MetaObject:     prometheusRule.ObjectMeta,
+			PrometheusRule: prometheusRule,
+			Alerts:         prometheusRule.AlertNames(),
+		},
+	}, nil
+}
+
+func KubernetesPrometheus(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, prometheusesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesPrometheusResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert prometheus, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesPrometheus(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "prometheus")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, prometheusesCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesPrometheusResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesPrometheusResource(item *unstructured.Unstructured) (models.Resource, error) {
+	prometheus, err := helpers.ConvertPrometheus(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("prometheus/%s/%s", prometheus.Namespace, prometheus.Name),
+		Name: fmt.Sprintf("%s/%s", prometheus.Namespace, prometheus.Name),
+		Description: model.KubernetesPrometheusDescription{
+			MetaObject: prometheus.ObjectMeta,
+			Prometheus: prometheus,
+		},
+	}, nil
+}
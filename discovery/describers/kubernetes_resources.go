@@ -12,7 +12,6 @@ import (
 
 	// Required for Retry-After parsing
 	"strings" // Required for error aggregation and kind mapping
-	"sync"    // For caching discovery results safely
 	"syscall" // Required for signal types (SIGINT, SIGTERM)
 	"time"
 
@@ -24,13 +23,9 @@ import (
 
 	// For UID type
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/discovery"               // Import discovery client
-	"k8s.io/client-go/discovery/cached/memory" // For caching discovery results
+	"k8s.io/client-go/discovery" // Import discovery client
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"       // Import rest package for Config type
-	"k8s.io/client-go/restmapper" // Import RESTMapper
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/rest" // Import rest package for Config type
 )
 
 // --- Configurable Constants ---
@@ -48,20 +43,18 @@ const (
 	neverExceedTimeout   time.Duration = 30 * time.Minute
 )
 
-// Global discovery information
-var restMapper meta.RESTMapper
-var discoveryOnce sync.Once
+var namespacesGroupResource = schema.GroupResource{Resource: "namespaces"}
 
 // --- Application Arguments Structure ---
 type AppArgs struct {
-	KubeconfigPath  string
-	ResourceType    string
-	Limit           int64
-	QPS             float64
-	Burst           int
-	StreamMode      bool
-	IncludeStatus   bool
-	IncludeMetadata bool
+	RestConfig   *rest.Config
+	ResourceType string
+	Limit        int64
+	QPS          float64
+	Burst        int
+	StreamMode   bool
+	Capture      provider.CaptureConfig
+	Namespaces   provider.NamespaceFilter
 }
 
 // --- Output Structures ---
@@ -92,45 +85,92 @@ type NonStreamOutput struct {
 }
 
 // --- Kind to Resource Table Mapping ---
+// Keys are <group>/<lower-case kind>, or the lower-case kind alone for the core API group, so kinds sharing
+// a name across API groups (e.g. the Kustomization of Flux and of kustomize.config.k8s.io) aren't confused.
 var kindToResourceTableMap = map[string]string{
-	"clusterrole":              "k8_cluster_role",
-	"clusterrolebinding":       "k8_cluster_role_binding",
-	"configmap":                "k8_config_map",
-	"cronjob":                  "k8_cronjob",
-	"customresourcedefinition": "k8_custom_resource_definition",
-	"daemonset":                "k8_daemonset",
-	"deployment":               "k8_deployment",
-	"endpointslice":            "k8_endpoint_slice",
-	"endpoints":                "k8_endpoints",
-	"event":                    "k8_event",
-	"horizontalpodautoscaler":  "k8_horizontal_pod_autoscaler",
-	"ingress":                  "k8_ingress",
-	"job":                      "k8_job",
-	"limitrange":               "k8_limit_range",
-	"namespace":                "k8_namespace",
-	"networkpolicy":            "k8_network_policy",
-	"node":                     "k8_node",
-	"persistentvolume":         "k8_persistent_volume",
-	"persistentvolumeclaim":    "k8_persistent_volume_claim",
-	"pod":                      "k8_pod",
-	"poddisruptionbudget":      "k8_pod_disruption_budget",
-	"podtemplate":              "k8_pod_template",
-	"replicaset":               "k8_replicaset",
-	"replicationcontroller":    "k8_replication_controller",
-	"resourcequota":            "k8_resource_quota",
-	"role":                     "k8_role",
-	"rolebinding":              "k8_role_binding",
-	"secret":                   "k8_secret",
-	"service":                  "k8_service",
-	"serviceaccount":           "k8_service_account",
-	"statefulset":              "k8_stateful_set",
-	"storageclass":             "k8_storage_class",
+	"admissionregistration.k8s.io/mutatingwebhookconfiguration":     "k8_mutating_webhook_configuration",
+	"admissionregistration.k8s.io/validatingadmissionpolicy":        "k8_validating_admission_policy",
+	"admissionregistration.k8s.io/validatingadmissionpolicybinding": "k8_validating_admission_policy_binding",
+	"admissionregistration.k8s.io/validatingwebhookconfiguration":   "k8_validating_webhook_configuration",
+	"apiextensions.k8s.io/customresourcedefinition":                 "k8_custom_resource_definition",
+	"apiregistration.k8s.io/apiservice":                             "k8_api_service",
+	"apps/daemonset":                                                "k8_daemonset",
+	"apps/deployment":                                               "k8_deployment",
+	"apps/replicaset":                                               "k8_replicaset",
+	"apps/statefulset":                                              "k8_stateful_set",
+	"argoproj.io/application":                                       "k8_argo_application",
+	"argoproj.io/appproject":                                        "k8_argo_app_project",
+	"autoscaling.k8s.io/verticalpodautoscaler":                      "k8_vertical_pod_autoscaler",
+	"autoscaling/horizontalpodautoscaler":                           "k8_horizontal_pod_autoscaler",
+	"batch/cronjob":                                                 "k8_cronjob",
+	"batch/job":                                                     "k8_job",
+	"cert-manager.io/certificate":                                   "k8_certificate",
+	"cert-manager.io/certificaterequest":                            "k8_certificate_request",
+	"cert-manager.io/clusterissuer":                                 "k8_cluster_issuer",
+	"cert-manager.io/issuer":                                        "k8_issuer",
+	"certificates.k8s.io/certificatesigningrequest":                 "k8_certificate_signing_request",
+	"configmap":                      "k8_config_map",
+	"coordination.k8s.io/lease":      "k8_lease",
+	"discovery.k8s.io/endpointslice": "k8_endpoint_slice",
+	"endpoints":                      "k8_endpoints",
+	"event":                          "k8_event",
+	"events.k8s.io/event":            "k8_event",
+	"flowcontrol.apiserver.k8s.io/flowschema":                 "k8_flow_schema",
+	"flowcontrol.apiserver.k8s.io/prioritylevelconfiguration": "k8_priority_level_configuration",
+	"gateway.networking.k8s.io/gateway":                       "k8_gateway",
+	"gateway.networking.k8s.io/gatewayclass":                  "k8_gateway_class",
+	"gateway.networking.k8s.io/grpcroute":                     "k8_grpc_route",
+	"gateway.networking.k8s.io/httproute":                     "k8_http_route",
+	"gateway.networking.k8s.io/referencegrant":                "k8_reference_grant",
+	"helm.toolkit.fluxcd.io/helmrelease":                      "k8_flux_helm_release",
+	"kustomize.toolkit.fluxcd.io/kustomization":               "k8_flux_kustomization",
+	"limitrange":                                    "k8_limit_range",
+	"metrics.k8s.io/nodemetrics":                    "k8_node_metric",
+	"metrics.k8s.io/podmetrics":                     "k8_pod_metric",
+	"monitoring.coreos.com/podmonitor":              "k8_pod_monitor",
+	"monitoring.coreos.com/prometheus":              "k8_prometheus",
+	"monitoring.coreos.com/prometheusrule":          "k8_prometheus_rule",
+	"monitoring.coreos.com/servicemonitor":          "k8_service_monitor",
+	"namespace":                                     "k8_namespace",
+	"networking.k8s.io/ingress":                     "k8_ingress",
+	"networking.k8s.io/ingressclass":                "k8_ingress_class",
+	"networking.k8s.io/networkpolicy":               "k8_network_policy",
+	"node":                                          "k8_node",
+	"node.k8s.io/runtimeclass":                      "k8_runtime_class",
+	"persistentvolume":                              "k8_persistent_volume",
+	"persistentvolumeclaim":                         "k8_persistent_volume_claim",
+	"pod":                                           "k8_pod",
+	"podtemplate":                                   "k8_pod_template",
+	"policy/poddisruptionbudget":                    "k8_pod_disruption_budget",
+	"rbac.authorization.k8s.io/clusterrole":         "k8_cluster_role",
+	"rbac.authorization.k8s.io/clusterrolebinding":  "k8_cluster_role_binding",
+	"rbac.authorization.k8s.io/role":                "k8_role",
+	"rbac.authorization.k8s.io/rolebinding":         "k8_role_binding",
+	"replicationcontroller":                         "k8_replication_controller",
+	"resourcequota":                                 "k8_resource_quota",
+	"scheduling.k8s.io/priorityclass":               "k8_priority_class",
+	"secret":                                        "k8_secret",
+	"service":                                       "k8_service",
+	"serviceaccount":                                "k8_service_account",
+	"snapshot.storage.k8s.io/volumesnapshot":        "k8_volume_snapshot",
+	"snapshot.storage.k8s.io/volumesnapshotclass":   "k8_volume_snapshot_class",
+	"snapshot.storage.k8s.io/volumesnapshotcontent": "k8_volume_snapshot_content",
+	"source.toolkit.fluxcd.io/gitrepository":        "k8_flux_git_repository",
+	"source.toolkit.fluxcd.io/helmrepository":       "k8_flux_helm_repository",
+	"source.toolkit.fluxcd.io/ocirepository":        "k8_flux_oci_repository",
+	"storage.k8s.io/csidriver":                      "k8_csi_driver",
+	"storage.k8s.io/csinode":                        "k8_csi_node",
+	"storage.k8s.io/storageclass":                   "k8_storage_class",
+	"storage.k8s.io/volumeattachment":               "k8_volume_attachment",
 }
 
-// getResourceTable determines the resource table name based on the object's Kind.
-func getResourceTable(kind string) string {
-	lowerKind := strings.ToLower(kind)
-	if ref, ok := kindToResourceTableMap[lowerKind]; ok {
+// getResourceTable determines the resource table name based on the object's API group and Kind.
+func getResourceTable(group string, kind string) string {
+	key := strings.ToLower(kind)
+	if group != "" {
+		key = group + "/" + key
+	}
+	if ref, ok := kindToResourceTableMap[key]; ok {
 		return ref
 	}
 	return "k8_custom_resource"
@@ -138,34 +178,16 @@ func getResourceTable(kind string) string {
 
 // --- Main Execution ---
 
-func GetKubernetesResources(kubeConfig string) ([]provider.KubernetesResourceDescription, error) {
+func GetKubernetesResources(config *rest.Config, namespaces provider.NamespaceFilter, capture provider.CaptureConfig) ([]provider.KubernetesResourceDescription, error) {
 	limit := defaultLimit
 	qps := float64(defaultQPS)
 	burst := defaultBurst
 	stream := false
-	includeStatus := false
-	includeMetadata := false
-
-	kubeconfigPath := "./kubeconfig.yaml"
-
-	// Write the string into the file with 0600 permissions
-	err := os.WriteFile(kubeconfigPath, []byte(kubeConfig), 0600)
-	if err != nil {
-		return nil, err
-	}
-
-	fmt.Println("kubeconfigPath", kubeconfigPath)
-	time.Sleep(2 * time.Second)
-
-	// --- Basic File Existence Check ---
-	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
-		return nil, err
-	}
 
 	appArgs := AppArgs{
-		KubeconfigPath: kubeconfigPath, ResourceType: "", Limit: limit,
-		QPS: qps, Burst: burst, StreamMode: stream, IncludeStatus: includeStatus,
-		IncludeMetadata: includeMetadata,
+		RestConfig: config, ResourceType: "", Limit: limit,
+		QPS: qps, Burst: burst, StreamMode: stream, Capture: capture,
+		Namespaces: namespaces,
 	}
 
 	resources, err := Execute(appArgs)
@@ -175,16 +197,9 @@ func GetKubernetesResources(kubeConfig string) ([]provider.KubernetesResourceDes
 // --- Execute Function (Main Application Logic) ---
 
 func Execute(args AppArgs) ([]provider.KubernetesResourceDescription, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", args.KubeconfigPath)
-	if err != nil {
-		log.Printf("Error building kubeconfig: %v", err)
-		if !args.StreamMode {
-			failStatus := map[string]interface{}{"results": map[string]interface{}{}, "list_summary": ListSummary{Status: "failed", Error: fmt.Sprintf("kubeconfig error: %v", err)}}
-			failJSON, _ := json.MarshalIndent(failStatus, "", "  ")
-			fmt.Println(string(failJSON))
-		}
-		return nil, err
-	}
+	var err error
+	// copy the config, the rate limits of the lister must not leak into the other clients of the describer
+	config := rest.CopyConfig(args.RestConfig)
 	config.QPS = float32(args.QPS)
 	config.Burst = args.Burst
 	log.Printf("Using client rate limiting: QPS=%.2f, Burst=%d", config.QPS, config.Burst)
@@ -198,8 +213,8 @@ func Execute(args AppArgs) ([]provider.KubernetesResourceDescription, error) {
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	go func() { sig := <-sigChan; log.Printf("Received signal: %v. Shutting down...", sig); rootCancel() }()
 
-	logMsg := fmt.Sprintf("Starting lister with hard timeout limit: %v, idle timeout: %v. Stream mode: %v. Include Status: %v. Include Metadata: %v.",
-		neverExceedTimeout, idleTimeout, args.StreamMode, args.IncludeStatus, args.IncludeMetadata)
+	logMsg := fmt.Sprintf("Starting lister with hard timeout limit: %v, idle timeout: %v. Stream mode: %v. Capture Spec: %v. Capture Status: %v. Capture Metadata: %v.",
+		neverExceedTimeout, idleTimeout, args.StreamMode, args.Capture.Spec, args.Capture.Status, args.Capture.Metadata)
 	isListAll := args.ResourceType == ""
 	if isListAll {
 		logMsg += " Listing all resource types."
@@ -221,18 +236,22 @@ func Execute(args AppArgs) ([]provider.KubernetesResourceDescription, error) {
 		itemsProcessed, runErr = RunLister(timedCtx, config, args, resultsMap)
 		// Populate resourceTableCounts for the summary if successful
 		if runErr == nil || !errors.Is(runErr, context.Canceled) && !errors.Is(runErr, context.DeadlineExceeded) {
-			gvr, _, findErr := findResourceGVR(timedCtx, args.ResourceType)
+			var gvr schema.GroupVersionResource
+			cached, findErr := clusterDiscoveryCache.get(timedCtx, config)
+			if findErr == nil {
+				gvr, _, findErr = findResourceGVR(cached.restMapper, args.ResourceType)
+			}
 			if findErr == nil {
 				kind := gvr.Resource
 				// Try getting kind from results if available (more accurate)
 				// Need to access the correct map key (resourceTable)
-				resourceTable := getResourceTable(kind)
+				resourceTable := getResourceTable(gvr.Group, kind)
 				if resResult, ok := resultsMap[resourceTable]; ok && len(resResult.Items) > 0 {
 					if resResult.Items[0].Kind != "" {
 						kind = resResult.Items[0].Kind
 					}
 					// Re-calculate resourceTable based on potentially more accurate kind
-					resourceTable = getResourceTable(kind)
+					resourceTable = getResourceTable(gvr.Group, kind)
 				}
 			} else {
 				log.Printf("Warning: Could not re-find GVR for summary count for type '%s'", args.ResourceType)
@@ -289,16 +308,12 @@ func RunLister(ctx context.Context, config *rest.Config, args AppArgs, resultsMa
 	if err != nil {
 		return 0, fmt.Errorf("error creating dynamic client: %w", err)
 	}
-	clientset, err := kubernetes.NewForConfig(config)
+	cached, err := clusterDiscoveryCache.get(ctx, config)
 	if err != nil {
-		return 0, fmt.Errorf("error creating standard clientset: %w", err)
-	}
-	initErr := initDiscovery(clientset.Discovery())
-	if initErr != nil {
-		return 0, initErr
+		return 0, err
 	}
 
-	gvr, isNamespaced, err := findResourceGVR(ctx, args.ResourceType)
+	gvr, isNamespaced, err := findResourceGVR(cached.restMapper, args.ResourceType)
 	if err != nil {
 		log.Printf("Error finding resource type '%s': %v", args.ResourceType, err)
 		return 0, err
@@ -317,7 +332,7 @@ func RunLister(ctx context.Context, config *rest.Config, args AppArgs, resultsMa
 					kind = itemsData[0].Kind
 				}
 			}
-			resourceTable := getResourceTable(kind)
+			resourceTable := getResourceTable(gvr.Group, kind)
 			// Create the ResourceTypeResult struct and add it to the map
 			resultsMap[resourceTable] = ResourceTypeResult{
 				ResourceTable: resourceTable,
@@ -341,17 +356,12 @@ func ListAllTypes(ctx context.Context, config *rest.Config, args AppArgs, result
 	if err != nil {
 		return 0, nil, fmt.Errorf("error creating dynamic client: %w", err)
 	}
-	clientset, err := kubernetes.NewForConfig(config)
+	cached, err := clusterDiscoveryCache.get(ctx, config)
 	if err != nil {
-		return 0, nil, fmt.Errorf("error creating standard clientset: %w", err)
-	}
-	initErr := initDiscovery(clientset.Discovery())
-	if initErr != nil {
-		return 0, nil, initErr
+		return 0, nil, err
 	}
 
-	discoveryClient := memory.NewMemCacheClient(clientset.Discovery())
-	resList, err := discoveryClient.ServerPreferredResources()
+	resList, err := cached.client.ServerPreferredResources()
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Printf("Warning: ServerPreferredResources API not found, discovery might be incomplete.")
@@ -416,7 +426,7 @@ func ListAllTypes(ctx context.Context, config *rest.Config, args AppArgs, result
 				kindForTableRef = gvr.Resource
 				log.Printf("[%s] Warning: Kind missing in discovery for resource, using resource name '%s' for resource_table lookup.", gvrString, kindForTableRef)
 			}
-			resourceTable := getResourceTable(kindForTableRef)
+			resourceTable := getResourceTable(gvr.Group, kindForTableRef)
 			resourceTableCounts[resourceTable] += itemsProcessed
 
 			totalItemsOverall += itemsProcessed
@@ -482,19 +492,6 @@ EndListAll:
 	return totalItemsOverall, resourceTableCounts, nil
 }
 
-// initDiscovery initializes the RESTMapper safely using sync.Once
-func initDiscovery(discoveryClient discovery.DiscoveryInterface) error {
-	var initErr error
-	discoveryOnce.Do(func() {
-		cachedDiscoveryClient := memory.NewMemCacheClient(discoveryClient)
-		restMapper = restmapper.NewDeferredDiscoveryRESTMapper(cachedDiscoveryClient)
-	})
-	if restMapper == nil {
-		return fmt.Errorf("RESTMapper failed to initialize")
-	}
-	return initErr
-}
-
 // --- Retry Logic (Enhanced) ---
 func executeWithRetry(ctx context.Context, operationName string, operation func(context.Context) error) error {
 	var lastErr error
@@ -571,10 +568,8 @@ func executeWithRetry(ctx context.Context, operationName string, operation func(
 }
 
 // --- Dynamic Resource Discovery ---
-func findResourceGVR(ctx context.Context, resourceType string) (gvr schema.GroupVersionResource, namespaced bool, err error) {
-	if restMapper == nil {
-		return schema.GroupVersionResource{}, false, fmt.Errorf("RESTMapper not initialized before finding GVR")
-	}
+// findResourceGVR resolves a kind or resource name with the RESTMapper of the cluster being described
+func findResourceGVR(restMapper meta.RESTMapper, resourceType string) (gvr schema.GroupVersionResource, namespaced bool, err error) {
 	gk := schema.GroupKind{Kind: resourceType}
 	mapping, err := restMapper.RESTMapping(gk, "")
 	if err == nil {
@@ -599,11 +594,35 @@ func findResourceGVR(ctx context.Context, resourceType string) (gvr schema.Group
 }
 
 // --- List Handler ---
-// Fetches resources page by page for a given GVR.
-// If streaming, prints item JSON to stdout immediately.
-// If not streaming, buffers item data.
+// Lists a GVR cluster-wide, or namespace by namespace for namespaced resources
+// if the credential is restricted to a set of namespaces or namespace patterns are set.
+// Cluster-scoped resources aren't listed if the credential is restricted to a set of namespaces.
 // Returns ([]K8sObjectData, int, error) -> (buffered items, count, error).
 func handleList(ctx context.Context, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, isNamespaced bool, args AppArgs) ([]provider.KubernetesResourceDescription, int, error) {
+	if !isNamespaced {
+		if args.Namespaces.IsScoped() {
+			return nil, 0, nil
+		}
+		return handleNamespaceList(ctx, dynamicClient, gvr, isNamespaced, "", args)
+	}
+
+	var itemsDataBuffer []provider.KubernetesResourceDescription
+	totalListed := 0
+	for _, namespace := range args.Namespaces.ListNamespaces() {
+		itemsData, itemsProcessed, err := handleNamespaceList(ctx, dynamicClient, gvr, isNamespaced, namespace, args)
+		itemsDataBuffer = append(itemsDataBuffer, itemsData...)
+		totalListed += itemsProcessed
+		if err != nil {
+			return itemsDataBuffer, totalListed, err
+		}
+	}
+	return itemsDataBuffer, totalListed, nil
+}
+
+// Fetches resources of a namespace ("" for all namespaces) page by page for a given GVR.
+// If streaming, prints item JSON to stdout immediately.
+// If not streaming, buffers item data.
+func handleNamespaceList(ctx context.Context, dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, isNamespaced bool, namespace string, args AppArgs) ([]provider.KubernetesResourceDescription, int, error) {
 	logPrefix := fmt.Sprintf("[%s] ", gvr.String())
 	logLimit := args.Limit
 	if logLimit <= 0 {
@@ -645,7 +664,7 @@ func handleList(ctx context.Context, dynamicClient dynamic.Interface, gvr schema
 			defer cancel()
 			resourceInterface := dynamicClient.Resource(gvr)
 			if isNamespaced {
-				list, opErr = resourceInterface.Namespace("").List(apiCtx, listOptions)
+				list, opErr = resourceInterface.Namespace(namespace).List(apiCtx, listOptions)
 			} else {
 				list, opErr = resourceInterface.List(apiCtx, listOptions)
 			}
@@ -669,50 +688,14 @@ func handleList(ctx context.Context, dynamicClient dynamic.Interface, gvr schema
 
 			for i := range list.Items {
 				item := &list.Items[i]
-				kind := item.GetKind()
-				if kind == "" {
-					kind = gvr.Resource
-					log.Printf("%sWarning: Kind missing for item %s, using resource name '%s' for resource_table lookup.", logPrefix, item.GetName(), kind)
+				// Skip objects of namespaces that are out of the integration scope, including the namespaces themselves
+				if isNamespaced && !args.Namespaces.Allows(item.GetNamespace()) {
+					continue
 				}
-				resourceTable := getResourceTable(kind)
-				lowerKind := strings.ToLower(kind)
-
-				// Prepare the main output data map with snake_case keys
-				outputData := provider.KubernetesResourceDescription{
-					Kind:              lowerKind,
-					ObjectName:        item.GetName(),
-					Namespace:         item.GetNamespace(),
-					UID:               fmt.Sprintf("%s", item.GetUID()),
-					CreationTimestamp: item.GetCreationTimestamp().Format(time.RFC3339),
-					ResourceVersion:   item.GetResourceVersion(),
-					ResourceTable:     resourceTable,
-					ApiVersion:        item.GetAPIVersion(),
-				}
-
-				// Conditionally include metadata
-				if args.IncludeMetadata {
-					metadataObj := map[string]interface{}{
-						"labels":      item.GetLabels(),
-						"annotations": item.GetAnnotations(),
-					}
-					if metadataObj["labels"] == nil {
-						metadataObj["labels"] = make(map[string]string)
-					}
-					if metadataObj["annotations"] == nil {
-						metadataObj["annotations"] = make(map[string]string)
-					}
-					outputData.Metadata = metadataObj
-				}
-
-				// Conditionally include status
-				if args.IncludeStatus {
-					statusVal := item.Object["status"]
-					if statusMap, ok := statusVal.(map[string]interface{}); !ok || statusMap == nil {
-						outputData.Status = make(map[string]interface{})
-					} else {
-						outputData.Status = statusVal
-					}
+				if !isNamespaced && gvr.GroupResource() == namespacesGroupResource && !args.Namespaces.Allows(item.GetName()) {
+					continue
 				}
+				outputData := kubernetesResourceDescription(item, gvr, isNamespaced, args.Capture)
 
 				// Decide whether to stream or buffer
 				if args.StreamMode {
@@ -741,3 +724,75 @@ func handleList(ctx context.Context, dynamicClient dynamic.Interface, gvr schema
 	log.Printf("%sSuccessfully listed %d items for this type.", logPrefix, totalListed)
 	return itemsDataBuffer, totalListed, nil // Return buffer (nil if streaming), count, and success
 }
+
+// kubernetesResourceDescription describes an object listed or got with the dynamic client
+func kubernetesResourceDescription(item *unstructured.Unstructured, gvr schema.GroupVersionResource, isNamespaced bool, capture provider.CaptureConfig) provider.KubernetesResourceDescription {
+	kind := item.GetKind()
+	if kind == "" {
+		kind = gvr.Resource
+		log.Printf("[%s] Warning: Kind missing for item %s, using resource name '%s' for resource_table lookup.", gvr.String(), item.GetName(), kind)
+	}
+
+	// Prepare the main output data map with snake_case keys
+	outputData := provider.KubernetesResourceDescription{
+		Kind:              strings.ToLower(kind),
+		ObjectName:        item.GetName(),
+		Namespace:         item.GetNamespace(),
+		UID:               fmt.Sprintf("%s", item.GetUID()),
+		CreationTimestamp: item.GetCreationTimestamp().Format(time.RFC3339),
+		ResourceVersion:   item.GetResourceVersion(),
+		ResourceTable:     getResourceTable(gvr.Group, kind),
+		ApiVersion:        item.GetAPIVersion(),
+		Group:             gvr.Group,
+		Version:           gvr.Version,
+		Resource:          gvr.Resource,
+		Namespaced:        isNamespaced,
+	}
+
+	captureObject(item, &outputData, capture)
+	return outputData
+}
+
+// captureObject copies the parts of the object selected by the capture config into its description,
+// after removing the pruned fields. The spec and status are dropped if they exceed the size limit.
+func captureObject(item *unstructured.Unstructured, outputData *provider.KubernetesResourceDescription, capture provider.CaptureConfig) {
+	if !capture.Spec && !capture.Status && !capture.Metadata {
+		return
+	}
+	for _, path := range capture.Prune {
+		unstructured.RemoveNestedField(item.Object, path...)
+	}
+
+	if capture.Metadata {
+		if metadataObj, ok := item.Object["metadata"].(map[string]interface{}); ok {
+			outputData.Metadata = metadataObj
+		}
+	}
+	if capture.Spec {
+		outputData.Spec = item.Object["spec"]
+	}
+	if capture.Status {
+		outputData.Status = item.Object["status"]
+	}
+
+	if capture.MaxBytes > 0 && (outputData.Spec != nil || outputData.Status != nil) {
+		size := 0
+		for _, value := range []interface{}{outputData.Spec, outputData.Status} {
+			if value == nil {
+				continue
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				size = capture.MaxBytes + 1
+				break
+			}
+			size += len(data)
+		}
+		if size > capture.MaxBytes {
+			log.Printf("Warning: spec and status of %s %s/%s exceed %d bytes, dropping them", outputData.Kind, item.GetNamespace(), item.GetName(), capture.MaxBytes)
+			outputData.Spec = nil
+			outputData.Status = nil
+			outputData.Truncated = true
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package describers
+
+import (
+	"testing"
+)
+
+func TestGetResourceTable(t *testing.T) {
+	tests := []struct {
+		group string
+		kind  string
+		want  string
+	}{
+		{group: "", kind: "Pod", want: "k8_pod"},
+		{group: "", kind: "Event", want: "k8_event"},
+		{group: "events.k8s.io", kind: "Event", want: "k8_event"},
+		{group: "argoproj.io", kind: "Application", want: "k8_argo_application"},
+		{group: "app.k8s.io", kind: "Application", want: "k8_custom_resource"},
+		{group: "kustomize.toolkit.fluxcd.io", kind: "Kustomization", want: "k8_flux_kustomization"},
+		{group: "kustomize.config.k8s.io", kind: "Kustomization", want: "k8_custom_resource"},
+		{group: "cert-manager.io", kind: "Certificate", want: "k8_certificate"},
+		{group: "", kind: "Deployment", want: "k8_custom_resource"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.group+"/"+tt.kind, func(t *testing.T) {
+			if got := getResourceTable(tt.group, tt.kind); got != tt.want {
+				t.Errorf("getResourceTable(%q, %q) = %q, want %q", tt.group, tt.kind, got, tt.want)
+			}
+		})
+	}
+}
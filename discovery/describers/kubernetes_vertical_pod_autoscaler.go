@@ -0,0 +1,84 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const verticalPodAutoscalersCRD = "verticalpodautoscalers.autoscaling.k8s.io"
+
+func KubernetesVerticalPodAutoscaler(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, verticalPodAutoscalersCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesVerticalPodAutoscalerResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert vertical pod autoscaler, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesVerticalPodAutoscaler(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "verticalpodautoscaler")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, verticalPodAutoscalersCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesVerticalPodAutoscalerResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesVerticalPodAutoscalerResource(item *unstructured.Unstructured) (models.Resource, error) {
+	verticalPodAutoscaler, err := helpers.ConvertVerticalPodAutoscaler(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	containerRecommendations, err := verticalPodAutoscaler.ContainerRecommendations()
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("verticalpodautoscaler/%s/%s", verticalPodAutoscaler.Namespace, verticalPodAutoscaler.Name),
+		Name: fmt.Sprintf("%s/%s", verticalPodAutoscaler.Namespace, verticalPodAutoscaler.Name),
+		Description: model.KubernetesVerticalPodAutoscalerDescription{
+			MetaObject:               verticalPodAutoscaler.ObjectMeta,
+			VerticalPodAutoscaler:    verticalPodAutoscaler,
+			ContainerRecommendations: containerRecommendations,
+		},
+	}, nil
+}
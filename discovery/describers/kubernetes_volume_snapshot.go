@@ -0,0 +1,213 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	model "github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	volumeSnapshotsCRD        = "volumesnapshots.snapshot.storage.k8s.io"
+	volumeSnapshotClassesCRD  = "volumesnapshotclasses.snapshot.storage.k8s.io"
+	volumeSnapshotContentsCRD = "volumesnapshotcontents.snapshot.storage.k8s.io"
+)
+
+func KubernetesVolumeSnapshot(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, volumeSnapshotsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesVolumeSnapshotResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert volume snapshot, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesVolumeSnapshot(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	namespace, name, err := parseNamespacedResourceID(resourceID, "volumesnapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, volumeSnapshotsCRD, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesVolumeSnapshotResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesVolumeSnapshotResource(item *unstructured.Unstructured) (models.Resource, error) {
+	volumeSnapshot, err := helpers.ConvertVolumeSnapshot(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("volumesnapshot/%s/%s", volumeSnapshot.Namespace, volumeSnapshot.Name),
+		Name: fmt.Sprintf("%s/%s", volumeSnapshot.Namespace, volumeSnapshot.Name),
+		Description: model.KubernetesVolumeSnapshotDescription{
+			MetaObject:     volumeSnapshot.ObjectMeta,
+			VolumeSnapshot: volumeSnapshot,
+		},
+	}, nil
+}
+
+func KubernetesVolumeSnapshotClass(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, volumeSnapshotClassesCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesVolumeSnapshotClassResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert volume snapshot class, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesVolumeSnapshotClass(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "volumesnapshotclass")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, volumeSnapshotClassesCRD, "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesVolumeSnapshotClassResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesVolumeSnapshotClassResource(item *unstructured.Unstructured) (models.Resource, error) {
+	volumeSnapshotClass, err := helpers.ConvertVolumeSnapshotClass(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("volumesnapshotclass/%s", volumeSnapshotClass.Name),
+		Name: volumeSnapshotClass.Name,
+		Description: model.KubernetesVolumeSnapshotClassDescription{
+			MetaObject:          volumeSnapshotClass.ObjectMeta,
+			VolumeSnapshotClass: volumeSnapshotClass,
+		},
+	}, nil
+}
+
+func KubernetesVolumeSnapshotContent(ctx context.Context, client model.Client, extra string, stream *models.StreamSender) ([]models.Resource, error) {
+	var allValues []models.Resource
+
+	err := listCustomResources(ctx, client, volumeSnapshotContentsCRD, func(item *unstructured.Unstructured) error {
+		resource, err := kubernetesVolumeSnapshotContentResource(item)
+		if err != nil {
+			GetLoggerFromContext(ctx).Warn("failed to convert volume snapshot content, skipping it",
+				zap.String("namespace", item.GetNamespace()), zap.String("name", item.GetName()), zap.Error(err))
+			return nil
+		}
+		if stream != nil {
+			if err := (*stream)(resource); err != nil {
+				return fmt.Errorf("error streaming resource: %w", err)
+			}
+		} else {
+			allValues = append(allValues, resource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allValues, nil
+}
+
+func GetKubernetesVolumeSnapshotContent(ctx context.Context, client model.Client, extra string, resourceID string, stream *models.StreamSender) (*models.Resource, error) {
+	name, err := parseClusterResourceID(resourceID, "volumesnapshotcontent")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := getCustomResource(ctx, client, volumeSnapshotContentsCRD, "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := kubernetesVolumeSnapshotContentResource(item)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		if err := (*stream)(resource); err != nil {
+			return nil, fmt.Errorf("error streaming resource: %w", err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func kubernetesVolumeSnapshotContentResource(item *unstructured.Unstructured) (models.Resource, error) {
+	volumeSnapshotContent, err := helpers.ConvertVolumeSnapshotContent(item)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	return models.Resource{
+		ID:   fmt.Sprintf("volumesnapshotcontent/%s", volumeSnapshotContent.Name),
+		Name: volumeSnapshotContent.Name,
+		Description: model.KubernetesVolumeSnapshotContentDescription{
+			MetaObject:            volumeSnapshotContent.ObjectMeta,
+			VolumeSnapshotContent: volumeSnapshotContent,
+		},
+	}, nil
+}
@@ -0,0 +1,95 @@
+package describers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	describerPageSize int64 = 500
+	maxListRestarts   int   = 3
+)
+
+// listPaged lists a collection page by page using Limit/Continue and calls handle once for every item.
+// If the continue token expires (410 Gone) while paging, the list is restarted from the beginning
+// and the items that were already handled are skipped.
+func listPaged[L runtime.Object, T runtime.Object](ctx context.Context, list func(context.Context, metav1.ListOptions) (L, error), handle func(T) error) error {
+	seen := make(map[types.UID]struct{})
+	continueToken := ""
+	restarts := 0
+
+	for {
+		page, err := list(ctx, metav1.ListOptions{Limit: describerPageSize, Continue: continueToken})
+		if err != nil {
+			if continueToken != "" && (apierrors.IsResourceExpired(err) || apierrors.IsGone(err)) && restarts < maxListRestarts {
+				restarts++
+				GetLoggerFromContext(ctx).Warn("continue token expired, restarting list", zap.Int("restart", restarts))
+				continueToken = ""
+				continue
+			}
+			return err
+		}
+
+		err = meta.EachListItem(page, func(obj runtime.Object) error {
+			item, ok := obj.(T)
+			if !ok {
+				return fmt.Errorf("unexpected list item type %T", obj)
+			}
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			if uid := accessor.GetUID(); uid != "" {
+				if _, ok := seen[uid]; ok {
+					return nil
+				}
+				seen[uid] = struct{}{}
+			}
+			return handle(item)
+		})
+		if err != nil {
+			return err
+		}
+
+		listAccessor, err := meta.ListAccessor(page)
+		if err != nil {
+			return err
+		}
+		continueToken = listAccessor.GetContinue()
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
+
+type namespacedLister[L runtime.Object] interface {
+	List(context.Context, metav1.ListOptions) (L, error)
+}
+
+// listNamespacedPaged lists a namespaced collection with listPaged, either cluster-wide or, if the credential
+// is restricted to a set of namespaces or namespace patterns are set, namespace by namespace.
+func listNamespacedPaged[I namespacedLister[L], L runtime.Object, T runtime.Object](ctx context.Context, namespaces provider.NamespaceFilter, lister func(string) I, handle func(T) error) error {
+	for _, namespace := range namespaces.ListNamespaces() {
+		if err := listPaged(ctx, lister(namespace).List, handle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listClusterPaged lists a cluster-scoped collection with listPaged. Nothing is listed if the credential is restricted
+// to a set of namespaces, its roles are bound in those namespaces and can't grant access to cluster-scoped resources.
+func listClusterPaged[L runtime.Object, T runtime.Object](ctx context.Context, namespaces provider.NamespaceFilter, list func(context.Context, metav1.ListOptions) (L, error), handle func(T) error) error {
+	if namespaces.IsScoped() {
+		return nil
+	}
+	return listPaged(ctx, list, handle)
+}
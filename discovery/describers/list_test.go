@@ -0,0 +1,206 @@
+package describers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/opengovern/og-describer-kubernetes/discovery/provider"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// listCall is the expected continue token of a List call and the page, or error, it returns
+type listCall struct {
+	continueToken string
+	uids          []string
+	next          string
+	err           error
+}
+
+// fakePodList returns a list func serving the calls in order, it fails the test on unexpected calls
+func fakePodList(t *testing.T, calls []listCall) (func(context.Context, metav1.ListOptions) (*corev1.PodList, error), *int) {
+	made := 0
+	return func(_ context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+		if made >= len(calls) {
+			t.Fatalf("unexpected List call %d with continue %q", made+1, opts.Continue)
+		}
+		call := calls[made]
+		made++
+		if opts.Continue != call.continueToken {
+			t.Errorf("List call %d: continue = %q, want %q", made, opts.Continue, call.continueToken)
+		}
+		if opts.Limit != describerPageSize {
+			t.Errorf("List call %d: limit = %d, want %d", made, opts.Limit, describerPageSize)
+		}
+		if call.err != nil {
+			return nil, call.err
+		}
+		list := &corev1.PodList{ListMeta: metav1.ListMeta{Continue: call.next}}
+		for _, uid := range call.uids {
+			list.Items = append(list.Items, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-" + uid, UID: types.UID(uid)}})
+		}
+		return list, nil
+	}, &made
+}
+
+func TestListPaged(t *testing.T) {
+	expired := apierrors.NewResourceExpired("continue token expired")
+	gone := apierrors.NewGone("gone")
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("denied"))
+
+	tests := []struct {
+		name    string
+		calls   []listCall
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "single page",
+			calls: []listCall{{uids: []string{"a", "b"}}},
+			want:  []string{"pod-a", "pod-b"},
+		},
+		{
+			name: "pages are followed until the continue token is empty",
+			calls: []listCall{
+				{uids: []string{"a"}, next: "1"},
+				{continueToken: "1", uids: []string{"b"}, next: "2"},
+				{continueToken: "2", uids: []string{"c"}},
+			},
+			want: []string{"pod-a", "pod-b", "pod-c"},
+		},
+		{
+			name: "expired continue token restarts the list and skips the handled items",
+			calls: []listCall{
+				{uids: []string{"a", "b"}, next: "1"},
+				{continueToken: "1", err: expired},
+				{uids: []string{"a", "b"}, next: "1"},
+				{continueToken: "1", uids: []string{"c"}},
+			},
+			want: []string{"pod-a", "pod-b", "pod-c"},
+		},
+		{
+			name: "gone continue token restarts the list",
+			calls: []listCall{
+				{uids: []string{"a"}, next: "1"},
+				{continueToken: "1", err: gone},
+				{uids: []string{"b", "a"}},
+			},
+			want: []string{"pod-a", "pod-b"},
+		},
+		{
+			name:    "errors of the first page are returned",
+			calls:   []listCall{{err: expired}},
+			wantErr: expired,
+		},
+		{
+			name: "other errors while paging are returned",
+			calls: []listCall{
+				{uids: []string{"a"}, next: "1"},
+				{continueToken: "1", err: forbidden},
+			},
+			want:    []string{"pod-a"},
+			wantErr: forbidden,
+		},
+		{
+			name: "restarts are limited",
+			calls: func() []listCall {
+				var calls []listCall
+				for i := 0; i <= maxListRestarts; i++ {
+					calls = append(calls, listCall{uids: []string{"a"}, next: "1"}, listCall{continueToken: "1", err: expired})
+				}
+				return calls
+			}(),
+			want:    []string{"pod-a"},
+			wantErr: expired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, made := fakePodList(t, tt.calls)
+			var got []string
+			err := listPaged(context.Background(), list, func(pod *corev1.Pod) error {
+				got = append(got, pod.Name)
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("listPaged() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("handled %v, want %v", got, tt.want)
+			}
+			if *made != len(tt.calls) {
+				t.Errorf("List called %d times, want %d", *made, len(tt.calls))
+			}
+		})
+	}
+}
+
+func TestListPagedItemsWithoutUID(t *testing.T) {
+	list := func(context.Context, metav1.ListOptions) (*corev1.PodList, error) {
+		return &corev1.PodList{Items: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "a"}}, {ObjectMeta: metav1.ObjectMeta{Name: "b"}}}}, nil
+	}
+	handled := 0
+	err := listPaged(context.Background(), list, func(*corev1.Pod) error {
+		handled++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("listPaged() error = %v", err)
+	}
+	if handled != 2 {
+		t.Errorf("handled %d items, want 2", handled)
+	}
+}
+
+func TestListPagedHandleError(t *testing.T) {
+	list, _ := fakePodList(t, []listCall{{uids: []string{"a", "b"}, next: "1"}})
+	handleErr := fmt.Errorf("stream closed")
+	handled := 0
+	err := listPaged(context.Background(), list, func(*corev1.Pod) error {
+		handled++
+		return handleErr
+	})
+	if !errors.Is(err, handleErr) {
+		t.Fatalf("listPaged() error = %v, want %v", err, handleErr)
+	}
+	if handled != 1 {
+		t.Errorf("handled %d items, want 1", handled)
+	}
+}
+
+func TestListClusterPaged(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces provider.NamespaceFilter
+		calls      []listCall
+		want       []string
+	}{
+		{name: "unscoped credentials list cluster-wide", namespaces: provider.NamespaceFilter{Include: []string{"team-*"}}, calls: []listCall{{uids: []string{"a"}}}, want: []string{"pod-a"}},
+		{name: "scoped credentials list nothing", namespaces: provider.NamespaceFilter{Scope: []string{"team-a"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, made := fakePodList(t, tt.calls)
+			var got []string
+			err := listClusterPaged(context.Background(), tt.namespaces, list, func(pod *corev1.Pod) error {
+				got = append(got, pod.Name)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("listClusterPaged() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("handled %v, want %v", got, tt.want)
+			}
+			if *made != len(tt.calls) {
+				t.Errorf("List called %d times, want %d", *made, len(tt.calls))
+			}
+		})
+	}
+}
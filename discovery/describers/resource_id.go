@@ -0,0 +1,70 @@
+package describers
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// parseNamespacedResourceID parses the <kind>/<namespace>/<name> resource IDs produced by the list describers
+func parseNamespacedResourceID(resourceID string, kind string) (string, string, error) {
+	id, ok := strings.CutPrefix(resourceID, kind+"/")
+	if !ok {
+		return "", "", fmt.Errorf("invalid %s resource id %q, expected %s/<namespace>/<name>", kind, resourceID, kind)
+	}
+	namespace, name, ok := strings.Cut(id, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("invalid %s resource id %q, expected %s/<namespace>/<name>", kind, resourceID, kind)
+	}
+	return namespace, name, nil
+}
+
+// parseClusterResourceID parses the <kind>/<name> resource IDs produced by the list describers of cluster-scoped resources
+func parseClusterResourceID(resourceID string, kind string) (string, error) {
+	name, ok := strings.CutPrefix(resourceID, kind+"/")
+	if !ok || name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("invalid %s resource id %q, expected %s/<name>", kind, resourceID, kind)
+	}
+	return name, nil
+}
+
+// parseClusterContextResourceID parses the cluster/<context name> resource ID of the cluster. Context names may contain
+// slashes (e.g. EKS ARNs arn:aws:eks:<region>:<account>:cluster/<name>), so the rest of the ID is taken as it is.
+func parseClusterContextResourceID(resourceID string) (string, error) {
+	contextName, ok := strings.CutPrefix(resourceID, "cluster/")
+	if !ok || contextName == "" {
+		return "", fmt.Errorf("invalid cluster resource id %q, expected cluster/<context name>", resourceID)
+	}
+	return contextName, nil
+}
+
+// parseResourceID parses the resource/<resource>.<group>/<version>/<namespace>/<name> resource IDs of the objects described
+// by the generic lister, the group is omitted for the core API group and the namespace is empty for cluster-scoped objects
+func parseResourceID(resourceID string) (gvr schema.GroupVersionResource, namespace, name string, err error) {
+	id, ok := strings.CutPrefix(resourceID, "resource/")
+	parts := strings.Split(id, "/")
+	if !ok || len(parts) != 4 {
+		return schema.GroupVersionResource{}, "", "", fmt.Errorf("invalid resource id %q, expected resource/<resource>.<group>/<version>/<namespace>/<name>", resourceID)
+	}
+	resource, group, _ := strings.Cut(parts[0], ".")
+	if resource == "" || parts[1] == "" || parts[3] == "" {
+		return schema.GroupVersionResource{}, "", "", fmt.Errorf("invalid resource id %q, expected resource/<resource>.<group>/<version>/<namespace>/<name>", resourceID)
+	}
+	return schema.GroupVersionResource{Group: group, Version: parts[1], Resource: resource}, parts[2], parts[3], nil
+}
+
+// parseCustomResourceID parses the customresource/<kind>.<group>/<version>/<namespace>/<name> resource IDs,
+// the namespace is empty for cluster-scoped custom resources
+func parseCustomResourceID(resourceID string) (kind, group, version, namespace, name string, err error) {
+	id, ok := strings.CutPrefix(resourceID, "customresource/")
+	parts := strings.Split(id, "/")
+	if !ok || len(parts) != 4 {
+		return "", "", "", "", "", fmt.Errorf("invalid custom resource id %q, expected customresource/<kind>.<group>/<version>/<namespace>/<name>", resourceID)
+	}
+	kind, group, ok = strings.Cut(parts[0], ".")
+	if !ok || kind == "" || group == "" || parts[1] == "" || parts[3] == "" {
+		return "", "", "", "", "", fmt.Errorf("invalid custom resource id %q, expected customresource/<kind>.<group>/<version>/<namespace>/<name>", resourceID)
+	}
+	return kind, group, parts[1], parts[2], parts[3], nil
+}
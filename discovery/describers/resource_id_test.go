@@ -0,0 +1,60 @@
+package describers
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestParseClusterContextResourceID(t *testing.T) {
+	tests := []struct {
+		resourceID string
+		want       string
+		wantErr    bool
+	}{
+		{resourceID: "cluster/kind-dev", want: "kind-dev"},
+		{resourceID: "cluster/arn:aws:eks:eu-west-1:123456789012:cluster/prod", want: "arn:aws:eks:eu-west-1:123456789012:cluster/prod"},
+		{resourceID: "cluster/", wantErr: true},
+		{resourceID: "namespace/default", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resourceID, func(t *testing.T) {
+			got, err := parseClusterContextResourceID(tt.resourceID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseClusterContextResourceID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseClusterContextResourceID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseResourceID(t *testing.T) {
+	tests := []struct {
+		resourceID    string
+		wantGVR       schema.GroupVersionResource
+		wantNamespace string
+		wantName      string
+		wantErr       bool
+	}{
+		{resourceID: "resource/deployments.apps/v1/default/web", wantGVR: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, wantNamespace: "default", wantName: "web"},
+		{resourceID: "resource/configmaps/v1/kube-system/coredns", wantGVR: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, wantNamespace: "kube-system", wantName: "coredns"},
+		{resourceID: "resource/clusterroles.rbac.authorization.k8s.io/v1//admin", wantGVR: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}, wantName: "admin"},
+		{resourceID: "resource/deployments.apps/v1/default", wantErr: true},
+		{resourceID: "resource/deployments.apps//default/web", wantErr: true},
+		{resourceID: "resource/deployments.apps/v1/default/", wantErr: true},
+		{resourceID: "customresource/Widget.example.com/v1/default/w", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resourceID, func(t *testing.T) {
+			gvr, namespace, name, err := parseResourceID(tt.resourceID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseResourceID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gvr != tt.wantGVR || namespace != tt.wantNamespace || name != tt.wantName {
+				t.Errorf("parseResourceID() = %v, %q, %q, want %v, %q, %q", gvr, namespace, name, tt.wantGVR, tt.wantNamespace, tt.wantName)
+			}
+		})
+	}
+}
@@ -97,12 +97,20 @@ func (p KubernetesResourcePaginator) NextPage(ctx context.Context) ([]Kubernetes
 var listKubernetesResourceFilters = map[string]string{
 	"api_version":        "Description.ApiVersion",
 	"creation_timestamp": "Description.CreationTimestamp",
+	"group":              "Description.Group",
 	"kind":               "Description.Kind",
+	"metadata":           "Description.Metadata",
 	"namespace":          "Description.Namespace",
+	"namespaced":         "Description.Namespaced",
 	"object_name":        "Description.ObjectName",
+	"resource":           "Description.Resource",
 	"resource_table":     "Description.ResourceTable",
 	"resource_version":   "Description.ResourceVersion",
+	"spec":               "Description.Spec",
+	"status":             "Description.Status",
+	"truncated":          "Description.Truncated",
 	"uid":                "Description.UID",
+	"version":            "Description.Version",
 }
 
 func ListKubernetesResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -168,12 +176,20 @@ func ListKubernetesResource(ctx context.Context, d *plugin.QueryData, _ *plugin.
 var getKubernetesResourceFilters = map[string]string{
 	"api_version":        "Description.ApiVersion",
 	"creation_timestamp": "Description.CreationTimestamp",
+	"group":              "Description.Group",
 	"kind":               "Description.Kind",
+	"metadata":           "Description.Metadata",
 	"namespace":          "Description.Namespace",
+	"namespaced":         "Description.Namespaced",
 	"object_name":        "Description.ObjectName",
+	"resource":           "Description.Resource",
 	"resource_table":     "Description.ResourceTable",
 	"resource_version":   "Description.ResourceVersion",
+	"spec":               "Description.Spec",
+	"status":             "Description.Status",
+	"truncated":          "Description.Truncated",
 	"uid":                "Description.UID",
+	"version":            "Description.Version",
 }
 
 func GetKubernetesResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -231,72 +247,72 @@ func GetKubernetesResource(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 // ==========================  END: KubernetesResource =============================
 
-// ==========================  START: KubernetesCluster =============================
+// ==========================  START: KubernetesAPIService =============================
 
-type KubernetesCluster struct {
-	ResourceID      string                                  `json:"resource_id"`
-	PlatformID      string                                  `json:"platform_id"`
-	Description     kubernetes.KubernetesClusterDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                     `json:"metadata"`
-	DescribedBy     string                                  `json:"described_by"`
-	ResourceType    string                                  `json:"resource_type"`
-	IntegrationType string                                  `json:"integration_type"`
-	IntegrationID   string                                  `json:"integration_id"`
+type KubernetesAPIService struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesAPIServiceDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
 }
 
-type KubernetesClusterHit struct {
-	ID      string            `json:"_id"`
-	Score   float64           `json:"_score"`
-	Index   string            `json:"_index"`
-	Type    string            `json:"_type"`
-	Version int64             `json:"_version,omitempty"`
-	Source  KubernetesCluster `json:"_source"`
-	Sort    []interface{}     `json:"sort"`
+type KubernetesAPIServiceHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesAPIService `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
 }
 
-type KubernetesClusterHits struct {
-	Total essdk.SearchTotal      `json:"total"`
-	Hits  []KubernetesClusterHit `json:"hits"`
+type KubernetesAPIServiceHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesAPIServiceHit `json:"hits"`
 }
 
-type KubernetesClusterSearchResponse struct {
-	PitID string                `json:"pit_id"`
-	Hits  KubernetesClusterHits `json:"hits"`
+type KubernetesAPIServiceSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesAPIServiceHits `json:"hits"`
 }
 
-type KubernetesClusterPaginator struct {
+type KubernetesAPIServicePaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesClusterPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_cluster", filters, limit)
+func (k Client) NewKubernetesAPIServicePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesAPIServicePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_apiservice", filters, limit)
 	if err != nil {
-		return KubernetesClusterPaginator{}, err
+		return KubernetesAPIServicePaginator{}, err
 	}
 
-	p := KubernetesClusterPaginator{
+	p := KubernetesAPIServicePaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesClusterPaginator) HasNext() bool {
+func (p KubernetesAPIServicePaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesClusterPaginator) Close(ctx context.Context) error {
+func (p KubernetesAPIServicePaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesClusterPaginator) NextPage(ctx context.Context) ([]KubernetesCluster, error) {
-	var response KubernetesClusterSearchResponse
+func (p KubernetesAPIServicePaginator) NextPage(ctx context.Context) ([]KubernetesAPIService, error) {
+	var response KubernetesAPIServiceSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesCluster
+	var values []KubernetesAPIService
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -311,58 +327,69 @@ func (p KubernetesClusterPaginator) NextPage(ctx context.Context) ([]KubernetesC
 	return values, nil
 }
 
-var listKubernetesClusterFilters = map[string]string{
-	"auth_method":             "Description.AuthMethod",
-	"context_name":            "Description.ContextName",
-	"endpoint":                "Description.Endpoint",
-	"server_version":          "Description.ServerVersion",
-	"tls_server_verification": "Description.TLSServerVerification",
-}
-
-func ListKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesCluster")
+var listKubernetesAPIServiceFilters = map[string]string{
+	"available":                      "Description.Available",
+	"available_last_transition_time": "Description.AvailableCondition.LastTransitionTime",
+	"available_message":              "Description.AvailableCondition.Message",
+	"available_reason":               "Description.AvailableCondition.Reason",
+	"conditions":                     "Description.APIService.Status.Conditions",
+	"group":                          "Description.APIService.Spec.Group",
+	"group_priority_minimum":         "Description.APIService.Spec.GroupPriorityMinimum",
+	"has_ca_bundle":                  "Description.APIService.Spec.HasCABundle",
+	"insecure_skip_tls_verify":       "Description.APIService.Spec.InsecureSkipTLSVerify",
+	"platform_integration_id":        "IntegrationID",
+	"service":                        "Description.APIService.Spec.Service",
+	"service_name":                   "Description.APIService.Spec.Service.Name",
+	"service_namespace":              "Description.APIService.Spec.Service.Namespace",
+	"title":                          "Description.MetaObject.Name",
+	"version":                        "Description.APIService.Spec.Version",
+	"version_priority":               "Description.APIService.Spec.VersionPriority",
+}
+
+func ListKubernetesAPIService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesAPIService")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCluster NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesAPIService NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCluster NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesAPIService NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCluster GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesAPIService GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCluster GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesAPIService GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCluster GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesAPIService GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesClusterPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesAPIServicePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesAPIServiceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCluster NewKubernetesClusterPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesAPIService NewKubernetesAPIServicePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesCluster paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesAPIService paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -379,16 +406,28 @@ func ListKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
-var getKubernetesClusterFilters = map[string]string{
-	"auth_method":             "Description.AuthMethod",
-	"context_name":            "Description.ContextName",
-	"endpoint":                "Description.Endpoint",
-	"server_version":          "Description.ServerVersion",
-	"tls_server_verification": "Description.TLSServerVerification",
-}
-
-func GetKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesCluster")
+var getKubernetesAPIServiceFilters = map[string]string{
+	"available":                      "Description.Available",
+	"available_last_transition_time": "Description.AvailableCondition.LastTransitionTime",
+	"available_message":              "Description.AvailableCondition.Message",
+	"available_reason":               "Description.AvailableCondition.Reason",
+	"conditions":                     "Description.APIService.Status.Conditions",
+	"group":                          "Description.APIService.Spec.Group",
+	"group_priority_minimum":         "Description.APIService.Spec.GroupPriorityMinimum",
+	"has_ca_bundle":                  "Description.APIService.Spec.HasCABundle",
+	"insecure_skip_tls_verify":       "Description.APIService.Spec.InsecureSkipTLSVerify",
+	"name":                           "Description.MetaObject.Name",
+	"platform_integration_id":        "IntegrationID",
+	"service":                        "Description.APIService.Spec.Service",
+	"service_name":                   "Description.APIService.Spec.Service.Name",
+	"service_namespace":              "Description.APIService.Spec.Service.Namespace",
+	"title":                          "Description.MetaObject.Name",
+	"version":                        "Description.APIService.Spec.Version",
+	"version_priority":               "Description.APIService.Spec.VersionPriority",
+}
+
+func GetKubernetesAPIService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesAPIService")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -416,7 +455,7 @@ func GetKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesClusterPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesAPIServicePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesAPIServiceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -440,74 +479,74 @@ func GetKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	return nil, nil
 }
 
-// ==========================  END: KubernetesCluster =============================
+// ==========================  END: KubernetesAPIService =============================
 
-// ==========================  START: KubernetesClusterRole =============================
+// ==========================  START: KubernetesArgoApplication =============================
 
-type KubernetesClusterRole struct {
-	ResourceID      string                                      `json:"resource_id"`
-	PlatformID      string                                      `json:"platform_id"`
-	Description     kubernetes.KubernetesClusterRoleDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                         `json:"metadata"`
-	DescribedBy     string                                      `json:"described_by"`
-	ResourceType    string                                      `json:"resource_type"`
-	IntegrationType string                                      `json:"integration_type"`
-	IntegrationID   string                                      `json:"integration_id"`
+type KubernetesArgoApplication struct {
+	ResourceID      string                                          `json:"resource_id"`
+	PlatformID      string                                          `json:"platform_id"`
+	Description     kubernetes.KubernetesArgoApplicationDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                             `json:"metadata"`
+	DescribedBy     string                                          `json:"described_by"`
+	ResourceType    string                                          `json:"resource_type"`
+	IntegrationType string                                          `json:"integration_type"`
+	IntegrationID   string                                          `json:"integration_id"`
 }
 
-type KubernetesClusterRoleHit struct {
-	ID      string                `json:"_id"`
-	Score   float64               `json:"_score"`
-	Index   string                `json:"_index"`
-	Type    string                `json:"_type"`
-	Version int64                 `json:"_version,omitempty"`
-	Source  KubernetesClusterRole `json:"_source"`
-	Sort    []interface{}         `json:"sort"`
+type KubernetesArgoApplicationHit struct {
+	ID      string                    `json:"_id"`
+	Score   float64                   `json:"_score"`
+	Index   string                    `json:"_index"`
+	Type    string                    `json:"_type"`
+	Version int64                     `json:"_version,omitempty"`
+	Source  KubernetesArgoApplication `json:"_source"`
+	Sort    []interface{}             `json:"sort"`
 }
 
-type KubernetesClusterRoleHits struct {
-	Total essdk.SearchTotal          `json:"total"`
-	Hits  []KubernetesClusterRoleHit `json:"hits"`
+type KubernetesArgoApplicationHits struct {
+	Total essdk.SearchTotal              `json:"total"`
+	Hits  []KubernetesArgoApplicationHit `json:"hits"`
 }
 
-type KubernetesClusterRoleSearchResponse struct {
-	PitID string                    `json:"pit_id"`
-	Hits  KubernetesClusterRoleHits `json:"hits"`
+type KubernetesArgoApplicationSearchResponse struct {
+	PitID string                        `json:"pit_id"`
+	Hits  KubernetesArgoApplicationHits `json:"hits"`
 }
 
-type KubernetesClusterRolePaginator struct {
+type KubernetesArgoApplicationPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesClusterRolePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterRolePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_clusterrole", filters, limit)
+func (k Client) NewKubernetesArgoApplicationPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesArgoApplicationPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_argoapplication", filters, limit)
 	if err != nil {
-		return KubernetesClusterRolePaginator{}, err
+		return KubernetesArgoApplicationPaginator{}, err
 	}
 
-	p := KubernetesClusterRolePaginator{
+	p := KubernetesArgoApplicationPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesClusterRolePaginator) HasNext() bool {
+func (p KubernetesArgoApplicationPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesClusterRolePaginator) Close(ctx context.Context) error {
+func (p KubernetesArgoApplicationPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesClusterRolePaginator) NextPage(ctx context.Context) ([]KubernetesClusterRole, error) {
-	var response KubernetesClusterRoleSearchResponse
+func (p KubernetesArgoApplicationPaginator) NextPage(ctx context.Context) ([]KubernetesArgoApplication, error) {
+	var response KubernetesArgoApplicationSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesClusterRole
+	var values []KubernetesArgoApplication
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -522,56 +561,79 @@ func (p KubernetesClusterRolePaginator) NextPage(ctx context.Context) ([]Kuberne
 	return values, nil
 }
 
-var listKubernetesClusterRoleFilters = map[string]string{
-	"aggregation_rule": "Description.ClusterRole.AggregationRule",
-	"rules":            "Description.ClusterRole.Rules",
-	"title":            "Description.ClusterRole.Name",
-}
-
-func ListKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesClusterRole")
+var listKubernetesArgoApplicationFilters = map[string]string{
+	"conditions":              "Description.ArgoApplication.Status.Conditions",
+	"destination_name":        "Description.ArgoApplication.Spec.Destination.Name",
+	"destination_namespace":   "Description.ArgoApplication.Spec.Destination.Namespace",
+	"destination_server":      "Description.ArgoApplication.Spec.Destination.Server",
+	"health_message":          "Description.ArgoApplication.Status.Health.Message",
+	"health_status":           "Description.ArgoApplication.Status.Health.Status",
+	"images":                  "Description.ArgoApplication.Status.Summary.Images",
+	"operation_finished_at":   "Description.ArgoApplication.Status.OperationState.FinishedAt",
+	"operation_message":       "Description.ArgoApplication.Status.OperationState.Message",
+	"operation_phase":         "Description.ArgoApplication.Status.OperationState.Phase",
+	"operation_started_at":    "Description.ArgoApplication.Status.OperationState.StartedAt",
+	"operation_state":         "Description.ArgoApplication.Status.OperationState",
+	"platform_integration_id": "IntegrationID",
+	"project":                 "Description.ArgoApplication.Spec.Project",
+	"reconciled_at":           "Description.ArgoApplication.Status.ReconciledAt",
+	"resources":               "Description.ArgoApplication.Status.Resources",
+	"source_chart":            "Description.ArgoApplication.Spec.Source.Chart",
+	"source_path":             "Description.ArgoApplication.Spec.Source.Path",
+	"source_repo_url":         "Description.ArgoApplication.Spec.Source.RepoURL",
+	"source_target_revision":  "Description.ArgoApplication.Spec.Source.TargetRevision",
+	"source_type":             "Description.ArgoApplication.Status.SourceType",
+	"sources":                 "Description.ArgoApplication.Spec.Sources",
+	"sync_policy":             "Description.ArgoApplication.Spec.SyncPolicy",
+	"sync_revision":           "Description.ArgoApplication.Status.Sync.Revision",
+	"sync_status":             "Description.ArgoApplication.Status.Sync.Status",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesArgoApplication(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesArgoApplication")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRole NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoApplication NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRole NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoApplication NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoApplication GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoApplication GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoApplication GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesClusterRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesArgoApplicationPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesArgoApplicationFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRole NewKubernetesClusterRolePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoApplication NewKubernetesArgoApplicationPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesClusterRole paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesArgoApplication paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -588,14 +650,39 @@ func ListKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plug
 	return nil, nil
 }
 
-var getKubernetesClusterRoleFilters = map[string]string{
-	"aggregation_rule": "Description.ClusterRole.AggregationRule",
-	"rules":            "Description.ClusterRole.Rules",
-	"title":            "Description.ClusterRole.Name",
-}
-
-func GetKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesClusterRole")
+var getKubernetesArgoApplicationFilters = map[string]string{
+	"conditions":              "Description.ArgoApplication.Status.Conditions",
+	"destination_name":        "Description.ArgoApplication.Spec.Destination.Name",
+	"destination_namespace":   "Description.ArgoApplication.Spec.Destination.Namespace",
+	"destination_server":      "Description.ArgoApplication.Spec.Destination.Server",
+	"health_message":          "Description.ArgoApplication.Status.Health.Message",
+	"health_status":           "Description.ArgoApplication.Status.Health.Status",
+	"images":                  "Description.ArgoApplication.Status.Summary.Images",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"operation_finished_at":   "Description.ArgoApplication.Status.OperationState.FinishedAt",
+	"operation_message":       "Description.ArgoApplication.Status.OperationState.Message",
+	"operation_phase":         "Description.ArgoApplication.Status.OperationState.Phase",
+	"operation_started_at":    "Description.ArgoApplication.Status.OperationState.StartedAt",
+	"operation_state":         "Description.ArgoApplication.Status.OperationState",
+	"platform_integration_id": "IntegrationID",
+	"project":                 "Description.ArgoApplication.Spec.Project",
+	"reconciled_at":           "Description.ArgoApplication.Status.ReconciledAt",
+	"resources":               "Description.ArgoApplication.Status.Resources",
+	"source_chart":            "Description.ArgoApplication.Spec.Source.Chart",
+	"source_path":             "Description.ArgoApplication.Spec.Source.Path",
+	"source_repo_url":         "Description.ArgoApplication.Spec.Source.RepoURL",
+	"source_target_revision":  "Description.ArgoApplication.Spec.Source.TargetRevision",
+	"source_type":             "Description.ArgoApplication.Status.SourceType",
+	"sources":                 "Description.ArgoApplication.Spec.Sources",
+	"sync_policy":             "Description.ArgoApplication.Spec.SyncPolicy",
+	"sync_revision":           "Description.ArgoApplication.Status.Sync.Revision",
+	"sync_status":             "Description.ArgoApplication.Status.Sync.Status",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesArgoApplication(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesArgoApplication")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -623,7 +710,7 @@ func GetKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plugi
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesClusterRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesArgoApplicationPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesArgoApplicationFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -647,74 +734,74 @@ func GetKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-// ==========================  END: KubernetesClusterRole =============================
+// ==========================  END: KubernetesArgoApplication =============================
 
-// ==========================  START: KubernetesClusterRoleBinding =============================
+// ==========================  START: KubernetesArgoAppProject =============================
 
-type KubernetesClusterRoleBinding struct {
-	ResourceID      string                                             `json:"resource_id"`
-	PlatformID      string                                             `json:"platform_id"`
-	Description     kubernetes.KubernetesClusterRoleBindingDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                                `json:"metadata"`
-	DescribedBy     string                                             `json:"described_by"`
-	ResourceType    string                                             `json:"resource_type"`
-	IntegrationType string                                             `json:"integration_type"`
-	IntegrationID   string                                             `json:"integration_id"`
+type KubernetesArgoAppProject struct {
+	ResourceID      string                                         `json:"resource_id"`
+	PlatformID      string                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesArgoAppProjectDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                            `json:"metadata"`
+	DescribedBy     string                                         `json:"described_by"`
+	ResourceType    string                                         `json:"resource_type"`
+	IntegrationType string                                         `json:"integration_type"`
+	IntegrationID   string                                         `json:"integration_id"`
 }
 
-type KubernetesClusterRoleBindingHit struct {
-	ID      string                       `json:"_id"`
-	Score   float64                      `json:"_score"`
-	Index   string                       `json:"_index"`
-	Type    string                       `json:"_type"`
-	Version int64                        `json:"_version,omitempty"`
-	Source  KubernetesClusterRoleBinding `json:"_source"`
-	Sort    []interface{}                `json:"sort"`
+type KubernetesArgoAppProjectHit struct {
+	ID      string                   `json:"_id"`
+	Score   float64                  `json:"_score"`
+	Index   string                   `json:"_index"`
+	Type    string                   `json:"_type"`
+	Version int64                    `json:"_version,omitempty"`
+	Source  KubernetesArgoAppProject `json:"_source"`
+	Sort    []interface{}            `json:"sort"`
 }
 
-type KubernetesClusterRoleBindingHits struct {
-	Total essdk.SearchTotal                 `json:"total"`
-	Hits  []KubernetesClusterRoleBindingHit `json:"hits"`
+type KubernetesArgoAppProjectHits struct {
+	Total essdk.SearchTotal             `json:"total"`
+	Hits  []KubernetesArgoAppProjectHit `json:"hits"`
 }
 
-type KubernetesClusterRoleBindingSearchResponse struct {
-	PitID string                           `json:"pit_id"`
-	Hits  KubernetesClusterRoleBindingHits `json:"hits"`
+type KubernetesArgoAppProjectSearchResponse struct {
+	PitID string                       `json:"pit_id"`
+	Hits  KubernetesArgoAppProjectHits `json:"hits"`
 }
 
-type KubernetesClusterRoleBindingPaginator struct {
+type KubernetesArgoAppProjectPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesClusterRoleBindingPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterRoleBindingPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_clusterrolebinding", filters, limit)
+func (k Client) NewKubernetesArgoAppProjectPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesArgoAppProjectPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_argoappproject", filters, limit)
 	if err != nil {
-		return KubernetesClusterRoleBindingPaginator{}, err
+		return KubernetesArgoAppProjectPaginator{}, err
 	}
 
-	p := KubernetesClusterRoleBindingPaginator{
+	p := KubernetesArgoAppProjectPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesClusterRoleBindingPaginator) HasNext() bool {
+func (p KubernetesArgoAppProjectPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesClusterRoleBindingPaginator) Close(ctx context.Context) error {
+func (p KubernetesArgoAppProjectPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesClusterRoleBindingPaginator) NextPage(ctx context.Context) ([]KubernetesClusterRoleBinding, error) {
-	var response KubernetesClusterRoleBindingSearchResponse
+func (p KubernetesArgoAppProjectPaginator) NextPage(ctx context.Context) ([]KubernetesArgoAppProject, error) {
+	var response KubernetesArgoAppProjectSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesClusterRoleBinding
+	var values []KubernetesArgoAppProject
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -729,58 +816,66 @@ func (p KubernetesClusterRoleBindingPaginator) NextPage(ctx context.Context) ([]
 	return values, nil
 }
 
-var listKubernetesClusterRoleBindingFilters = map[string]string{
-	"role_api_group": "Description.ClusterRoleBinding.RoleRef.APIGroup",
-	"role_kind":      "Description.ClusterRoleBinding.RoleRef.Kind",
-	"role_name":      "Description.ClusterRoleBinding.RoleRef.Name",
-	"subjects":       "Description.ClusterRoleBinding.Subjects",
-	"title":          "Description.ClusterRoleBinding.Metadata.Name",
-}
-
-func ListKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesClusterRoleBinding")
+var listKubernetesArgoAppProjectFilters = map[string]string{
+	"cluster_resource_blacklist":   "Description.ArgoAppProject.Spec.ClusterResourceBlacklist",
+	"cluster_resource_whitelist":   "Description.ArgoAppProject.Spec.ClusterResourceWhitelist",
+	"description":                  "Description.ArgoAppProject.Spec.Description",
+	"destinations":                 "Description.ArgoAppProject.Spec.Destinations",
+	"namespace_resource_blacklist": "Description.ArgoAppProject.Spec.NamespaceResourceBlacklist",
+	"namespace_resource_whitelist": "Description.ArgoAppProject.Spec.NamespaceResourceWhitelist",
+	"orphaned_resources":           "Description.ArgoAppProject.Spec.OrphanedResources",
+	"platform_integration_id":      "IntegrationID",
+	"roles":                        "Description.ArgoAppProject.Spec.Roles",
+	"source_namespaces":            "Description.ArgoAppProject.Spec.SourceNamespaces",
+	"source_repos":                 "Description.ArgoAppProject.Spec.SourceRepos",
+	"sync_windows":                 "Description.ArgoAppProject.Spec.SyncWindows",
+	"title":                        "Description.MetaObject.Name",
+}
+
+func ListKubernetesArgoAppProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesArgoAppProject")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoAppProject NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoAppProject NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoAppProject GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoAppProject GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoAppProject GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesClusterRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesArgoAppProjectPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesArgoAppProjectFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding NewKubernetesClusterRoleBindingPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesArgoAppProject NewKubernetesArgoAppProjectPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesArgoAppProject paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -797,16 +892,26 @@ func ListKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData,
 	return nil, nil
 }
 
-var getKubernetesClusterRoleBindingFilters = map[string]string{
-	"role_api_group": "Description.ClusterRoleBinding.RoleRef.APIGroup",
-	"role_kind":      "Description.ClusterRoleBinding.RoleRef.Kind",
-	"role_name":      "Description.ClusterRoleBinding.RoleRef.Name",
-	"subjects":       "Description.ClusterRoleBinding.Subjects",
-	"title":          "Description.ClusterRoleBinding.Metadata.Name",
-}
-
-func GetKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesClusterRoleBinding")
+var getKubernetesArgoAppProjectFilters = map[string]string{
+	"cluster_resource_blacklist":   "Description.ArgoAppProject.Spec.ClusterResourceBlacklist",
+	"cluster_resource_whitelist":   "Description.ArgoAppProject.Spec.ClusterResourceWhitelist",
+	"description":                  "Description.ArgoAppProject.Spec.Description",
+	"destinations":                 "Description.ArgoAppProject.Spec.Destinations",
+	"name":                         "Description.MetaObject.Name",
+	"namespace":                    "Description.MetaObject.Namespace",
+	"namespace_resource_blacklist": "Description.ArgoAppProject.Spec.NamespaceResourceBlacklist",
+	"namespace_resource_whitelist": "Description.ArgoAppProject.Spec.NamespaceResourceWhitelist",
+	"orphaned_resources":           "Description.ArgoAppProject.Spec.OrphanedResources",
+	"platform_integration_id":      "IntegrationID",
+	"roles":                        "Description.ArgoAppProject.Spec.Roles",
+	"source_namespaces":            "Description.ArgoAppProject.Spec.SourceNamespaces",
+	"source_repos":                 "Description.ArgoAppProject.Spec.SourceRepos",
+	"sync_windows":                 "Description.ArgoAppProject.Spec.SyncWindows",
+	"title":                        "Description.MetaObject.Name",
+}
+
+func GetKubernetesArgoAppProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesArgoAppProject")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -834,7 +939,7 @@ func GetKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData, _
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesClusterRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesArgoAppProjectPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesArgoAppProjectFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -858,74 +963,74 @@ func GetKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData, _
 	return nil, nil
 }
 
-// ==========================  END: KubernetesClusterRoleBinding =============================
+// ==========================  END: KubernetesArgoAppProject =============================
 
-// ==========================  START: KubernetesConfigMap =============================
+// ==========================  START: KubernetesCertificate =============================
 
-type KubernetesConfigMap struct {
-	ResourceID      string                                    `json:"resource_id"`
-	PlatformID      string                                    `json:"platform_id"`
-	Description     kubernetes.KubernetesConfigMapDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                       `json:"metadata"`
-	DescribedBy     string                                    `json:"described_by"`
-	ResourceType    string                                    `json:"resource_type"`
-	IntegrationType string                                    `json:"integration_type"`
-	IntegrationID   string                                    `json:"integration_id"`
+type KubernetesCertificate struct {
+	ResourceID      string                                      `json:"resource_id"`
+	PlatformID      string                                      `json:"platform_id"`
+	Description     kubernetes.KubernetesCertificateDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                         `json:"metadata"`
+	DescribedBy     string                                      `json:"described_by"`
+	ResourceType    string                                      `json:"resource_type"`
+	IntegrationType string                                      `json:"integration_type"`
+	IntegrationID   string                                      `json:"integration_id"`
 }
 
-type KubernetesConfigMapHit struct {
-	ID      string              `json:"_id"`
-	Score   float64             `json:"_score"`
-	Index   string              `json:"_index"`
-	Type    string              `json:"_type"`
-	Version int64               `json:"_version,omitempty"`
-	Source  KubernetesConfigMap `json:"_source"`
-	Sort    []interface{}       `json:"sort"`
+type KubernetesCertificateHit struct {
+	ID      string                `json:"_id"`
+	Score   float64               `json:"_score"`
+	Index   string                `json:"_index"`
+	Type    string                `json:"_type"`
+	Version int64                 `json:"_version,omitempty"`
+	Source  KubernetesCertificate `json:"_source"`
+	Sort    []interface{}         `json:"sort"`
 }
 
-type KubernetesConfigMapHits struct {
-	Total essdk.SearchTotal        `json:"total"`
-	Hits  []KubernetesConfigMapHit `json:"hits"`
+type KubernetesCertificateHits struct {
+	Total essdk.SearchTotal          `json:"total"`
+	Hits  []KubernetesCertificateHit `json:"hits"`
 }
 
-type KubernetesConfigMapSearchResponse struct {
-	PitID string                  `json:"pit_id"`
-	Hits  KubernetesConfigMapHits `json:"hits"`
+type KubernetesCertificateSearchResponse struct {
+	PitID string                    `json:"pit_id"`
+	Hits  KubernetesCertificateHits `json:"hits"`
 }
 
-type KubernetesConfigMapPaginator struct {
+type KubernetesCertificatePaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesConfigMapPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesConfigMapPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_configmap", filters, limit)
+func (k Client) NewKubernetesCertificatePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCertificatePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_certificate", filters, limit)
 	if err != nil {
-		return KubernetesConfigMapPaginator{}, err
+		return KubernetesCertificatePaginator{}, err
 	}
 
-	p := KubernetesConfigMapPaginator{
+	p := KubernetesCertificatePaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesConfigMapPaginator) HasNext() bool {
+func (p KubernetesCertificatePaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesConfigMapPaginator) Close(ctx context.Context) error {
+func (p KubernetesCertificatePaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesConfigMapPaginator) NextPage(ctx context.Context) ([]KubernetesConfigMap, error) {
-	var response KubernetesConfigMapSearchResponse
+func (p KubernetesCertificatePaginator) NextPage(ctx context.Context) ([]KubernetesCertificate, error) {
+	var response KubernetesCertificateSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesConfigMap
+	var values []KubernetesCertificate
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -940,56 +1045,79 @@ func (p KubernetesConfigMapPaginator) NextPage(ctx context.Context) ([]Kubernete
 	return values, nil
 }
 
-var listKubernetesConfigMapFilters = map[string]string{
-	"data":      "Description.ConfigMap.Data",
-	"immutable": "Description.ConfigMap.Immutable",
-	"title":     "Description.ConfigMap.Name",
-}
-
-func ListKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesConfigMap")
+var listKubernetesCertificateFilters = map[string]string{
+	"common_name":              "Description.Certificate.Spec.CommonName",
+	"conditions":               "Description.Certificate.Status.Conditions",
+	"dns_names":                "Description.Certificate.Spec.DNSNames",
+	"duration":                 "Description.Certificate.Spec.Duration",
+	"email_addresses":          "Description.Certificate.Spec.EmailAddresses",
+	"failed_issuance_attempts": "Description.Certificate.Status.FailedIssuanceAttempts",
+	"ip_addresses":             "Description.Certificate.Spec.IPAddresses",
+	"is_ca":                    "Description.Certificate.Spec.IsCA",
+	"issuer_kind":              "Description.Certificate.Spec.IssuerRef.Kind",
+	"issuer_name":              "Description.Certificate.Spec.IssuerRef.Name",
+	"issuer_ref":               "Description.Certificate.Spec.IssuerRef",
+	"last_failure_time":        "Description.Certificate.Status.LastFailureTime",
+	"not_after":                "Description.Certificate.Status.NotAfter",
+	"not_before":               "Description.Certificate.Status.NotBefore",
+	"platform_integration_id":  "IntegrationID",
+	"private_key":              "Description.Certificate.Spec.PrivateKey",
+	"ready":                    "Description.Ready",
+	"ready_message":            "Description.ReadyCondition.Message",
+	"ready_reason":             "Description.ReadyCondition.Reason",
+	"renew_before":             "Description.Certificate.Spec.RenewBefore",
+	"renewal_time":             "Description.Certificate.Status.RenewalTime",
+	"revision":                 "Description.Certificate.Status.Revision",
+	"secret_name":              "Description.Certificate.Spec.SecretName",
+	"title":                    "Description.MetaObject.Name",
+	"uris":                     "Description.Certificate.Spec.URIs",
+	"usages":                   "Description.Certificate.Spec.Usages",
+}
+
+func ListKubernetesCertificate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCertificate")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesConfigMap NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificate NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesConfigMap NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificate NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesConfigMap GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificate GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesConfigMap GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificate GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesConfigMap GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificate GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesConfigMapPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesConfigMapFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCertificatePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCertificateFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesConfigMap NewKubernetesConfigMapPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificate NewKubernetesCertificatePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesConfigMap paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCertificate paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -1006,14 +1134,39 @@ func ListKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return nil, nil
 }
 
-var getKubernetesConfigMapFilters = map[string]string{
-	"data":      "Description.ConfigMap.Data",
-	"immutable": "Description.ConfigMap.Immutable",
-	"title":     "Description.ConfigMap.Name",
-}
-
-func GetKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesConfigMap")
+var getKubernetesCertificateFilters = map[string]string{
+	"common_name":              "Description.Certificate.Spec.CommonName",
+	"conditions":               "Description.Certificate.Status.Conditions",
+	"dns_names":                "Description.Certificate.Spec.DNSNames",
+	"duration":                 "Description.Certificate.Spec.Duration",
+	"email_addresses":          "Description.Certificate.Spec.EmailAddresses",
+	"failed_issuance_attempts": "Description.Certificate.Status.FailedIssuanceAttempts",
+	"ip_addresses":             "Description.Certificate.Spec.IPAddresses",
+	"is_ca":                    "Description.Certificate.Spec.IsCA",
+	"issuer_kind":              "Description.Certificate.Spec.IssuerRef.Kind",
+	"issuer_name":              "Description.Certificate.Spec.IssuerRef.Name",
+	"issuer_ref":               "Description.Certificate.Spec.IssuerRef",
+	"last_failure_time":        "Description.Certificate.Status.LastFailureTime",
+	"name":                     "Description.MetaObject.Name",
+	"namespace":                "Description.MetaObject.Namespace",
+	"not_after":                "Description.Certificate.Status.NotAfter",
+	"not_before":               "Description.Certificate.Status.NotBefore",
+	"platform_integration_id":  "IntegrationID",
+	"private_key":              "Description.Certificate.Spec.PrivateKey",
+	"ready":                    "Description.Ready",
+	"ready_message":            "Description.ReadyCondition.Message",
+	"ready_reason":             "Description.ReadyCondition.Reason",
+	"renew_before":             "Description.Certificate.Spec.RenewBefore",
+	"renewal_time":             "Description.Certificate.Status.RenewalTime",
+	"revision":                 "Description.Certificate.Status.Revision",
+	"secret_name":              "Description.Certificate.Spec.SecretName",
+	"title":                    "Description.MetaObject.Name",
+	"uris":                     "Description.Certificate.Spec.URIs",
+	"usages":                   "Description.Certificate.Spec.Usages",
+}
+
+func GetKubernetesCertificate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCertificate")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -1041,7 +1194,7 @@ func GetKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesConfigMapPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesConfigMapFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCertificatePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCertificateFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1065,74 +1218,74 @@ func GetKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	return nil, nil
 }
 
-// ==========================  END: KubernetesConfigMap =============================
+// ==========================  END: KubernetesCertificate =============================
 
-// ==========================  START: KubernetesCronJob =============================
+// ==========================  START: KubernetesCertificateRequest =============================
 
-type KubernetesCronJob struct {
-	ResourceID      string                                  `json:"resource_id"`
-	PlatformID      string                                  `json:"platform_id"`
-	Description     kubernetes.KubernetesCronJobDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                     `json:"metadata"`
-	DescribedBy     string                                  `json:"described_by"`
-	ResourceType    string                                  `json:"resource_type"`
-	IntegrationType string                                  `json:"integration_type"`
-	IntegrationID   string                                  `json:"integration_id"`
+type KubernetesCertificateRequest struct {
+	ResourceID      string                                             `json:"resource_id"`
+	PlatformID      string                                             `json:"platform_id"`
+	Description     kubernetes.KubernetesCertificateRequestDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                `json:"metadata"`
+	DescribedBy     string                                             `json:"described_by"`
+	ResourceType    string                                             `json:"resource_type"`
+	IntegrationType string                                             `json:"integration_type"`
+	IntegrationID   string                                             `json:"integration_id"`
 }
 
-type KubernetesCronJobHit struct {
-	ID      string            `json:"_id"`
-	Score   float64           `json:"_score"`
-	Index   string            `json:"_index"`
-	Type    string            `json:"_type"`
-	Version int64             `json:"_version,omitempty"`
-	Source  KubernetesCronJob `json:"_source"`
-	Sort    []interface{}     `json:"sort"`
+type KubernetesCertificateRequestHit struct {
+	ID      string                       `json:"_id"`
+	Score   float64                      `json:"_score"`
+	Index   string                       `json:"_index"`
+	Type    string                       `json:"_type"`
+	Version int64                        `json:"_version,omitempty"`
+	Source  KubernetesCertificateRequest `json:"_source"`
+	Sort    []interface{}                `json:"sort"`
 }
 
-type KubernetesCronJobHits struct {
-	Total essdk.SearchTotal      `json:"total"`
-	Hits  []KubernetesCronJobHit `json:"hits"`
+type KubernetesCertificateRequestHits struct {
+	Total essdk.SearchTotal                 `json:"total"`
+	Hits  []KubernetesCertificateRequestHit `json:"hits"`
 }
 
-type KubernetesCronJobSearchResponse struct {
-	PitID string                `json:"pit_id"`
-	Hits  KubernetesCronJobHits `json:"hits"`
+type KubernetesCertificateRequestSearchResponse struct {
+	PitID string                           `json:"pit_id"`
+	Hits  KubernetesCertificateRequestHits `json:"hits"`
 }
 
-type KubernetesCronJobPaginator struct {
+type KubernetesCertificateRequestPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesCronJobPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCronJobPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_cronjob", filters, limit)
+func (k Client) NewKubernetesCertificateRequestPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCertificateRequestPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_certificaterequest", filters, limit)
 	if err != nil {
-		return KubernetesCronJobPaginator{}, err
+		return KubernetesCertificateRequestPaginator{}, err
 	}
 
-	p := KubernetesCronJobPaginator{
+	p := KubernetesCertificateRequestPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesCronJobPaginator) HasNext() bool {
+func (p KubernetesCertificateRequestPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesCronJobPaginator) Close(ctx context.Context) error {
+func (p KubernetesCertificateRequestPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesCronJobPaginator) NextPage(ctx context.Context) ([]KubernetesCronJob, error) {
-	var response KubernetesCronJobSearchResponse
+func (p KubernetesCertificateRequestPaginator) NextPage(ctx context.Context) ([]KubernetesCertificateRequest, error) {
+	var response KubernetesCertificateRequestSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesCronJob
+	var values []KubernetesCertificateRequest
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -1147,62 +1300,70 @@ func (p KubernetesCronJobPaginator) NextPage(ctx context.Context) ([]KubernetesC
 	return values, nil
 }
 
-var listKubernetesCronJobFilters = map[string]string{
-	"active":                        "Description.CronJob.Status.Active",
-	"concurrency_policy":            "Description.CronJob.Spec.ConcurrencyPolicy",
-	"failed_jobs_history_limit":     "Description.CronJob.Spec.FailedJobsHistoryLimit",
-	"job_template":                  "Description.CronJob.Spec.JobTemplate",
-	"schedule":                      "Description.CronJob.Spec.Schedule",
-	"starting_deadline_seconds":     "Description.CronJob.Spec.StartingDeadlineSeconds",
-	"successful_jobs_history_limit": "Description.CronJob.Spec.SuccessfulJobsHistoryLimit",
-	"suspend":                       "Description.CronJob.Spec.Suspend",
-	"title":                         "Description.CronJob.Name",
-}
-
-func ListKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesCronJob")
+var listKubernetesCertificateRequestFilters = map[string]string{
+	"certificate":             "Description.CertificateRequest.Status.IssuedCertificate",
+	"certificate_not_after":   "Description.CertificateRequest.Status.IssuedCertificate.NotAfter",
+	"conditions":              "Description.CertificateRequest.Status.Conditions",
+	"duration":                "Description.CertificateRequest.Spec.Duration",
+	"failure_time":            "Description.CertificateRequest.Status.FailureTime",
+	"groups":                  "Description.CertificateRequest.Spec.Groups",
+	"is_ca":                   "Description.CertificateRequest.Spec.IsCA",
+	"issuer_kind":             "Description.CertificateRequest.Spec.IssuerRef.Kind",
+	"issuer_name":             "Description.CertificateRequest.Spec.IssuerRef.Name",
+	"issuer_ref":              "Description.CertificateRequest.Spec.IssuerRef",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_message":           "Description.ReadyCondition.Message",
+	"ready_reason":            "Description.ReadyCondition.Reason",
+	"title":                   "Description.MetaObject.Name",
+	"usages":                  "Description.CertificateRequest.Spec.Usages",
+	"username":                "Description.CertificateRequest.Spec.Username",
+}
+
+func ListKubernetesCertificateRequest(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCertificateRequest")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCronJob NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateRequest NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCronJob NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateRequest NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCronJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateRequest GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCronJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateRequest GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCronJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateRequest GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesCronJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCronJobFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCertificateRequestPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCertificateRequestFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCronJob NewKubernetesCronJobPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateRequest NewKubernetesCertificateRequestPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesCronJob paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCertificateRequest paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -1219,20 +1380,30 @@ func ListKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
-var getKubernetesCronJobFilters = map[string]string{
-	"active":                        "Description.CronJob.Status.Active",
-	"concurrency_policy":            "Description.CronJob.Spec.ConcurrencyPolicy",
-	"failed_jobs_history_limit":     "Description.CronJob.Spec.FailedJobsHistoryLimit",
-	"job_template":                  "Description.CronJob.Spec.JobTemplate",
-	"schedule":                      "Description.CronJob.Spec.Schedule",
-	"starting_deadline_seconds":     "Description.CronJob.Spec.StartingDeadlineSeconds",
-	"successful_jobs_history_limit": "Description.CronJob.Spec.SuccessfulJobsHistoryLimit",
-	"suspend":                       "Description.CronJob.Spec.Suspend",
-	"title":                         "Description.CronJob.Name",
-}
-
-func GetKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesCronJob")
+var getKubernetesCertificateRequestFilters = map[string]string{
+	"certificate":             "Description.CertificateRequest.Status.IssuedCertificate",
+	"certificate_not_after":   "Description.CertificateRequest.Status.IssuedCertificate.NotAfter",
+	"conditions":              "Description.CertificateRequest.Status.Conditions",
+	"duration":                "Description.CertificateRequest.Spec.Duration",
+	"failure_time":            "Description.CertificateRequest.Status.FailureTime",
+	"groups":                  "Description.CertificateRequest.Spec.Groups",
+	"is_ca":                   "Description.CertificateRequest.Spec.IsCA",
+	"issuer_kind":             "Description.CertificateRequest.Spec.IssuerRef.Kind",
+	"issuer_name":             "Description.CertificateRequest.Spec.IssuerRef.Name",
+	"issuer_ref":              "Description.CertificateRequest.Spec.IssuerRef",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_message":           "Description.ReadyCondition.Message",
+	"ready_reason":            "Description.ReadyCondition.Reason",
+	"title":                   "Description.MetaObject.Name",
+	"usages":                  "Description.CertificateRequest.Spec.Usages",
+	"username":                "Description.CertificateRequest.Spec.Username",
+}
+
+func GetKubernetesCertificateRequest(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCertificateRequest")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -1260,7 +1431,7 @@ func GetKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesCronJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCronJobFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCertificateRequestPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCertificateRequestFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1284,74 +1455,74 @@ func GetKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	return nil, nil
 }
 
-// ==========================  END: KubernetesCronJob =============================
+// ==========================  END: KubernetesCertificateRequest =============================
 
-// ==========================  START: KubernetesCustomResource =============================
+// ==========================  START: KubernetesCertificateSigningRequest =============================
 
-type KubernetesCustomResource struct {
-	ResourceID      string                                         `json:"resource_id"`
-	PlatformID      string                                         `json:"platform_id"`
-	Description     kubernetes.KubernetesCustomResourceDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                            `json:"metadata"`
-	DescribedBy     string                                         `json:"described_by"`
-	ResourceType    string                                         `json:"resource_type"`
-	IntegrationType string                                         `json:"integration_type"`
-	IntegrationID   string                                         `json:"integration_id"`
+type KubernetesCertificateSigningRequest struct {
+	ResourceID      string                                                    `json:"resource_id"`
+	PlatformID      string                                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesCertificateSigningRequestDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                       `json:"metadata"`
+	DescribedBy     string                                                    `json:"described_by"`
+	ResourceType    string                                                    `json:"resource_type"`
+	IntegrationType string                                                    `json:"integration_type"`
+	IntegrationID   string                                                    `json:"integration_id"`
 }
 
-type KubernetesCustomResourceHit struct {
-	ID      string                   `json:"_id"`
-	Score   float64                  `json:"_score"`
-	Index   string                   `json:"_index"`
-	Type    string                   `json:"_type"`
-	Version int64                    `json:"_version,omitempty"`
-	Source  KubernetesCustomResource `json:"_source"`
-	Sort    []interface{}            `json:"sort"`
+type KubernetesCertificateSigningRequestHit struct {
+	ID      string                              `json:"_id"`
+	Score   float64                             `json:"_score"`
+	Index   string                              `json:"_index"`
+	Type    string                              `json:"_type"`
+	Version int64                               `json:"_version,omitempty"`
+	Source  KubernetesCertificateSigningRequest `json:"_source"`
+	Sort    []interface{}                       `json:"sort"`
 }
 
-type KubernetesCustomResourceHits struct {
-	Total essdk.SearchTotal             `json:"total"`
-	Hits  []KubernetesCustomResourceHit `json:"hits"`
+type KubernetesCertificateSigningRequestHits struct {
+	Total essdk.SearchTotal                        `json:"total"`
+	Hits  []KubernetesCertificateSigningRequestHit `json:"hits"`
 }
 
-type KubernetesCustomResourceSearchResponse struct {
-	PitID string                       `json:"pit_id"`
-	Hits  KubernetesCustomResourceHits `json:"hits"`
+type KubernetesCertificateSigningRequestSearchResponse struct {
+	PitID string                                  `json:"pit_id"`
+	Hits  KubernetesCertificateSigningRequestHits `json:"hits"`
 }
 
-type KubernetesCustomResourcePaginator struct {
+type KubernetesCertificateSigningRequestPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesCustomResourcePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCustomResourcePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_customresource", filters, limit)
+func (k Client) NewKubernetesCertificateSigningRequestPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCertificateSigningRequestPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_certificatesigningrequest", filters, limit)
 	if err != nil {
-		return KubernetesCustomResourcePaginator{}, err
+		return KubernetesCertificateSigningRequestPaginator{}, err
 	}
 
-	p := KubernetesCustomResourcePaginator{
+	p := KubernetesCertificateSigningRequestPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesCustomResourcePaginator) HasNext() bool {
+func (p KubernetesCertificateSigningRequestPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesCustomResourcePaginator) Close(ctx context.Context) error {
+func (p KubernetesCertificateSigningRequestPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesCustomResourcePaginator) NextPage(ctx context.Context) ([]KubernetesCustomResource, error) {
-	var response KubernetesCustomResourceSearchResponse
+func (p KubernetesCertificateSigningRequestPaginator) NextPage(ctx context.Context) ([]KubernetesCertificateSigningRequest, error) {
+	var response KubernetesCertificateSigningRequestSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesCustomResource
+	var values []KubernetesCertificateSigningRequest
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -1366,55 +1537,67 @@ func (p KubernetesCustomResourcePaginator) NextPage(ctx context.Context) ([]Kube
 	return values, nil
 }
 
-var listKubernetesCustomResourceFilters = map[string]string{
-	"fully_qualified_name": "Description.FullyQualifiedName",
-	"title":                "Description.MetaObject.Name",
-}
-
-func ListKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesCustomResource")
+var listKubernetesCertificateSigningRequestFilters = map[string]string{
+	"approval_status":         "Description.ApprovalStatus",
+	"certificate":             "Description.CertificateSigningRequest.Status.Certificate",
+	"certificate_not_after":   "Description.CertificateSigningRequest.Status.Certificate.NotAfter",
+	"certificate_not_before":  "Description.CertificateSigningRequest.Status.Certificate.NotBefore",
+	"conditions":              "Description.CertificateSigningRequest.Status.Conditions",
+	"expiration_seconds":      "Description.CertificateSigningRequest.Spec.ExpirationSeconds",
+	"extra":                   "Description.CertificateSigningRequest.Spec.Extra",
+	"groups":                  "Description.CertificateSigningRequest.Spec.Groups",
+	"platform_integration_id": "IntegrationID",
+	"signer_name":             "Description.CertificateSigningRequest.Spec.SignerName",
+	"title":                   "Description.MetaObject.Name",
+	"usages":                  "Description.CertificateSigningRequest.Spec.Usages",
+	"user_uid":                "Description.CertificateSigningRequest.Spec.UID",
+	"username":                "Description.CertificateSigningRequest.Spec.Username",
+}
+
+func ListKubernetesCertificateSigningRequest(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCertificateSigningRequest")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResource NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResource NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResource GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResource GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResource GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesCustomResourcePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCustomResourceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCertificateSigningRequestPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCertificateSigningRequestFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResource NewKubernetesCustomResourcePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest NewKubernetesCertificateSigningRequestPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesCustomResource paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCertificateSigningRequest paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -1431,13 +1614,26 @@ func ListKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *p
 	return nil, nil
 }
 
-var getKubernetesCustomResourceFilters = map[string]string{
-	"fully_qualified_name": "Description.FullyQualifiedName",
-	"title":                "Description.MetaObject.Name",
-}
-
-func GetKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesCustomResource")
+var getKubernetesCertificateSigningRequestFilters = map[string]string{
+	"approval_status":         "Description.ApprovalStatus",
+	"certificate":             "Description.CertificateSigningRequest.Status.Certificate",
+	"certificate_not_after":   "Description.CertificateSigningRequest.Status.Certificate.NotAfter",
+	"certificate_not_before":  "Description.CertificateSigningRequest.Status.Certificate.NotBefore",
+	"conditions":              "Description.CertificateSigningRequest.Status.Conditions",
+	"expiration_seconds":      "Description.CertificateSigningRequest.Spec.ExpirationSeconds",
+	"extra":                   "Description.CertificateSigningRequest.Spec.Extra",
+	"groups":                  "Description.CertificateSigningRequest.Spec.Groups",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"signer_name":             "Description.CertificateSigningRequest.Spec.SignerName",
+	"title":                   "Description.MetaObject.Name",
+	"usages":                  "Description.CertificateSigningRequest.Spec.Usages",
+	"user_uid":                "Description.CertificateSigningRequest.Spec.UID",
+	"username":                "Description.CertificateSigningRequest.Spec.Username",
+}
+
+func GetKubernetesCertificateSigningRequest(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCertificateSigningRequest")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -1465,7 +1661,7 @@ func GetKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *pl
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesCustomResourcePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCustomResourceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCertificateSigningRequestPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCertificateSigningRequestFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1489,74 +1685,74 @@ func GetKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *pl
 	return nil, nil
 }
 
-// ==========================  END: KubernetesCustomResource =============================
+// ==========================  END: KubernetesCertificateSigningRequest =============================
 
-// ==========================  START: KubernetesCustomResourceDefinition =============================
+// ==========================  START: KubernetesCluster =============================
 
-type KubernetesCustomResourceDefinition struct {
-	ResourceID      string                                                   `json:"resource_id"`
-	PlatformID      string                                                   `json:"platform_id"`
-	Description     kubernetes.KubernetesCustomResourceDefinitionDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                                      `json:"metadata"`
-	DescribedBy     string                                                   `json:"described_by"`
-	ResourceType    string                                                   `json:"resource_type"`
-	IntegrationType string                                                   `json:"integration_type"`
-	IntegrationID   string                                                   `json:"integration_id"`
+type KubernetesCluster struct {
+	ResourceID      string                                  `json:"resource_id"`
+	PlatformID      string                                  `json:"platform_id"`
+	Description     kubernetes.KubernetesClusterDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                     `json:"metadata"`
+	DescribedBy     string                                  `json:"described_by"`
+	ResourceType    string                                  `json:"resource_type"`
+	IntegrationType string                                  `json:"integration_type"`
+	IntegrationID   string                                  `json:"integration_id"`
 }
 
-type KubernetesCustomResourceDefinitionHit struct {
-	ID      string                             `json:"_id"`
-	Score   float64                            `json:"_score"`
-	Index   string                             `json:"_index"`
-	Type    string                             `json:"_type"`
-	Version int64                              `json:"_version,omitempty"`
-	Source  KubernetesCustomResourceDefinition `json:"_source"`
-	Sort    []interface{}                      `json:"sort"`
+type KubernetesClusterHit struct {
+	ID      string            `json:"_id"`
+	Score   float64           `json:"_score"`
+	Index   string            `json:"_index"`
+	Type    string            `json:"_type"`
+	Version int64             `json:"_version,omitempty"`
+	Source  KubernetesCluster `json:"_source"`
+	Sort    []interface{}     `json:"sort"`
 }
 
-type KubernetesCustomResourceDefinitionHits struct {
-	Total essdk.SearchTotal                       `json:"total"`
-	Hits  []KubernetesCustomResourceDefinitionHit `json:"hits"`
+type KubernetesClusterHits struct {
+	Total essdk.SearchTotal      `json:"total"`
+	Hits  []KubernetesClusterHit `json:"hits"`
 }
 
-type KubernetesCustomResourceDefinitionSearchResponse struct {
-	PitID string                                 `json:"pit_id"`
-	Hits  KubernetesCustomResourceDefinitionHits `json:"hits"`
+type KubernetesClusterSearchResponse struct {
+	PitID string                `json:"pit_id"`
+	Hits  KubernetesClusterHits `json:"hits"`
 }
 
-type KubernetesCustomResourceDefinitionPaginator struct {
+type KubernetesClusterPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesCustomResourceDefinitionPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCustomResourceDefinitionPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_customresourcedefinition", filters, limit)
+func (k Client) NewKubernetesClusterPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_cluster", filters, limit)
 	if err != nil {
-		return KubernetesCustomResourceDefinitionPaginator{}, err
+		return KubernetesClusterPaginator{}, err
 	}
 
-	p := KubernetesCustomResourceDefinitionPaginator{
+	p := KubernetesClusterPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesCustomResourceDefinitionPaginator) HasNext() bool {
+func (p KubernetesClusterPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesCustomResourceDefinitionPaginator) Close(ctx context.Context) error {
+func (p KubernetesClusterPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesCustomResourceDefinitionPaginator) NextPage(ctx context.Context) ([]KubernetesCustomResourceDefinition, error) {
-	var response KubernetesCustomResourceDefinitionSearchResponse
+func (p KubernetesClusterPaginator) NextPage(ctx context.Context) ([]KubernetesCluster, error) {
+	var response KubernetesClusterSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesCustomResourceDefinition
+	var values []KubernetesCluster
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -1571,55 +1767,58 @@ func (p KubernetesCustomResourceDefinitionPaginator) NextPage(ctx context.Contex
 	return values, nil
 }
 
-var listKubernetesCustomResourceDefinitionFilters = map[string]string{
-	"spec":   "Description.CustomResourceDefinition.Spec",
-	"status": "Description.CustomResourceDefinition.Status",
+var listKubernetesClusterFilters = map[string]string{
+	"auth_method":             "Description.AuthMethod",
+	"context_name":            "Description.ContextName",
+	"endpoint":                "Description.Endpoint",
+	"server_version":          "Description.ServerVersion",
+	"tls_server_verification": "Description.TLSServerVerification",
 }
 
-func ListKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesCustomResourceDefinition")
+func ListKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCluster")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCluster NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCluster NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCluster GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCluster GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCluster GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesCustomResourceDefinitionPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCustomResourceDefinitionFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesClusterPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition NewKubernetesCustomResourceDefinitionPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCluster NewKubernetesClusterPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCluster paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -1636,13 +1835,16 @@ func ListKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.Query
 	return nil, nil
 }
 
-var getKubernetesCustomResourceDefinitionFilters = map[string]string{
-	"spec":   "Description.CustomResourceDefinition.Spec",
-	"status": "Description.CustomResourceDefinition.Status",
-}
-
-func GetKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesCustomResourceDefinition")
+var getKubernetesClusterFilters = map[string]string{
+	"auth_method":             "Description.AuthMethod",
+	"context_name":            "Description.ContextName",
+	"endpoint":                "Description.Endpoint",
+	"server_version":          "Description.ServerVersion",
+	"tls_server_verification": "Description.TLSServerVerification",
+}
+
+func GetKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCluster")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -1670,7 +1872,7 @@ func GetKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.QueryD
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesCustomResourceDefinitionPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCustomResourceDefinitionFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesClusterPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1694,74 +1896,74 @@ func GetKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.QueryD
 	return nil, nil
 }
 
-// ==========================  END: KubernetesCustomResourceDefinition =============================
+// ==========================  END: KubernetesCluster =============================
 
-// ==========================  START: KubernetesDaemonSet =============================
+// ==========================  START: KubernetesClusterIssuer =============================
 
-type KubernetesDaemonSet struct {
-	ResourceID      string                                    `json:"resource_id"`
-	PlatformID      string                                    `json:"platform_id"`
-	Description     kubernetes.KubernetesDaemonSetDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                       `json:"metadata"`
-	DescribedBy     string                                    `json:"described_by"`
-	ResourceType    string                                    `json:"resource_type"`
-	IntegrationType string                                    `json:"integration_type"`
-	IntegrationID   string                                    `json:"integration_id"`
+type KubernetesClusterIssuer struct {
+	ResourceID      string                                        `json:"resource_id"`
+	PlatformID      string                                        `json:"platform_id"`
+	Description     kubernetes.KubernetesClusterIssuerDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                           `json:"metadata"`
+	DescribedBy     string                                        `json:"described_by"`
+	ResourceType    string                                        `json:"resource_type"`
+	IntegrationType string                                        `json:"integration_type"`
+	IntegrationID   string                                        `json:"integration_id"`
 }
 
-type KubernetesDaemonSetHit struct {
-	ID      string              `json:"_id"`
-	Score   float64             `json:"_score"`
-	Index   string              `json:"_index"`
-	Type    string              `json:"_type"`
-	Version int64               `json:"_version,omitempty"`
-	Source  KubernetesDaemonSet `json:"_source"`
-	Sort    []interface{}       `json:"sort"`
+type KubernetesClusterIssuerHit struct {
+	ID      string                  `json:"_id"`
+	Score   float64                 `json:"_score"`
+	Index   string                  `json:"_index"`
+	Type    string                  `json:"_type"`
+	Version int64                   `json:"_version,omitempty"`
+	Source  KubernetesClusterIssuer `json:"_source"`
+	Sort    []interface{}           `json:"sort"`
 }
 
-type KubernetesDaemonSetHits struct {
-	Total essdk.SearchTotal        `json:"total"`
-	Hits  []KubernetesDaemonSetHit `json:"hits"`
+type KubernetesClusterIssuerHits struct {
+	Total essdk.SearchTotal            `json:"total"`
+	Hits  []KubernetesClusterIssuerHit `json:"hits"`
 }
 
-type KubernetesDaemonSetSearchResponse struct {
-	PitID string                  `json:"pit_id"`
-	Hits  KubernetesDaemonSetHits `json:"hits"`
+type KubernetesClusterIssuerSearchResponse struct {
+	PitID string                      `json:"pit_id"`
+	Hits  KubernetesClusterIssuerHits `json:"hits"`
 }
 
-type KubernetesDaemonSetPaginator struct {
+type KubernetesClusterIssuerPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesDaemonSetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesDaemonSetPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_daemonset", filters, limit)
+func (k Client) NewKubernetesClusterIssuerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterIssuerPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_clusterissuer", filters, limit)
 	if err != nil {
-		return KubernetesDaemonSetPaginator{}, err
+		return KubernetesClusterIssuerPaginator{}, err
 	}
 
-	p := KubernetesDaemonSetPaginator{
+	p := KubernetesClusterIssuerPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesDaemonSetPaginator) HasNext() bool {
+func (p KubernetesClusterIssuerPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesDaemonSetPaginator) Close(ctx context.Context) error {
+func (p KubernetesClusterIssuerPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesDaemonSetPaginator) NextPage(ctx context.Context) ([]KubernetesDaemonSet, error) {
-	var response KubernetesDaemonSetSearchResponse
+func (p KubernetesClusterIssuerPaginator) NextPage(ctx context.Context) ([]KubernetesClusterIssuer, error) {
+	var response KubernetesClusterIssuerSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesDaemonSet
+	var values []KubernetesClusterIssuer
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -1776,70 +1978,66 @@ func (p KubernetesDaemonSetPaginator) NextPage(ctx context.Context) ([]Kubernete
 	return values, nil
 }
 
-var listKubernetesDaemonSetFilters = map[string]string{
-	"collision_count":          "Description.DaemonSet.Status.CollisionCount",
-	"conditions":               "Description.DaemonSet.Status.Conditions",
-	"current_number_scheduled": "Description.DaemonSet.Status.CurrentNumberScheduled",
-	"desired_number_scheduled": "Description.DaemonSet.Status.DesiredNumberScheduled",
-	"min_ready_seconds":        "Description.DaemonSet.Spec.MinReadySeconds",
-	"number_available":         "Description.DaemonSet.Status.NumberAvailable",
-	"number_misscheduled":      "Description.DaemonSet.Status.NumberMisscheduled",
-	"number_ready":             "Description.DaemonSet.Status.NumberReady",
-	"number_unavailable":       "Description.DaemonSet.Status.NumberUnavailable",
-	"observed_generation":      "Description.DaemonSet.Status.ObservedGeneration",
-	"revision_history_limit":   "Description.DaemonSet.Spec.RevisionHistoryLimit",
-	"selector":                 "Description.DaemonSet.Spec.Volumes",
-	"selector_query":           "Description.LabelSelectorString",
-	"template":                 "Description.DaemonSet.Spec.Template",
-	"title":                    "Description.DaemonSet.Name",
-	"update_strategy":          "Description.DaemonSet.Spec.UpdateStrategy",
-	"updated_number_scheduled": "Description.DaemonSet.Status.UpdatedNumberScheduled",
-}
-
-func ListKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesDaemonSet")
+var listKubernetesClusterIssuerFilters = map[string]string{
+	"acme":                    "Description.ClusterIssuer.Spec.ACME",
+	"acme_status":             "Description.ClusterIssuer.Status.ACME",
+	"ca":                      "Description.ClusterIssuer.Spec.CA",
+	"conditions":              "Description.ClusterIssuer.Status.Conditions",
+	"issuer_type":             "Description.IssuerType",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_message":           "Description.ReadyCondition.Message",
+	"ready_reason":            "Description.ReadyCondition.Reason",
+	"self_signed":             "Description.ClusterIssuer.Spec.SelfSigned",
+	"title":                   "Description.MetaObject.Name",
+	"vault":                   "Description.ClusterIssuer.Spec.Vault",
+	"venafi":                  "Description.ClusterIssuer.Spec.Venafi",
+}
+
+func ListKubernetesClusterIssuer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesClusterIssuer")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDaemonSet NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterIssuer NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDaemonSet NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterIssuer NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDaemonSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterIssuer GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDaemonSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterIssuer GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDaemonSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterIssuer GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesDaemonSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesDaemonSetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesClusterIssuerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterIssuerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDaemonSet NewKubernetesDaemonSetPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterIssuer NewKubernetesClusterIssuerPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesDaemonSet paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesClusterIssuer paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -1856,28 +2054,25 @@ func ListKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return nil, nil
 }
 
-var getKubernetesDaemonSetFilters = map[string]string{
-	"collision_count":          "Description.DaemonSet.Status.CollisionCount",
-	"conditions":               "Description.DaemonSet.Status.Conditions",
-	"current_number_scheduled": "Description.DaemonSet.Status.CurrentNumberScheduled",
-	"desired_number_scheduled": "Description.DaemonSet.Status.DesiredNumberScheduled",
-	"min_ready_seconds":        "Description.DaemonSet.Spec.MinReadySeconds",
-	"number_available":         "Description.DaemonSet.Status.NumberAvailable",
-	"number_misscheduled":      "Description.DaemonSet.Status.NumberMisscheduled",
-	"number_ready":             "Description.DaemonSet.Status.NumberReady",
-	"number_unavailable":       "Description.DaemonSet.Status.NumberUnavailable",
-	"observed_generation":      "Description.DaemonSet.Status.ObservedGeneration",
-	"revision_history_limit":   "Description.DaemonSet.Spec.RevisionHistoryLimit",
-	"selector":                 "Description.DaemonSet.Spec.Volumes",
-	"selector_query":           "Description.LabelSelectorString",
-	"template":                 "Description.DaemonSet.Spec.Template",
-	"title":                    "Description.DaemonSet.Name",
-	"update_strategy":          "Description.DaemonSet.Spec.UpdateStrategy",
-	"updated_number_scheduled": "Description.DaemonSet.Status.UpdatedNumberScheduled",
-}
-
-func GetKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesDaemonSet")
+var getKubernetesClusterIssuerFilters = map[string]string{
+	"acme":                    "Description.ClusterIssuer.Spec.ACME",
+	"acme_status":             "Description.ClusterIssuer.Status.ACME",
+	"ca":                      "Description.ClusterIssuer.Spec.CA",
+	"conditions":              "Description.ClusterIssuer.Status.Conditions",
+	"issuer_type":             "Description.IssuerType",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_message":           "Description.ReadyCondition.Message",
+	"ready_reason":            "Description.ReadyCondition.Reason",
+	"self_signed":             "Description.ClusterIssuer.Spec.SelfSigned",
+	"title":                   "Description.MetaObject.Name",
+	"vault":                   "Description.ClusterIssuer.Spec.Vault",
+	"venafi":                  "Description.ClusterIssuer.Spec.Venafi",
+}
+
+func GetKubernetesClusterIssuer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesClusterIssuer")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -1905,7 +2100,7 @@ func GetKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesDaemonSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesDaemonSetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesClusterIssuerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterIssuerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1929,74 +2124,74 @@ func GetKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	return nil, nil
 }
 
-// ==========================  END: KubernetesDaemonSet =============================
+// ==========================  END: KubernetesClusterIssuer =============================
 
-// ==========================  START: KubernetesDeployment =============================
+// ==========================  START: KubernetesClusterRole =============================
 
-type KubernetesDeployment struct {
-	ResourceID      string                                     `json:"resource_id"`
-	PlatformID      string                                     `json:"platform_id"`
-	Description     kubernetes.KubernetesDeploymentDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                        `json:"metadata"`
-	DescribedBy     string                                     `json:"described_by"`
-	ResourceType    string                                     `json:"resource_type"`
-	IntegrationType string                                     `json:"integration_type"`
-	IntegrationID   string                                     `json:"integration_id"`
+type KubernetesClusterRole struct {
+	ResourceID      string                                      `json:"resource_id"`
+	PlatformID      string                                      `json:"platform_id"`
+	Description     kubernetes.KubernetesClusterRoleDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                         `json:"metadata"`
+	DescribedBy     string                                      `json:"described_by"`
+	ResourceType    string                                      `json:"resource_type"`
+	IntegrationType string                                      `json:"integration_type"`
+	IntegrationID   string                                      `json:"integration_id"`
 }
 
-type KubernetesDeploymentHit struct {
-	ID      string               `json:"_id"`
-	Score   float64              `json:"_score"`
-	Index   string               `json:"_index"`
-	Type    string               `json:"_type"`
-	Version int64                `json:"_version,omitempty"`
-	Source  KubernetesDeployment `json:"_source"`
-	Sort    []interface{}        `json:"sort"`
+type KubernetesClusterRoleHit struct {
+	ID      string                `json:"_id"`
+	Score   float64               `json:"_score"`
+	Index   string                `json:"_index"`
+	Type    string                `json:"_type"`
+	Version int64                 `json:"_version,omitempty"`
+	Source  KubernetesClusterRole `json:"_source"`
+	Sort    []interface{}         `json:"sort"`
 }
 
-type KubernetesDeploymentHits struct {
-	Total essdk.SearchTotal         `json:"total"`
-	Hits  []KubernetesDeploymentHit `json:"hits"`
+type KubernetesClusterRoleHits struct {
+	Total essdk.SearchTotal          `json:"total"`
+	Hits  []KubernetesClusterRoleHit `json:"hits"`
 }
 
-type KubernetesDeploymentSearchResponse struct {
-	PitID string                   `json:"pit_id"`
-	Hits  KubernetesDeploymentHits `json:"hits"`
+type KubernetesClusterRoleSearchResponse struct {
+	PitID string                    `json:"pit_id"`
+	Hits  KubernetesClusterRoleHits `json:"hits"`
 }
 
-type KubernetesDeploymentPaginator struct {
+type KubernetesClusterRolePaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesDeploymentPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesDeploymentPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_deployment", filters, limit)
+func (k Client) NewKubernetesClusterRolePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterRolePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_clusterrole", filters, limit)
 	if err != nil {
-		return KubernetesDeploymentPaginator{}, err
+		return KubernetesClusterRolePaginator{}, err
 	}
 
-	p := KubernetesDeploymentPaginator{
+	p := KubernetesClusterRolePaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesDeploymentPaginator) HasNext() bool {
+func (p KubernetesClusterRolePaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesDeploymentPaginator) Close(ctx context.Context) error {
+func (p KubernetesClusterRolePaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesDeploymentPaginator) NextPage(ctx context.Context) ([]KubernetesDeployment, error) {
-	var response KubernetesDeploymentSearchResponse
+func (p KubernetesClusterRolePaginator) NextPage(ctx context.Context) ([]KubernetesClusterRole, error) {
+	var response KubernetesClusterRoleSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesDeployment
+	var values []KubernetesClusterRole
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -2011,71 +2206,57 @@ func (p KubernetesDeploymentPaginator) NextPage(ctx context.Context) ([]Kubernet
 	return values, nil
 }
 
-var listKubernetesDeploymentFilters = map[string]string{
-	"available_replicas":        "Description.Deployment.Status.AvailableReplicas",
-	"collision_count":           "Description.Deployment.Status.CollisionCount",
-	"conditions":                "Description.Deployment.Status.Conditions",
-	"min_ready_seconds":         "Description.Deployment.Spec.MinReadySeconds",
-	"observed_generation":       "Description.Deployment.Status.ObservedGeneration",
-	"paused":                    "Description.Deployment.Spec.Paused",
-	"progress_deadline_seconds": "Description.Deployment.Spec.ProgressDeadlineSeconds",
-	"ready_replicas":            "Description.Deployment.Status.ReadyReplicas",
-	"replicas":                  "Description.Deployment.Spec.Replicas",
-	"revision_history_limit":    "Description.Deployment.Spec.RevisionHistoryLimit",
-	"selector":                  "Description.Deployment.Spec.Selector",
-	"selector_query":            "Description.LabelSelectorString",
-	"status_replicas":           "Description.Deployment.Status.Replicas",
-	"strategy":                  "Description.Deployment.Spec.Strategy",
-	"template":                  "Description.Deployment.Spec.Template",
-	"title":                     "Description.Deployment.Name",
-	"unavailable_replicas":      "Description.Deployment.Status.UnavailableReplicas",
-	"updated_replicas":          "Description.Deployment.Status.UpdatedReplicas",
+var listKubernetesClusterRoleFilters = map[string]string{
+	"aggregation_rule":        "Description.ClusterRole.AggregationRule",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.ClusterRole.Rules",
+	"title":                   "Description.ClusterRole.Name",
 }
 
-func ListKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesDeployment")
+func ListKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesClusterRole")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDeployment NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRole NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDeployment NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRole NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDeployment GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDeployment GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDeployment GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesDeploymentPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesDeploymentFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesClusterRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesDeployment NewKubernetesDeploymentPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRole NewKubernetesClusterRolePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesDeployment paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesClusterRole paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -2092,29 +2273,16 @@ func ListKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-var getKubernetesDeploymentFilters = map[string]string{
-	"available_replicas":        "Description.Deployment.Status.AvailableReplicas",
-	"collision_count":           "Description.Deployment.Status.CollisionCount",
-	"conditions":                "Description.Deployment.Status.Conditions",
-	"min_ready_seconds":         "Description.Deployment.Spec.MinReadySeconds",
-	"observed_generation":       "Description.Deployment.Status.ObservedGeneration",
-	"paused":                    "Description.Deployment.Spec.Paused",
-	"progress_deadline_seconds": "Description.Deployment.Spec.ProgressDeadlineSeconds",
-	"ready_replicas":            "Description.Deployment.Status.ReadyReplicas",
-	"replicas":                  "Description.Deployment.Spec.Replicas",
-	"revision_history_limit":    "Description.Deployment.Spec.RevisionHistoryLimit",
-	"selector":                  "Description.Deployment.Spec.Selector",
-	"selector_query":            "Description.LabelSelectorString",
-	"status_replicas":           "Description.Deployment.Status.Replicas",
-	"strategy":                  "Description.Deployment.Spec.Strategy",
-	"template":                  "Description.Deployment.Spec.Template",
-	"title":                     "Description.Deployment.Name",
-	"unavailable_replicas":      "Description.Deployment.Status.UnavailableReplicas",
-	"updated_replicas":          "Description.Deployment.Status.UpdatedReplicas",
+var getKubernetesClusterRoleFilters = map[string]string{
+	"aggregation_rule":        "Description.ClusterRole.AggregationRule",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.ClusterRole.Rules",
+	"title":                   "Description.ClusterRole.Name",
 }
 
-func GetKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesDeployment")
+func GetKubernetesClusterRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesClusterRole")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -2142,7 +2310,7 @@ func GetKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesDeploymentPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesDeploymentFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesClusterRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -2166,74 +2334,74 @@ func GetKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return nil, nil
 }
 
-// ==========================  END: KubernetesDeployment =============================
+// ==========================  END: KubernetesClusterRole =============================
 
-// ==========================  START: KubernetesEndpointSlice =============================
+// ==========================  START: KubernetesClusterRoleBinding =============================
 
-type KubernetesEndpointSlice struct {
-	ResourceID      string                                        `json:"resource_id"`
-	PlatformID      string                                        `json:"platform_id"`
-	Description     kubernetes.KubernetesEndpointSliceDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                           `json:"metadata"`
-	DescribedBy     string                                        `json:"described_by"`
-	ResourceType    string                                        `json:"resource_type"`
-	IntegrationType string                                        `json:"integration_type"`
-	IntegrationID   string                                        `json:"integration_id"`
+type KubernetesClusterRoleBinding struct {
+	ResourceID      string                                             `json:"resource_id"`
+	PlatformID      string                                             `json:"platform_id"`
+	Description     kubernetes.KubernetesClusterRoleBindingDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                `json:"metadata"`
+	DescribedBy     string                                             `json:"described_by"`
+	ResourceType    string                                             `json:"resource_type"`
+	IntegrationType string                                             `json:"integration_type"`
+	IntegrationID   string                                             `json:"integration_id"`
 }
 
-type KubernetesEndpointSliceHit struct {
-	ID      string                  `json:"_id"`
-	Score   float64                 `json:"_score"`
-	Index   string                  `json:"_index"`
-	Type    string                  `json:"_type"`
-	Version int64                   `json:"_version,omitempty"`
-	Source  KubernetesEndpointSlice `json:"_source"`
-	Sort    []interface{}           `json:"sort"`
+type KubernetesClusterRoleBindingHit struct {
+	ID      string                       `json:"_id"`
+	Score   float64                      `json:"_score"`
+	Index   string                       `json:"_index"`
+	Type    string                       `json:"_type"`
+	Version int64                        `json:"_version,omitempty"`
+	Source  KubernetesClusterRoleBinding `json:"_source"`
+	Sort    []interface{}                `json:"sort"`
 }
 
-type KubernetesEndpointSliceHits struct {
-	Total essdk.SearchTotal            `json:"total"`
-	Hits  []KubernetesEndpointSliceHit `json:"hits"`
+type KubernetesClusterRoleBindingHits struct {
+	Total essdk.SearchTotal                 `json:"total"`
+	Hits  []KubernetesClusterRoleBindingHit `json:"hits"`
 }
 
-type KubernetesEndpointSliceSearchResponse struct {
-	PitID string                      `json:"pit_id"`
-	Hits  KubernetesEndpointSliceHits `json:"hits"`
+type KubernetesClusterRoleBindingSearchResponse struct {
+	PitID string                           `json:"pit_id"`
+	Hits  KubernetesClusterRoleBindingHits `json:"hits"`
 }
 
-type KubernetesEndpointSlicePaginator struct {
+type KubernetesClusterRoleBindingPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesEndpointSlicePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesEndpointSlicePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_endpointslice", filters, limit)
+func (k Client) NewKubernetesClusterRoleBindingPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesClusterRoleBindingPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_clusterrolebinding", filters, limit)
 	if err != nil {
-		return KubernetesEndpointSlicePaginator{}, err
+		return KubernetesClusterRoleBindingPaginator{}, err
 	}
 
-	p := KubernetesEndpointSlicePaginator{
+	p := KubernetesClusterRoleBindingPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesEndpointSlicePaginator) HasNext() bool {
+func (p KubernetesClusterRoleBindingPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesEndpointSlicePaginator) Close(ctx context.Context) error {
+func (p KubernetesClusterRoleBindingPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesEndpointSlicePaginator) NextPage(ctx context.Context) ([]KubernetesEndpointSlice, error) {
-	var response KubernetesEndpointSliceSearchResponse
+func (p KubernetesClusterRoleBindingPaginator) NextPage(ctx context.Context) ([]KubernetesClusterRoleBinding, error) {
+	var response KubernetesClusterRoleBindingSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesEndpointSlice
+	var values []KubernetesClusterRoleBinding
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -2248,57 +2416,59 @@ func (p KubernetesEndpointSlicePaginator) NextPage(ctx context.Context) ([]Kuber
 	return values, nil
 }
 
-var listKubernetesEndpointSliceFilters = map[string]string{
-	"address_type": "Description.EndpointSlice.AddressType",
-	"endpoints":    "Description.EndpointSlice.Endpoints",
-	"ports":        "Description.EndpointSlice.Ports",
-	"title":        "Description.EndpointSlice.Name",
+var listKubernetesClusterRoleBindingFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"role_api_group":          "Description.ClusterRoleBinding.RoleRef.APIGroup",
+	"role_kind":               "Description.ClusterRoleBinding.RoleRef.Kind",
+	"role_name":               "Description.ClusterRoleBinding.RoleRef.Name",
+	"subjects":                "Description.ClusterRoleBinding.Subjects",
+	"title":                   "Description.ClusterRoleBinding.Metadata.Name",
 }
 
-func ListKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesEndpointSlice")
+func ListKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesClusterRoleBinding")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesEndpointSlicePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesEndpointSliceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesClusterRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesClusterRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice NewKubernetesEndpointSlicePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding NewKubernetesClusterRoleBindingPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesEndpointSlice paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesClusterRoleBinding paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -2315,15 +2485,18 @@ func ListKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *pl
 	return nil, nil
 }
 
-var getKubernetesEndpointSliceFilters = map[string]string{
-	"address_type": "Description.EndpointSlice.AddressType",
-	"endpoints":    "Description.EndpointSlice.Endpoints",
-	"ports":        "Description.EndpointSlice.Ports",
-	"title":        "Description.EndpointSlice.Name",
+var getKubernetesClusterRoleBindingFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"role_api_group":          "Description.ClusterRoleBinding.RoleRef.APIGroup",
+	"role_kind":               "Description.ClusterRoleBinding.RoleRef.Kind",
+	"role_name":               "Description.ClusterRoleBinding.RoleRef.Name",
+	"subjects":                "Description.ClusterRoleBinding.Subjects",
+	"title":                   "Description.ClusterRoleBinding.Metadata.Name",
 }
 
-func GetKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesEndpointSlice")
+func GetKubernetesClusterRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesClusterRoleBinding")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -2351,7 +2524,7 @@ func GetKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *plu
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesEndpointSlicePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesEndpointSliceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesClusterRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesClusterRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -2375,74 +2548,74 @@ func GetKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *plu
 	return nil, nil
 }
 
-// ==========================  END: KubernetesEndpointSlice =============================
+// ==========================  END: KubernetesClusterRoleBinding =============================
 
-// ==========================  START: KubernetesEndpoint =============================
+// ==========================  START: KubernetesConfigMap =============================
 
-type KubernetesEndpoint struct {
-	ResourceID      string                                   `json:"resource_id"`
-	PlatformID      string                                   `json:"platform_id"`
-	Description     kubernetes.KubernetesEndpointDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                      `json:"metadata"`
-	DescribedBy     string                                   `json:"described_by"`
-	ResourceType    string                                   `json:"resource_type"`
-	IntegrationType string                                   `json:"integration_type"`
-	IntegrationID   string                                   `json:"integration_id"`
+type KubernetesConfigMap struct {
+	ResourceID      string                                    `json:"resource_id"`
+	PlatformID      string                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesConfigMapDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                       `json:"metadata"`
+	DescribedBy     string                                    `json:"described_by"`
+	ResourceType    string                                    `json:"resource_type"`
+	IntegrationType string                                    `json:"integration_type"`
+	IntegrationID   string                                    `json:"integration_id"`
 }
 
-type KubernetesEndpointHit struct {
-	ID      string             `json:"_id"`
-	Score   float64            `json:"_score"`
-	Index   string             `json:"_index"`
-	Type    string             `json:"_type"`
-	Version int64              `json:"_version,omitempty"`
-	Source  KubernetesEndpoint `json:"_source"`
-	Sort    []interface{}      `json:"sort"`
+type KubernetesConfigMapHit struct {
+	ID      string              `json:"_id"`
+	Score   float64             `json:"_score"`
+	Index   string              `json:"_index"`
+	Type    string              `json:"_type"`
+	Version int64               `json:"_version,omitempty"`
+	Source  KubernetesConfigMap `json:"_source"`
+	Sort    []interface{}       `json:"sort"`
 }
 
-type KubernetesEndpointHits struct {
-	Total essdk.SearchTotal       `json:"total"`
-	Hits  []KubernetesEndpointHit `json:"hits"`
+type KubernetesConfigMapHits struct {
+	Total essdk.SearchTotal        `json:"total"`
+	Hits  []KubernetesConfigMapHit `json:"hits"`
 }
 
-type KubernetesEndpointSearchResponse struct {
-	PitID string                 `json:"pit_id"`
-	Hits  KubernetesEndpointHits `json:"hits"`
+type KubernetesConfigMapSearchResponse struct {
+	PitID string                  `json:"pit_id"`
+	Hits  KubernetesConfigMapHits `json:"hits"`
 }
 
-type KubernetesEndpointPaginator struct {
+type KubernetesConfigMapPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesEndpointPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesEndpointPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_endpoint", filters, limit)
+func (k Client) NewKubernetesConfigMapPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesConfigMapPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_configmap", filters, limit)
 	if err != nil {
-		return KubernetesEndpointPaginator{}, err
+		return KubernetesConfigMapPaginator{}, err
 	}
 
-	p := KubernetesEndpointPaginator{
+	p := KubernetesConfigMapPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesEndpointPaginator) HasNext() bool {
+func (p KubernetesConfigMapPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesEndpointPaginator) Close(ctx context.Context) error {
+func (p KubernetesConfigMapPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesEndpointPaginator) NextPage(ctx context.Context) ([]KubernetesEndpoint, error) {
-	var response KubernetesEndpointSearchResponse
+func (p KubernetesConfigMapPaginator) NextPage(ctx context.Context) ([]KubernetesConfigMap, error) {
+	var response KubernetesConfigMapSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesEndpoint
+	var values []KubernetesConfigMap
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -2457,55 +2630,57 @@ func (p KubernetesEndpointPaginator) NextPage(ctx context.Context) ([]Kubernetes
 	return values, nil
 }
 
-var listKubernetesEndpointFilters = map[string]string{
-	"subsets": "Description.Endpoint.Subsets",
-	"title":   "Description.Endpoint.Name",
+var listKubernetesConfigMapFilters = map[string]string{
+	"data":                    "Description.ConfigMap.Data",
+	"immutable":               "Description.ConfigMap.Immutable",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.ConfigMap.Name",
 }
 
-func ListKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesEndpoint")
+func ListKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesConfigMap")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpoint NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesConfigMap NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpoint NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesConfigMap NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpoint GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesConfigMap GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpoint GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesConfigMap GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpoint GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesConfigMap GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesEndpointPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesEndpointFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesConfigMapPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesConfigMapFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEndpoint NewKubernetesEndpointPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesConfigMap NewKubernetesConfigMapPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesEndpoint paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesConfigMap paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -2522,13 +2697,17 @@ func ListKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	return nil, nil
 }
 
-var getKubernetesEndpointFilters = map[string]string{
-	"subsets": "Description.Endpoint.Subsets",
-	"title":   "Description.Endpoint.Name",
+var getKubernetesConfigMapFilters = map[string]string{
+	"data":                    "Description.ConfigMap.Data",
+	"immutable":               "Description.ConfigMap.Immutable",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.ConfigMap.Name",
 }
 
-func GetKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesEndpoint")
+func GetKubernetesConfigMap(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesConfigMap")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -2556,7 +2735,7 @@ func GetKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesEndpointPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesEndpointFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesConfigMapPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesConfigMapFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -2580,74 +2759,74 @@ func GetKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
-// ==========================  END: KubernetesEndpoint =============================
+// ==========================  END: KubernetesConfigMap =============================
 
-// ==========================  START: KubernetesEvent =============================
+// ==========================  START: KubernetesCronJob =============================
 
-type KubernetesEvent struct {
-	ResourceID      string                                `json:"resource_id"`
-	PlatformID      string                                `json:"platform_id"`
-	Description     kubernetes.KubernetesEventDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                   `json:"metadata"`
-	DescribedBy     string                                `json:"described_by"`
-	ResourceType    string                                `json:"resource_type"`
-	IntegrationType string                                `json:"integration_type"`
-	IntegrationID   string                                `json:"integration_id"`
+type KubernetesCronJob struct {
+	ResourceID      string                                  `json:"resource_id"`
+	PlatformID      string                                  `json:"platform_id"`
+	Description     kubernetes.KubernetesCronJobDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                     `json:"metadata"`
+	DescribedBy     string                                  `json:"described_by"`
+	ResourceType    string                                  `json:"resource_type"`
+	IntegrationType string                                  `json:"integration_type"`
+	IntegrationID   string                                  `json:"integration_id"`
 }
 
-type KubernetesEventHit struct {
-	ID      string          `json:"_id"`
-	Score   float64         `json:"_score"`
-	Index   string          `json:"_index"`
-	Type    string          `json:"_type"`
-	Version int64           `json:"_version,omitempty"`
-	Source  KubernetesEvent `json:"_source"`
-	Sort    []interface{}   `json:"sort"`
+type KubernetesCronJobHit struct {
+	ID      string            `json:"_id"`
+	Score   float64           `json:"_score"`
+	Index   string            `json:"_index"`
+	Type    string            `json:"_type"`
+	Version int64             `json:"_version,omitempty"`
+	Source  KubernetesCronJob `json:"_source"`
+	Sort    []interface{}     `json:"sort"`
 }
 
-type KubernetesEventHits struct {
-	Total essdk.SearchTotal    `json:"total"`
-	Hits  []KubernetesEventHit `json:"hits"`
+type KubernetesCronJobHits struct {
+	Total essdk.SearchTotal      `json:"total"`
+	Hits  []KubernetesCronJobHit `json:"hits"`
 }
 
-type KubernetesEventSearchResponse struct {
-	PitID string              `json:"pit_id"`
-	Hits  KubernetesEventHits `json:"hits"`
+type KubernetesCronJobSearchResponse struct {
+	PitID string                `json:"pit_id"`
+	Hits  KubernetesCronJobHits `json:"hits"`
 }
 
-type KubernetesEventPaginator struct {
+type KubernetesCronJobPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesEventPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesEventPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_event", filters, limit)
+func (k Client) NewKubernetesCronJobPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCronJobPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_cronjob", filters, limit)
 	if err != nil {
-		return KubernetesEventPaginator{}, err
+		return KubernetesCronJobPaginator{}, err
 	}
 
-	p := KubernetesEventPaginator{
+	p := KubernetesCronJobPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesEventPaginator) HasNext() bool {
+func (p KubernetesCronJobPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesEventPaginator) Close(ctx context.Context) error {
+func (p KubernetesCronJobPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesEventPaginator) NextPage(ctx context.Context) ([]KubernetesEvent, error) {
-	var response KubernetesEventSearchResponse
+func (p KubernetesCronJobPaginator) NextPage(ctx context.Context) ([]KubernetesCronJob, error) {
+	var response KubernetesCronJobSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesEvent
+	var values []KubernetesCronJob
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -2662,64 +2841,63 @@ func (p KubernetesEventPaginator) NextPage(ctx context.Context) ([]KubernetesEve
 	return values, nil
 }
 
-var listKubernetesEventFilters = map[string]string{
-	"action":              "Description.Event.Action",
-	"count":               "Description.Event.Count",
-	"involved_object":     "Description.Event.InvolvedObject",
-	"message":             "Description.Event.Message",
-	"reason":              "Description.Event.Reason",
-	"related":             "Description.Event.Related",
-	"reporting_component": "Description.Event.ReportingComponent",
-	"reporting_instance":  "Description.Event.ReportingInstance",
-	"series":              "Description.Event.Series",
-	"source":              "Description.Event.Source",
-	"type":                "Description.Event.Type",
+var listKubernetesCronJobFilters = map[string]string{
+	"active":                        "Description.CronJob.Status.Active",
+	"concurrency_policy":            "Description.CronJob.Spec.ConcurrencyPolicy",
+	"failed_jobs_history_limit":     "Description.CronJob.Spec.FailedJobsHistoryLimit",
+	"job_template":                  "Description.CronJob.Spec.JobTemplate",
+	"platform_integration_id":       "IntegrationID",
+	"schedule":                      "Description.CronJob.Spec.Schedule",
+	"starting_deadline_seconds":     "Description.CronJob.Spec.StartingDeadlineSeconds",
+	"successful_jobs_history_limit": "Description.CronJob.Spec.SuccessfulJobsHistoryLimit",
+	"suspend":                       "Description.CronJob.Spec.Suspend",
+	"title":                         "Description.CronJob.Name",
 }
 
-func ListKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesEvent")
+func ListKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCronJob")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEvent NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCronJob NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEvent NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCronJob NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEvent GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCronJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEvent GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCronJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEvent GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCronJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesEventPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesEventFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCronJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCronJobFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesEvent NewKubernetesEventPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCronJob NewKubernetesCronJobPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesEvent paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCronJob paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -2736,22 +2914,23 @@ func ListKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	return nil, nil
 }
 
-var getKubernetesEventFilters = map[string]string{
-	"action":              "Description.Event.Action",
-	"count":               "Description.Event.Count",
-	"involved_object":     "Description.Event.InvolvedObject",
-	"message":             "Description.Event.Message",
-	"reason":              "Description.Event.Reason",
-	"related":             "Description.Event.Related",
-	"reporting_component": "Description.Event.ReportingComponent",
-	"reporting_instance":  "Description.Event.ReportingInstance",
-	"series":              "Description.Event.Series",
-	"source":              "Description.Event.Source",
-	"type":                "Description.Event.Type",
+var getKubernetesCronJobFilters = map[string]string{
+	"active":                        "Description.CronJob.Status.Active",
+	"concurrency_policy":            "Description.CronJob.Spec.ConcurrencyPolicy",
+	"failed_jobs_history_limit":     "Description.CronJob.Spec.FailedJobsHistoryLimit",
+	"job_template":                  "Description.CronJob.Spec.JobTemplate",
+	"name":                          "Description.MetaObject.Name",
+	"namespace":                     "Description.MetaObject.Namespace",
+	"platform_integration_id":       "IntegrationID",
+	"schedule":                      "Description.CronJob.Spec.Schedule",
+	"starting_deadline_seconds":     "Description.CronJob.Spec.StartingDeadlineSeconds",
+	"successful_jobs_history_limit": "Description.CronJob.Spec.SuccessfulJobsHistoryLimit",
+	"suspend":                       "Description.CronJob.Spec.Suspend",
+	"title":                         "Description.CronJob.Name",
 }
 
-func GetKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesEvent")
+func GetKubernetesCronJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCronJob")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -2779,7 +2958,7 @@ func GetKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesEventPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesEventFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCronJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCronJobFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -2803,74 +2982,74 @@ func GetKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	return nil, nil
 }
 
-// ==========================  END: KubernetesEvent =============================
+// ==========================  END: KubernetesCronJob =============================
 
-// ==========================  START: KubernetesHorizontalPodAutoscaler =============================
+// ==========================  START: KubernetesCSIDriver =============================
 
-type KubernetesHorizontalPodAutoscaler struct {
-	ResourceID      string                                                  `json:"resource_id"`
-	PlatformID      string                                                  `json:"platform_id"`
-	Description     kubernetes.KubernetesHorizontalPodAutoscalerDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                                     `json:"metadata"`
-	DescribedBy     string                                                  `json:"described_by"`
-	ResourceType    string                                                  `json:"resource_type"`
-	IntegrationType string                                                  `json:"integration_type"`
-	IntegrationID   string                                                  `json:"integration_id"`
+type KubernetesCSIDriver struct {
+	ResourceID      string                                    `json:"resource_id"`
+	PlatformID      string                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesCSIDriverDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                       `json:"metadata"`
+	DescribedBy     string                                    `json:"described_by"`
+	ResourceType    string                                    `json:"resource_type"`
+	IntegrationType string                                    `json:"integration_type"`
+	IntegrationID   string                                    `json:"integration_id"`
 }
 
-type KubernetesHorizontalPodAutoscalerHit struct {
-	ID      string                            `json:"_id"`
-	Score   float64                           `json:"_score"`
-	Index   string                            `json:"_index"`
-	Type    string                            `json:"_type"`
-	Version int64                             `json:"_version,omitempty"`
-	Source  KubernetesHorizontalPodAutoscaler `json:"_source"`
-	Sort    []interface{}                     `json:"sort"`
+type KubernetesCSIDriverHit struct {
+	ID      string              `json:"_id"`
+	Score   float64             `json:"_score"`
+	Index   string              `json:"_index"`
+	Type    string              `json:"_type"`
+	Version int64               `json:"_version,omitempty"`
+	Source  KubernetesCSIDriver `json:"_source"`
+	Sort    []interface{}       `json:"sort"`
 }
 
-type KubernetesHorizontalPodAutoscalerHits struct {
-	Total essdk.SearchTotal                      `json:"total"`
-	Hits  []KubernetesHorizontalPodAutoscalerHit `json:"hits"`
+type KubernetesCSIDriverHits struct {
+	Total essdk.SearchTotal        `json:"total"`
+	Hits  []KubernetesCSIDriverHit `json:"hits"`
 }
 
-type KubernetesHorizontalPodAutoscalerSearchResponse struct {
-	PitID string                                `json:"pit_id"`
-	Hits  KubernetesHorizontalPodAutoscalerHits `json:"hits"`
+type KubernetesCSIDriverSearchResponse struct {
+	PitID string                  `json:"pit_id"`
+	Hits  KubernetesCSIDriverHits `json:"hits"`
 }
 
-type KubernetesHorizontalPodAutoscalerPaginator struct {
+type KubernetesCSIDriverPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesHorizontalPodAutoscalerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesHorizontalPodAutoscalerPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_horizontalpodautoscaler", filters, limit)
+func (k Client) NewKubernetesCSIDriverPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCSIDriverPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_csidriver", filters, limit)
 	if err != nil {
-		return KubernetesHorizontalPodAutoscalerPaginator{}, err
+		return KubernetesCSIDriverPaginator{}, err
 	}
 
-	p := KubernetesHorizontalPodAutoscalerPaginator{
+	p := KubernetesCSIDriverPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesHorizontalPodAutoscalerPaginator) HasNext() bool {
+func (p KubernetesCSIDriverPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesHorizontalPodAutoscalerPaginator) Close(ctx context.Context) error {
+func (p KubernetesCSIDriverPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesHorizontalPodAutoscalerPaginator) NextPage(ctx context.Context) ([]KubernetesHorizontalPodAutoscaler, error) {
-	var response KubernetesHorizontalPodAutoscalerSearchResponse
+func (p KubernetesCSIDriverPaginator) NextPage(ctx context.Context) ([]KubernetesCSIDriver, error) {
+	var response KubernetesCSIDriverSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesHorizontalPodAutoscaler
+	var values []KubernetesCSIDriver
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -2885,65 +3064,63 @@ func (p KubernetesHorizontalPodAutoscalerPaginator) NextPage(ctx context.Context
 	return values, nil
 }
 
-var listKubernetesHorizontalPodAutoscalerFilters = map[string]string{
-	"conditions":          "Description.HorizontalPodAutoscaler.Status.Conditions",
-	"current_metrics":     "Description.HorizontalPodAutoscaler.Status.CurrentMetrics",
-	"current_replicas":    "Description.HorizontalPodAutoscaler.Status.CurrentReplicas",
-	"desired_replicas":    "Description.HorizontalPodAutoscaler.Status.DesiredReplicas",
-	"max_replicas":        "Description.HorizontalPodAutoscaler.Spec.MaxReplicas",
-	"metrics":             "Description.HorizontalPodAutoscaler.Spec.Metrics",
-	"min_replicas":        "Description.HorizontalPodAutoscaler.Spec.MinReplicas",
-	"observed_generation": "Description.HorizontalPodAutoscaler.Status.ObservedGeneration",
-	"scale_down_behavior": "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleDown",
-	"scale_target_ref":    "Description.HorizontalPodAutoscaler.Spec.ScaleTargetRef",
-	"scale_up_behavior":   "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleUp",
-	"title":               "Description.HorizontalPodAutoscaler.Name",
+var listKubernetesCSIDriverFilters = map[string]string{
+	"attach_required":         "Description.CSIDriver.Spec.AttachRequired",
+	"fs_group_policy":         "Description.CSIDriver.Spec.FSGroupPolicy",
+	"platform_integration_id": "IntegrationID",
+	"pod_info_on_mount":       "Description.CSIDriver.Spec.PodInfoOnMount",
+	"requires_republish":      "Description.CSIDriver.Spec.RequiresRepublish",
+	"selinux_mount":           "Description.CSIDriver.Spec.SELinuxMount",
+	"storage_capacity":        "Description.CSIDriver.Spec.StorageCapacity",
+	"title":                   "Description.MetaObject.Name",
+	"token_requests":          "Description.CSIDriver.Spec.TokenRequests",
+	"volume_lifecycle_modes":  "Description.CSIDriver.Spec.VolumeLifecycleModes",
 }
 
-func ListKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesHorizontalPodAutoscaler")
+func ListKubernetesCSIDriver(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCSIDriver")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSIDriver NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSIDriver NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSIDriver GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSIDriver GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSIDriver GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesHorizontalPodAutoscalerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesHorizontalPodAutoscalerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCSIDriverPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCSIDriverFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler NewKubernetesHorizontalPodAutoscalerPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSIDriver NewKubernetesCSIDriverPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCSIDriver paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -2960,23 +3137,22 @@ func ListKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryD
 	return nil, nil
 }
 
-var getKubernetesHorizontalPodAutoscalerFilters = map[string]string{
-	"conditions":          "Description.HorizontalPodAutoscaler.Status.Conditions",
-	"current_metrics":     "Description.HorizontalPodAutoscaler.Status.CurrentMetrics",
-	"current_replicas":    "Description.HorizontalPodAutoscaler.Status.CurrentReplicas",
-	"desired_replicas":    "Description.HorizontalPodAutoscaler.Status.DesiredReplicas",
-	"max_replicas":        "Description.HorizontalPodAutoscaler.Spec.MaxReplicas",
-	"metrics":             "Description.HorizontalPodAutoscaler.Spec.Metrics",
-	"min_replicas":        "Description.HorizontalPodAutoscaler.Spec.MinReplicas",
-	"observed_generation": "Description.HorizontalPodAutoscaler.Status.ObservedGeneration",
-	"scale_down_behavior": "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleDown",
-	"scale_target_ref":    "Description.HorizontalPodAutoscaler.Spec.ScaleTargetRef",
-	"scale_up_behavior":   "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleUp",
-	"title":               "Description.HorizontalPodAutoscaler.Name",
+var getKubernetesCSIDriverFilters = map[string]string{
+	"attach_required":         "Description.CSIDriver.Spec.AttachRequired",
+	"fs_group_policy":         "Description.CSIDriver.Spec.FSGroupPolicy",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"pod_info_on_mount":       "Description.CSIDriver.Spec.PodInfoOnMount",
+	"requires_republish":      "Description.CSIDriver.Spec.RequiresRepublish",
+	"selinux_mount":           "Description.CSIDriver.Spec.SELinuxMount",
+	"storage_capacity":        "Description.CSIDriver.Spec.StorageCapacity",
+	"title":                   "Description.MetaObject.Name",
+	"token_requests":          "Description.CSIDriver.Spec.TokenRequests",
+	"volume_lifecycle_modes":  "Description.CSIDriver.Spec.VolumeLifecycleModes",
 }
 
-func GetKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesHorizontalPodAutoscaler")
+func GetKubernetesCSIDriver(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCSIDriver")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -3004,7 +3180,7 @@ func GetKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryDa
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesHorizontalPodAutoscalerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesHorizontalPodAutoscalerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCSIDriverPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCSIDriverFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -3028,14 +3204,14 @@ func GetKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryDa
 	return nil, nil
 }
 
-// ==========================  END: KubernetesHorizontalPodAutoscaler =============================
+// ==========================  END: KubernetesCSIDriver =============================
 
-// ==========================  START: KubernetesIngress =============================
+// ==========================  START: KubernetesCSINode =============================
 
-type KubernetesIngress struct {
+type KubernetesCSINode struct {
 	ResourceID      string                                  `json:"resource_id"`
 	PlatformID      string                                  `json:"platform_id"`
-	Description     kubernetes.KubernetesIngressDescription `json:"Description"`
+	Description     kubernetes.KubernetesCSINodeDescription `json:"Description"`
 	Metadata        kubernetes.Metadata                     `json:"metadata"`
 	DescribedBy     string                                  `json:"described_by"`
 	ResourceType    string                                  `json:"resource_type"`
@@ -3043,59 +3219,59 @@ type KubernetesIngress struct {
 	IntegrationID   string                                  `json:"integration_id"`
 }
 
-type KubernetesIngressHit struct {
+type KubernetesCSINodeHit struct {
 	ID      string            `json:"_id"`
 	Score   float64           `json:"_score"`
 	Index   string            `json:"_index"`
 	Type    string            `json:"_type"`
 	Version int64             `json:"_version,omitempty"`
-	Source  KubernetesIngress `json:"_source"`
+	Source  KubernetesCSINode `json:"_source"`
 	Sort    []interface{}     `json:"sort"`
 }
 
-type KubernetesIngressHits struct {
+type KubernetesCSINodeHits struct {
 	Total essdk.SearchTotal      `json:"total"`
-	Hits  []KubernetesIngressHit `json:"hits"`
+	Hits  []KubernetesCSINodeHit `json:"hits"`
 }
 
-type KubernetesIngressSearchResponse struct {
+type KubernetesCSINodeSearchResponse struct {
 	PitID string                `json:"pit_id"`
-	Hits  KubernetesIngressHits `json:"hits"`
+	Hits  KubernetesCSINodeHits `json:"hits"`
 }
 
-type KubernetesIngressPaginator struct {
+type KubernetesCSINodePaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesIngressPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesIngressPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_ingress", filters, limit)
+func (k Client) NewKubernetesCSINodePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCSINodePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_csinode", filters, limit)
 	if err != nil {
-		return KubernetesIngressPaginator{}, err
+		return KubernetesCSINodePaginator{}, err
 	}
 
-	p := KubernetesIngressPaginator{
+	p := KubernetesCSINodePaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesIngressPaginator) HasNext() bool {
+func (p KubernetesCSINodePaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesIngressPaginator) Close(ctx context.Context) error {
+func (p KubernetesCSINodePaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesIngressPaginator) NextPage(ctx context.Context) ([]KubernetesIngress, error) {
-	var response KubernetesIngressSearchResponse
+func (p KubernetesCSINodePaginator) NextPage(ctx context.Context) ([]KubernetesCSINode, error) {
+	var response KubernetesCSINodeSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesIngress
+	var values []KubernetesCSINode
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -3110,59 +3286,56 @@ func (p KubernetesIngressPaginator) NextPage(ctx context.Context) ([]KubernetesI
 	return values, nil
 }
 
-var listKubernetesIngressFilters = map[string]string{
-	"default_backend":    "Description.Ingress.Spec.DefaultBackend",
-	"ingress_class_name": "Description.Ingress.Spec.IngressClassName",
-	"load_balancer":      "Description.Ingress.Status.LoadBalancer.Ingress",
-	"rules":              "Description.Ingress.Spec.Rules",
-	"title":              "Description.Ingress.Name",
-	"tls":                "Description.Ingress.Spec.TLS",
+var listKubernetesCSINodeFilters = map[string]string{
+	"drivers":                 "Description.CSINode.Spec.Drivers",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func ListKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesIngress")
+func ListKubernetesCSINode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCSINode")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesIngress NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSINode NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesIngress NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSINode NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesIngress GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSINode GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesIngress GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSINode GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesIngress GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSINode GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesIngressPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesIngressFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCSINodePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCSINodeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesIngress NewKubernetesIngressPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCSINode NewKubernetesCSINodePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesIngress paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCSINode paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -3179,17 +3352,15 @@ func ListKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
-var getKubernetesIngressFilters = map[string]string{
-	"default_backend":    "Description.Ingress.Spec.DefaultBackend",
-	"ingress_class_name": "Description.Ingress.Spec.IngressClassName",
-	"load_balancer":      "Description.Ingress.Status.LoadBalancer.Ingress",
-	"rules":              "Description.Ingress.Spec.Rules",
-	"title":              "Description.Ingress.Name",
-	"tls":                "Description.Ingress.Spec.TLS",
+var getKubernetesCSINodeFilters = map[string]string{
+	"drivers":                 "Description.CSINode.Spec.Drivers",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func GetKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesIngress")
+func GetKubernetesCSINode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCSINode")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -3217,7 +3388,7 @@ func GetKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesIngressPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesIngressFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCSINodePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCSINodeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -3241,74 +3412,74 @@ func GetKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	return nil, nil
 }
 
-// ==========================  END: KubernetesIngress =============================
+// ==========================  END: KubernetesCSINode =============================
 
-// ==========================  START: KubernetesJob =============================
+// ==========================  START: KubernetesCustomResource =============================
 
-type KubernetesJob struct {
-	ResourceID      string                              `json:"resource_id"`
-	PlatformID      string                              `json:"platform_id"`
-	Description     kubernetes.KubernetesJobDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                 `json:"metadata"`
-	DescribedBy     string                              `json:"described_by"`
-	ResourceType    string                              `json:"resource_type"`
-	IntegrationType string                              `json:"integration_type"`
-	IntegrationID   string                              `json:"integration_id"`
+type KubernetesCustomResource struct {
+	ResourceID      string                                         `json:"resource_id"`
+	PlatformID      string                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesCustomResourceDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                            `json:"metadata"`
+	DescribedBy     string                                         `json:"described_by"`
+	ResourceType    string                                         `json:"resource_type"`
+	IntegrationType string                                         `json:"integration_type"`
+	IntegrationID   string                                         `json:"integration_id"`
 }
 
-type KubernetesJobHit struct {
-	ID      string        `json:"_id"`
-	Score   float64       `json:"_score"`
-	Index   string        `json:"_index"`
-	Type    string        `json:"_type"`
-	Version int64         `json:"_version,omitempty"`
-	Source  KubernetesJob `json:"_source"`
-	Sort    []interface{} `json:"sort"`
+type KubernetesCustomResourceHit struct {
+	ID      string                   `json:"_id"`
+	Score   float64                  `json:"_score"`
+	Index   string                   `json:"_index"`
+	Type    string                   `json:"_type"`
+	Version int64                    `json:"_version,omitempty"`
+	Source  KubernetesCustomResource `json:"_source"`
+	Sort    []interface{}            `json:"sort"`
 }
 
-type KubernetesJobHits struct {
-	Total essdk.SearchTotal  `json:"total"`
-	Hits  []KubernetesJobHit `json:"hits"`
+type KubernetesCustomResourceHits struct {
+	Total essdk.SearchTotal             `json:"total"`
+	Hits  []KubernetesCustomResourceHit `json:"hits"`
 }
 
-type KubernetesJobSearchResponse struct {
-	PitID string            `json:"pit_id"`
-	Hits  KubernetesJobHits `json:"hits"`
+type KubernetesCustomResourceSearchResponse struct {
+	PitID string                       `json:"pit_id"`
+	Hits  KubernetesCustomResourceHits `json:"hits"`
 }
 
-type KubernetesJobPaginator struct {
+type KubernetesCustomResourcePaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesJobPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesJobPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_job", filters, limit)
+func (k Client) NewKubernetesCustomResourcePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCustomResourcePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_customresource", filters, limit)
 	if err != nil {
-		return KubernetesJobPaginator{}, err
+		return KubernetesCustomResourcePaginator{}, err
 	}
 
-	p := KubernetesJobPaginator{
+	p := KubernetesCustomResourcePaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesJobPaginator) HasNext() bool {
+func (p KubernetesCustomResourcePaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesJobPaginator) Close(ctx context.Context) error {
+func (p KubernetesCustomResourcePaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesJobPaginator) NextPage(ctx context.Context) ([]KubernetesJob, error) {
-	var response KubernetesJobSearchResponse
+func (p KubernetesCustomResourcePaginator) NextPage(ctx context.Context) ([]KubernetesCustomResource, error) {
+	var response KubernetesCustomResourceSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesJob
+	var values []KubernetesCustomResource
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -3323,67 +3494,58 @@ func (p KubernetesJobPaginator) NextPage(ctx context.Context) ([]KubernetesJob,
 	return values, nil
 }
 
-var listKubernetesJobFilters = map[string]string{
-	"active":                     "Description.Job.Status.Active",
-	"active_deadline_seconds":    "Description.Job.Spec.ActiveDeadlineSeconds",
-	"backoff_limit":              "Description.Job.Spec.BackoffLimit",
-	"completions":                "Description.Job.Spec.Completions",
-	"conditions":                 "Description.Job.Status.Conditions",
-	"failed":                     "Description.Job.Status.Failed",
-	"manual_selector":            "Description.Job.Spec.ManualSelector",
-	"parallelism":                "Description.Job.Spec.Parallelism",
-	"selector":                   "Description.Job.Spec.Selector",
-	"selector_query":             "Description.LabelSelectorString",
-	"succeeded":                  "Description.Job.Status.Succeeded",
-	"template":                   "Description.Job.Spec.Template",
-	"title":                      "Description.Job.Name",
-	"ttl_seconds_after_finished": "Description.Job.Spec.TTLSecondsAfterFinished",
+var listKubernetesCustomResourceFilters = map[string]string{
+	"fully_qualified_name":    "Description.FullyQualifiedName",
+	"platform_integration_id": "IntegrationID",
+	"spec":                    "Description.Spec",
+	"status":                  "Description.Status",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func ListKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesJob")
+func ListKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCustomResource")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesJob NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResource NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesJob NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResource NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResource GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResource GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResource GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesJobFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCustomResourcePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCustomResourceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesJob NewKubernetesJobPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResource NewKubernetesCustomResourcePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesJob paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCustomResource paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -3400,25 +3562,18 @@ func ListKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	return nil, nil
 }
 
-var getKubernetesJobFilters = map[string]string{
-	"active":                     "Description.Job.Status.Active",
-	"active_deadline_seconds":    "Description.Job.Spec.ActiveDeadlineSeconds",
-	"backoff_limit":              "Description.Job.Spec.BackoffLimit",
-	"completions":                "Description.Job.Spec.Completions",
-	"conditions":                 "Description.Job.Status.Conditions",
-	"failed":                     "Description.Job.Status.Failed",
-	"manual_selector":            "Description.Job.Spec.ManualSelector",
-	"parallelism":                "Description.Job.Spec.Parallelism",
-	"selector":                   "Description.Job.Spec.Selector",
-	"selector_query":             "Description.LabelSelectorString",
-	"succeeded":                  "Description.Job.Status.Succeeded",
-	"template":                   "Description.Job.Spec.Template",
-	"title":                      "Description.Job.Name",
-	"ttl_seconds_after_finished": "Description.Job.Spec.TTLSecondsAfterFinished",
+var getKubernetesCustomResourceFilters = map[string]string{
+	"fully_qualified_name":    "Description.FullyQualifiedName",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"spec":                    "Description.Spec",
+	"status":                  "Description.Status",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func GetKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesJob")
+func GetKubernetesCustomResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCustomResource")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -3446,7 +3601,7 @@ func GetKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesJobFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCustomResourcePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCustomResourceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -3470,74 +3625,74 @@ func GetKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	return nil, nil
 }
 
-// ==========================  END: KubernetesJob =============================
+// ==========================  END: KubernetesCustomResource =============================
 
-// ==========================  START: KubernetesLimitRange =============================
+// ==========================  START: KubernetesCustomResourceDefinition =============================
 
-type KubernetesLimitRange struct {
-	ResourceID      string                                     `json:"resource_id"`
-	PlatformID      string                                     `json:"platform_id"`
-	Description     kubernetes.KubernetesLimitRangeDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                        `json:"metadata"`
-	DescribedBy     string                                     `json:"described_by"`
-	ResourceType    string                                     `json:"resource_type"`
-	IntegrationType string                                     `json:"integration_type"`
-	IntegrationID   string                                     `json:"integration_id"`
+type KubernetesCustomResourceDefinition struct {
+	ResourceID      string                                                   `json:"resource_id"`
+	PlatformID      string                                                   `json:"platform_id"`
+	Description     kubernetes.KubernetesCustomResourceDefinitionDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                      `json:"metadata"`
+	DescribedBy     string                                                   `json:"described_by"`
+	ResourceType    string                                                   `json:"resource_type"`
+	IntegrationType string                                                   `json:"integration_type"`
+	IntegrationID   string                                                   `json:"integration_id"`
 }
 
-type KubernetesLimitRangeHit struct {
-	ID      string               `json:"_id"`
-	Score   float64              `json:"_score"`
-	Index   string               `json:"_index"`
-	Type    string               `json:"_type"`
-	Version int64                `json:"_version,omitempty"`
-	Source  KubernetesLimitRange `json:"_source"`
-	Sort    []interface{}        `json:"sort"`
+type KubernetesCustomResourceDefinitionHit struct {
+	ID      string                             `json:"_id"`
+	Score   float64                            `json:"_score"`
+	Index   string                             `json:"_index"`
+	Type    string                             `json:"_type"`
+	Version int64                              `json:"_version,omitempty"`
+	Source  KubernetesCustomResourceDefinition `json:"_source"`
+	Sort    []interface{}                      `json:"sort"`
 }
 
-type KubernetesLimitRangeHits struct {
-	Total essdk.SearchTotal         `json:"total"`
-	Hits  []KubernetesLimitRangeHit `json:"hits"`
+type KubernetesCustomResourceDefinitionHits struct {
+	Total essdk.SearchTotal                       `json:"total"`
+	Hits  []KubernetesCustomResourceDefinitionHit `json:"hits"`
 }
 
-type KubernetesLimitRangeSearchResponse struct {
-	PitID string                   `json:"pit_id"`
-	Hits  KubernetesLimitRangeHits `json:"hits"`
+type KubernetesCustomResourceDefinitionSearchResponse struct {
+	PitID string                                 `json:"pit_id"`
+	Hits  KubernetesCustomResourceDefinitionHits `json:"hits"`
 }
 
-type KubernetesLimitRangePaginator struct {
+type KubernetesCustomResourceDefinitionPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesLimitRangePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesLimitRangePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_limitrange", filters, limit)
+func (k Client) NewKubernetesCustomResourceDefinitionPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesCustomResourceDefinitionPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_customresourcedefinition", filters, limit)
 	if err != nil {
-		return KubernetesLimitRangePaginator{}, err
+		return KubernetesCustomResourceDefinitionPaginator{}, err
 	}
 
-	p := KubernetesLimitRangePaginator{
+	p := KubernetesCustomResourceDefinitionPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesLimitRangePaginator) HasNext() bool {
+func (p KubernetesCustomResourceDefinitionPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesLimitRangePaginator) Close(ctx context.Context) error {
+func (p KubernetesCustomResourceDefinitionPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesLimitRangePaginator) NextPage(ctx context.Context) ([]KubernetesLimitRange, error) {
-	var response KubernetesLimitRangeSearchResponse
+func (p KubernetesCustomResourceDefinitionPaginator) NextPage(ctx context.Context) ([]KubernetesCustomResourceDefinition, error) {
+	var response KubernetesCustomResourceDefinitionSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesLimitRange
+	var values []KubernetesCustomResourceDefinition
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -3552,55 +3707,56 @@ func (p KubernetesLimitRangePaginator) NextPage(ctx context.Context) ([]Kubernet
 	return values, nil
 }
 
-var listKubernetesLimitRangeFilters = map[string]string{
-	"spec_limits": "Description.LimitRange.Spec.Limits",
-	"title":       "Description.LimitRange.Name",
+var listKubernetesCustomResourceDefinitionFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"spec":                    "Description.CustomResourceDefinition.Spec",
+	"status":                  "Description.CustomResourceDefinition.Status",
 }
 
-func ListKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesLimitRange")
+func ListKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesCustomResourceDefinition")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesLimitRange NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesLimitRange NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesLimitRange GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesLimitRange GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesLimitRange GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesLimitRangePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesLimitRangeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesCustomResourceDefinitionPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesCustomResourceDefinitionFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesLimitRange NewKubernetesLimitRangePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition NewKubernetesCustomResourceDefinitionPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesLimitRange paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesCustomResourceDefinition paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -3617,13 +3773,15 @@ func ListKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-var getKubernetesLimitRangeFilters = map[string]string{
-	"spec_limits": "Description.LimitRange.Spec.Limits",
-	"title":       "Description.LimitRange.Name",
+var getKubernetesCustomResourceDefinitionFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"spec":                    "Description.CustomResourceDefinition.Spec",
+	"status":                  "Description.CustomResourceDefinition.Status",
 }
 
-func GetKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesLimitRange")
+func GetKubernetesCustomResourceDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesCustomResourceDefinition")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -3651,7 +3809,7 @@ func GetKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugin
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesLimitRangePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesLimitRangeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesCustomResourceDefinitionPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesCustomResourceDefinitionFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -3675,14 +3833,14 @@ func GetKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return nil, nil
 }
 
-// ==========================  END: KubernetesLimitRange =============================
+// ==========================  END: KubernetesCustomResourceDefinition =============================
 
-// ==========================  START: KubernetesNamespace =============================
+// ==========================  START: KubernetesDaemonSet =============================
 
-type KubernetesNamespace struct {
+type KubernetesDaemonSet struct {
 	ResourceID      string                                    `json:"resource_id"`
 	PlatformID      string                                    `json:"platform_id"`
-	Description     kubernetes.KubernetesNamespaceDescription `json:"Description"`
+	Description     kubernetes.KubernetesDaemonSetDescription `json:"Description"`
 	Metadata        kubernetes.Metadata                       `json:"metadata"`
 	DescribedBy     string                                    `json:"described_by"`
 	ResourceType    string                                    `json:"resource_type"`
@@ -3690,59 +3848,59 @@ type KubernetesNamespace struct {
 	IntegrationID   string                                    `json:"integration_id"`
 }
 
-type KubernetesNamespaceHit struct {
+type KubernetesDaemonSetHit struct {
 	ID      string              `json:"_id"`
 	Score   float64             `json:"_score"`
 	Index   string              `json:"_index"`
 	Type    string              `json:"_type"`
 	Version int64               `json:"_version,omitempty"`
-	Source  KubernetesNamespace `json:"_source"`
+	Source  KubernetesDaemonSet `json:"_source"`
 	Sort    []interface{}       `json:"sort"`
 }
 
-type KubernetesNamespaceHits struct {
+type KubernetesDaemonSetHits struct {
 	Total essdk.SearchTotal        `json:"total"`
-	Hits  []KubernetesNamespaceHit `json:"hits"`
+	Hits  []KubernetesDaemonSetHit `json:"hits"`
 }
 
-type KubernetesNamespaceSearchResponse struct {
+type KubernetesDaemonSetSearchResponse struct {
 	PitID string                  `json:"pit_id"`
-	Hits  KubernetesNamespaceHits `json:"hits"`
+	Hits  KubernetesDaemonSetHits `json:"hits"`
 }
 
-type KubernetesNamespacePaginator struct {
+type KubernetesDaemonSetPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesNamespacePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNamespacePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_namespace", filters, limit)
+func (k Client) NewKubernetesDaemonSetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesDaemonSetPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_daemonset", filters, limit)
 	if err != nil {
-		return KubernetesNamespacePaginator{}, err
+		return KubernetesDaemonSetPaginator{}, err
 	}
 
-	p := KubernetesNamespacePaginator{
+	p := KubernetesDaemonSetPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesNamespacePaginator) HasNext() bool {
+func (p KubernetesDaemonSetPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesNamespacePaginator) Close(ctx context.Context) error {
+func (p KubernetesDaemonSetPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesNamespacePaginator) NextPage(ctx context.Context) ([]KubernetesNamespace, error) {
-	var response KubernetesNamespaceSearchResponse
+func (p KubernetesDaemonSetPaginator) NextPage(ctx context.Context) ([]KubernetesDaemonSet, error) {
+	var response KubernetesDaemonSetSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesNamespace
+	var values []KubernetesDaemonSet
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -3757,57 +3915,71 @@ func (p KubernetesNamespacePaginator) NextPage(ctx context.Context) ([]Kubernete
 	return values, nil
 }
 
-var listKubernetesNamespaceFilters = map[string]string{
-	"conditions":      "Description.Namespace.Status.NamespaceCondition",
-	"phase":           "Description.Namespace.Status.Phase",
-	"spec_finalizers": "Description.Namespace.Spec.Finalizers",
-	"title":           "Description.Namespace.Name",
+var listKubernetesDaemonSetFilters = map[string]string{
+	"collision_count":          "Description.DaemonSet.Status.CollisionCount",
+	"conditions":               "Description.DaemonSet.Status.Conditions",
+	"current_number_scheduled": "Description.DaemonSet.Status.CurrentNumberScheduled",
+	"desired_number_scheduled": "Description.DaemonSet.Status.DesiredNumberScheduled",
+	"min_ready_seconds":        "Description.DaemonSet.Spec.MinReadySeconds",
+	"number_available":         "Description.DaemonSet.Status.NumberAvailable",
+	"number_misscheduled":      "Description.DaemonSet.Status.NumberMisscheduled",
+	"number_ready":             "Description.DaemonSet.Status.NumberReady",
+	"number_unavailable":       "Description.DaemonSet.Status.NumberUnavailable",
+	"observed_generation":      "Description.DaemonSet.Status.ObservedGeneration",
+	"platform_integration_id":  "IntegrationID",
+	"revision_history_limit":   "Description.DaemonSet.Spec.RevisionHistoryLimit",
+	"selector":                 "Description.DaemonSet.Spec.Volumes",
+	"selector_query":           "Description.LabelSelectorString",
+	"template":                 "Description.DaemonSet.Spec.Template",
+	"title":                    "Description.DaemonSet.Name",
+	"update_strategy":          "Description.DaemonSet.Spec.UpdateStrategy",
+	"updated_number_scheduled": "Description.DaemonSet.Status.UpdatedNumberScheduled",
 }
 
-func ListKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesNamespace")
+func ListKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesDaemonSet")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNamespace NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDaemonSet NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNamespace NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDaemonSet NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNamespace GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDaemonSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNamespace GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDaemonSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNamespace GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDaemonSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesNamespacePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNamespaceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesDaemonSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesDaemonSetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNamespace NewKubernetesNamespacePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDaemonSet NewKubernetesDaemonSetPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesNamespace paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesDaemonSet paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -3824,15 +3996,31 @@ func ListKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return nil, nil
 }
 
-var getKubernetesNamespaceFilters = map[string]string{
-	"conditions":      "Description.Namespace.Status.NamespaceCondition",
-	"phase":           "Description.Namespace.Status.Phase",
-	"spec_finalizers": "Description.Namespace.Spec.Finalizers",
-	"title":           "Description.Namespace.Name",
+var getKubernetesDaemonSetFilters = map[string]string{
+	"collision_count":          "Description.DaemonSet.Status.CollisionCount",
+	"conditions":               "Description.DaemonSet.Status.Conditions",
+	"current_number_scheduled": "Description.DaemonSet.Status.CurrentNumberScheduled",
+	"desired_number_scheduled": "Description.DaemonSet.Status.DesiredNumberScheduled",
+	"min_ready_seconds":        "Description.DaemonSet.Spec.MinReadySeconds",
+	"name":                     "Description.MetaObject.Name",
+	"namespace":                "Description.MetaObject.Namespace",
+	"number_available":         "Description.DaemonSet.Status.NumberAvailable",
+	"number_misscheduled":      "Description.DaemonSet.Status.NumberMisscheduled",
+	"number_ready":             "Description.DaemonSet.Status.NumberReady",
+	"number_unavailable":       "Description.DaemonSet.Status.NumberUnavailable",
+	"observed_generation":      "Description.DaemonSet.Status.ObservedGeneration",
+	"platform_integration_id":  "IntegrationID",
+	"revision_history_limit":   "Description.DaemonSet.Spec.RevisionHistoryLimit",
+	"selector":                 "Description.DaemonSet.Spec.Volumes",
+	"selector_query":           "Description.LabelSelectorString",
+	"template":                 "Description.DaemonSet.Spec.Template",
+	"title":                    "Description.DaemonSet.Name",
+	"update_strategy":          "Description.DaemonSet.Spec.UpdateStrategy",
+	"updated_number_scheduled": "Description.DaemonSet.Status.UpdatedNumberScheduled",
 }
 
-func GetKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesNamespace")
+func GetKubernetesDaemonSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesDaemonSet")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -3860,7 +4048,7 @@ func GetKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesNamespacePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNamespaceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesDaemonSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesDaemonSetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -3884,74 +4072,74 @@ func GetKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	return nil, nil
 }
 
-// ==========================  END: KubernetesNamespace =============================
+// ==========================  END: KubernetesDaemonSet =============================
 
-// ==========================  START: KubernetesNetworkPolicy =============================
+// ==========================  START: KubernetesDeployment =============================
 
-type KubernetesNetworkPolicy struct {
-	ResourceID      string                                        `json:"resource_id"`
-	PlatformID      string                                        `json:"platform_id"`
-	Description     kubernetes.KubernetesNetworkPolicyDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                           `json:"metadata"`
-	DescribedBy     string                                        `json:"described_by"`
-	ResourceType    string                                        `json:"resource_type"`
-	IntegrationType string                                        `json:"integration_type"`
-	IntegrationID   string                                        `json:"integration_id"`
+type KubernetesDeployment struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesDeploymentDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
 }
 
-type KubernetesNetworkPolicyHit struct {
-	ID      string                  `json:"_id"`
-	Score   float64                 `json:"_score"`
-	Index   string                  `json:"_index"`
-	Type    string                  `json:"_type"`
-	Version int64                   `json:"_version,omitempty"`
-	Source  KubernetesNetworkPolicy `json:"_source"`
-	Sort    []interface{}           `json:"sort"`
+type KubernetesDeploymentHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesDeployment `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
 }
 
-type KubernetesNetworkPolicyHits struct {
-	Total essdk.SearchTotal            `json:"total"`
-	Hits  []KubernetesNetworkPolicyHit `json:"hits"`
+type KubernetesDeploymentHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesDeploymentHit `json:"hits"`
 }
 
-type KubernetesNetworkPolicySearchResponse struct {
-	PitID string                      `json:"pit_id"`
-	Hits  KubernetesNetworkPolicyHits `json:"hits"`
+type KubernetesDeploymentSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesDeploymentHits `json:"hits"`
 }
 
-type KubernetesNetworkPolicyPaginator struct {
+type KubernetesDeploymentPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesNetworkPolicyPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNetworkPolicyPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_networkpolicy", filters, limit)
+func (k Client) NewKubernetesDeploymentPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesDeploymentPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_deployment", filters, limit)
 	if err != nil {
-		return KubernetesNetworkPolicyPaginator{}, err
+		return KubernetesDeploymentPaginator{}, err
 	}
 
-	p := KubernetesNetworkPolicyPaginator{
+	p := KubernetesDeploymentPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesNetworkPolicyPaginator) HasNext() bool {
+func (p KubernetesDeploymentPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesNetworkPolicyPaginator) Close(ctx context.Context) error {
+func (p KubernetesDeploymentPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesNetworkPolicyPaginator) NextPage(ctx context.Context) ([]KubernetesNetworkPolicy, error) {
-	var response KubernetesNetworkPolicySearchResponse
+func (p KubernetesDeploymentPaginator) NextPage(ctx context.Context) ([]KubernetesDeployment, error) {
+	var response KubernetesDeploymentSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesNetworkPolicy
+	var values []KubernetesDeployment
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -3966,58 +4154,72 @@ func (p KubernetesNetworkPolicyPaginator) NextPage(ctx context.Context) ([]Kuber
 	return values, nil
 }
 
-var listKubernetesNetworkPolicyFilters = map[string]string{
-	"egress":       "Description.NetworkPolicy.Spec.Egress",
-	"ingress":      "Description.NetworkPolicy.Spec.Ingress",
-	"pod_selector": "Description.NetworkPolicy.Spec.PodSelector",
-	"policy_types": "Description.NetworkPolicy.Spec.PolicyTypes",
-	"title":        "Description.NetworkPolicy.Name",
+var listKubernetesDeploymentFilters = map[string]string{
+	"available_replicas":        "Description.Deployment.Status.AvailableReplicas",
+	"collision_count":           "Description.Deployment.Status.CollisionCount",
+	"conditions":                "Description.Deployment.Status.Conditions",
+	"min_ready_seconds":         "Description.Deployment.Spec.MinReadySeconds",
+	"observed_generation":       "Description.Deployment.Status.ObservedGeneration",
+	"paused":                    "Description.Deployment.Spec.Paused",
+	"platform_integration_id":   "IntegrationID",
+	"progress_deadline_seconds": "Description.Deployment.Spec.ProgressDeadlineSeconds",
+	"ready_replicas":            "Description.Deployment.Status.ReadyReplicas",
+	"replicas":                  "Description.Deployment.Spec.Replicas",
+	"revision_history_limit":    "Description.Deployment.Spec.RevisionHistoryLimit",
+	"selector":                  "Description.Deployment.Spec.Selector",
+	"selector_query":            "Description.LabelSelectorString",
+	"status_replicas":           "Description.Deployment.Status.Replicas",
+	"strategy":                  "Description.Deployment.Spec.Strategy",
+	"template":                  "Description.Deployment.Spec.Template",
+	"title":                     "Description.Deployment.Name",
+	"unavailable_replicas":      "Description.Deployment.Status.UnavailableReplicas",
+	"updated_replicas":          "Description.Deployment.Status.UpdatedReplicas",
 }
 
-func ListKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesNetworkPolicy")
+func ListKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesDeployment")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDeployment NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDeployment NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDeployment GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDeployment GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDeployment GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesNetworkPolicyPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNetworkPolicyFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesDeploymentPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesDeploymentFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy NewKubernetesNetworkPolicyPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesDeployment NewKubernetesDeploymentPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesDeployment paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -4034,16 +4236,32 @@ func ListKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *pl
 	return nil, nil
 }
 
-var getKubernetesNetworkPolicyFilters = map[string]string{
-	"egress":       "Description.NetworkPolicy.Spec.Egress",
-	"ingress":      "Description.NetworkPolicy.Spec.Ingress",
-	"pod_selector": "Description.NetworkPolicy.Spec.PodSelector",
-	"policy_types": "Description.NetworkPolicy.Spec.PolicyTypes",
-	"title":        "Description.NetworkPolicy.Name",
+var getKubernetesDeploymentFilters = map[string]string{
+	"available_replicas":        "Description.Deployment.Status.AvailableReplicas",
+	"collision_count":           "Description.Deployment.Status.CollisionCount",
+	"conditions":                "Description.Deployment.Status.Conditions",
+	"min_ready_seconds":         "Description.Deployment.Spec.MinReadySeconds",
+	"name":                      "Description.MetaObject.Name",
+	"namespace":                 "Description.MetaObject.Namespace",
+	"observed_generation":       "Description.Deployment.Status.ObservedGeneration",
+	"paused":                    "Description.Deployment.Spec.Paused",
+	"platform_integration_id":   "IntegrationID",
+	"progress_deadline_seconds": "Description.Deployment.Spec.ProgressDeadlineSeconds",
+	"ready_replicas":            "Description.Deployment.Status.ReadyReplicas",
+	"replicas":                  "Description.Deployment.Spec.Replicas",
+	"revision_history_limit":    "Description.Deployment.Spec.RevisionHistoryLimit",
+	"selector":                  "Description.Deployment.Spec.Selector",
+	"selector_query":            "Description.LabelSelectorString",
+	"status_replicas":           "Description.Deployment.Status.Replicas",
+	"strategy":                  "Description.Deployment.Spec.Strategy",
+	"template":                  "Description.Deployment.Spec.Template",
+	"title":                     "Description.Deployment.Name",
+	"unavailable_replicas":      "Description.Deployment.Status.UnavailableReplicas",
+	"updated_replicas":          "Description.Deployment.Status.UpdatedReplicas",
 }
 
-func GetKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesNetworkPolicy")
+func GetKubernetesDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesDeployment")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -4071,7 +4289,7 @@ func GetKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *plu
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesNetworkPolicyPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNetworkPolicyFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesDeploymentPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesDeploymentFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -4095,74 +4313,74 @@ func GetKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *plu
 	return nil, nil
 }
 
-// ==========================  END: KubernetesNetworkPolicy =============================
+// ==========================  END: KubernetesDeployment =============================
 
-// ==========================  START: KubernetesNode =============================
+// ==========================  START: KubernetesEndpointSlice =============================
 
-type KubernetesNode struct {
-	ResourceID      string                               `json:"resource_id"`
-	PlatformID      string                               `json:"platform_id"`
-	Description     kubernetes.KubernetesNodeDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                  `json:"metadata"`
-	DescribedBy     string                               `json:"described_by"`
-	ResourceType    string                               `json:"resource_type"`
-	IntegrationType string                               `json:"integration_type"`
-	IntegrationID   string                               `json:"integration_id"`
+type KubernetesEndpointSlice struct {
+	ResourceID      string                                        `json:"resource_id"`
+	PlatformID      string                                        `json:"platform_id"`
+	Description     kubernetes.KubernetesEndpointSliceDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                           `json:"metadata"`
+	DescribedBy     string                                        `json:"described_by"`
+	ResourceType    string                                        `json:"resource_type"`
+	IntegrationType string                                        `json:"integration_type"`
+	IntegrationID   string                                        `json:"integration_id"`
 }
 
-type KubernetesNodeHit struct {
-	ID      string         `json:"_id"`
-	Score   float64        `json:"_score"`
-	Index   string         `json:"_index"`
-	Type    string         `json:"_type"`
-	Version int64          `json:"_version,omitempty"`
-	Source  KubernetesNode `json:"_source"`
-	Sort    []interface{}  `json:"sort"`
+type KubernetesEndpointSliceHit struct {
+	ID      string                  `json:"_id"`
+	Score   float64                 `json:"_score"`
+	Index   string                  `json:"_index"`
+	Type    string                  `json:"_type"`
+	Version int64                   `json:"_version,omitempty"`
+	Source  KubernetesEndpointSlice `json:"_source"`
+	Sort    []interface{}           `json:"sort"`
 }
 
-type KubernetesNodeHits struct {
-	Total essdk.SearchTotal   `json:"total"`
-	Hits  []KubernetesNodeHit `json:"hits"`
+type KubernetesEndpointSliceHits struct {
+	Total essdk.SearchTotal            `json:"total"`
+	Hits  []KubernetesEndpointSliceHit `json:"hits"`
 }
 
-type KubernetesNodeSearchResponse struct {
-	PitID string             `json:"pit_id"`
-	Hits  KubernetesNodeHits `json:"hits"`
+type KubernetesEndpointSliceSearchResponse struct {
+	PitID string                      `json:"pit_id"`
+	Hits  KubernetesEndpointSliceHits `json:"hits"`
 }
 
-type KubernetesNodePaginator struct {
+type KubernetesEndpointSlicePaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesNodePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNodePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_node", filters, limit)
+func (k Client) NewKubernetesEndpointSlicePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesEndpointSlicePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_endpointslice", filters, limit)
 	if err != nil {
-		return KubernetesNodePaginator{}, err
+		return KubernetesEndpointSlicePaginator{}, err
 	}
 
-	p := KubernetesNodePaginator{
+	p := KubernetesEndpointSlicePaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesNodePaginator) HasNext() bool {
+func (p KubernetesEndpointSlicePaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesNodePaginator) Close(ctx context.Context) error {
+func (p KubernetesEndpointSlicePaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesNodePaginator) NextPage(ctx context.Context) ([]KubernetesNode, error) {
-	var response KubernetesNodeSearchResponse
+func (p KubernetesEndpointSlicePaginator) NextPage(ctx context.Context) ([]KubernetesEndpointSlice, error) {
+	var response KubernetesEndpointSliceSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesNode
+	var values []KubernetesEndpointSlice
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -4177,71 +4395,58 @@ func (p KubernetesNodePaginator) NextPage(ctx context.Context) ([]KubernetesNode
 	return values, nil
 }
 
-var listKubernetesNodeFilters = map[string]string{
-	"addresses":        "Description.Node.Status.Addresses",
-	"allocatable":      "Description.Node.Status.Allocatable",
-	"capacity":         "Description.Node.Status.Capacity",
-	"conditions":       "Description.Node.Status.Conditions",
-	"config":           "Description.Node.Status.Config",
-	"config_source":    "Description.Node.Spec.ConfigSource",
-	"daemon_endpoints": "Description.Node.Status.DaemonEndpoints",
-	"images":           "Description.Node.Status.Images",
-	"node_info":        "Description.Node.Status.NodeInfo",
-	"phase":            "Description.Node.Status.Phase",
-	"pod_cidr":         "Description.Node.Spec.PodCIDR",
-	"pod_cidrs":        "Description.Node.Spec.PodCIDRs",
-	"provider_id":      "Description.Node.Spec.ProviderID",
-	"taints":           "Description.Node.Spec.Taints",
-	"title":            "Description.Node.Name",
-	"unschedulable":    "Description.Node.Spec.Unschedulable",
-	"volumes_attached": "Description.Node.Status.VolumesAttached",
-	"volumes_in_use":   "Description.Node.Status.VolumesInUse",
+var listKubernetesEndpointSliceFilters = map[string]string{
+	"address_type":            "Description.EndpointSlice.AddressType",
+	"endpoints":               "Description.EndpointSlice.Endpoints",
+	"platform_integration_id": "IntegrationID",
+	"ports":                   "Description.EndpointSlice.Ports",
+	"title":                   "Description.EndpointSlice.Name",
 }
 
-func ListKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesNode")
+func ListKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesEndpointSlice")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNode NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNode NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNode GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNode GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNode GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesNodePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNodeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesEndpointSlicePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesEndpointSliceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesNode NewKubernetesNodePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpointSlice NewKubernetesEndpointSlicePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesNode paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesEndpointSlice paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -4258,29 +4463,18 @@ func ListKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	return nil, nil
 }
 
-var getKubernetesNodeFilters = map[string]string{
-	"addresses":        "Description.Node.Status.Addresses",
-	"allocatable":      "Description.Node.Status.Allocatable",
-	"capacity":         "Description.Node.Status.Capacity",
-	"conditions":       "Description.Node.Status.Conditions",
-	"config":           "Description.Node.Status.Config",
-	"config_source":    "Description.Node.Spec.ConfigSource",
-	"daemon_endpoints": "Description.Node.Status.DaemonEndpoints",
-	"images":           "Description.Node.Status.Images",
-	"node_info":        "Description.Node.Status.NodeInfo",
-	"phase":            "Description.Node.Status.Phase",
-	"pod_cidr":         "Description.Node.Spec.PodCIDR",
-	"pod_cidrs":        "Description.Node.Spec.PodCIDRs",
-	"provider_id":      "Description.Node.Spec.ProviderID",
-	"taints":           "Description.Node.Spec.Taints",
-	"title":            "Description.Node.Name",
-	"unschedulable":    "Description.Node.Spec.Unschedulable",
-	"volumes_attached": "Description.Node.Status.VolumesAttached",
-	"volumes_in_use":   "Description.Node.Status.VolumesInUse",
+var getKubernetesEndpointSliceFilters = map[string]string{
+	"address_type":            "Description.EndpointSlice.AddressType",
+	"endpoints":               "Description.EndpointSlice.Endpoints",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"ports":                   "Description.EndpointSlice.Ports",
+	"title":                   "Description.EndpointSlice.Name",
 }
 
-func GetKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesNode")
+func GetKubernetesEndpointSlice(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesEndpointSlice")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -4308,7 +4502,7 @@ func GetKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesNodePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNodeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesEndpointSlicePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesEndpointSliceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -4332,74 +4526,74 @@ func GetKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	return nil, nil
 }
 
-// ==========================  END: KubernetesNode =============================
-
-// ==========================  START: KubernetesPersistentVolume =============================
+// ==========================  END: KubernetesEndpointSlice =============================
 
-type KubernetesPersistentVolume struct {
-	ResourceID      string                                           `json:"resource_id"`
-	PlatformID      string                                           `json:"platform_id"`
-	Description     kubernetes.KubernetesPersistentVolumeDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                              `json:"metadata"`
-	DescribedBy     string                                           `json:"described_by"`
-	ResourceType    string                                           `json:"resource_type"`
-	IntegrationType string                                           `json:"integration_type"`
-	IntegrationID   string                                           `json:"integration_id"`
-}
+// ==========================  START: KubernetesEndpoint =============================
 
-type KubernetesPersistentVolumeHit struct {
-	ID      string                     `json:"_id"`
-	Score   float64                    `json:"_score"`
-	Index   string                     `json:"_index"`
-	Type    string                     `json:"_type"`
-	Version int64                      `json:"_version,omitempty"`
-	Source  KubernetesPersistentVolume `json:"_source"`
-	Sort    []interface{}              `json:"sort"`
+type KubernetesEndpoint struct {
+	ResourceID      string                                   `json:"resource_id"`
+	PlatformID      string                                   `json:"platform_id"`
+	Description     kubernetes.KubernetesEndpointDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                      `json:"metadata"`
+	DescribedBy     string                                   `json:"described_by"`
+	ResourceType    string                                   `json:"resource_type"`
+	IntegrationType string                                   `json:"integration_type"`
+	IntegrationID   string                                   `json:"integration_id"`
 }
 
-type KubernetesPersistentVolumeHits struct {
-	Total essdk.SearchTotal               `json:"total"`
-	Hits  []KubernetesPersistentVolumeHit `json:"hits"`
+type KubernetesEndpointHit struct {
+	ID      string             `json:"_id"`
+	Score   float64            `json:"_score"`
+	Index   string             `json:"_index"`
+	Type    string             `json:"_type"`
+	Version int64              `json:"_version,omitempty"`
+	Source  KubernetesEndpoint `json:"_source"`
+	Sort    []interface{}      `json:"sort"`
 }
 
-type KubernetesPersistentVolumeSearchResponse struct {
-	PitID string                         `json:"pit_id"`
-	Hits  KubernetesPersistentVolumeHits `json:"hits"`
+type KubernetesEndpointHits struct {
+	Total essdk.SearchTotal       `json:"total"`
+	Hits  []KubernetesEndpointHit `json:"hits"`
 }
 
-type KubernetesPersistentVolumePaginator struct {
+type KubernetesEndpointSearchResponse struct {
+	PitID string                 `json:"pit_id"`
+	Hits  KubernetesEndpointHits `json:"hits"`
+}
+
+type KubernetesEndpointPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesPersistentVolumePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPersistentVolumePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_persistentvolume", filters, limit)
+func (k Client) NewKubernetesEndpointPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesEndpointPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_endpoint", filters, limit)
 	if err != nil {
-		return KubernetesPersistentVolumePaginator{}, err
+		return KubernetesEndpointPaginator{}, err
 	}
 
-	p := KubernetesPersistentVolumePaginator{
+	p := KubernetesEndpointPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesPersistentVolumePaginator) HasNext() bool {
+func (p KubernetesEndpointPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesPersistentVolumePaginator) Close(ctx context.Context) error {
+func (p KubernetesEndpointPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesPersistentVolumePaginator) NextPage(ctx context.Context) ([]KubernetesPersistentVolume, error) {
-	var response KubernetesPersistentVolumeSearchResponse
+func (p KubernetesEndpointPaginator) NextPage(ctx context.Context) ([]KubernetesEndpoint, error) {
+	var response KubernetesEndpointSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesPersistentVolume
+	var values []KubernetesEndpoint
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -4414,66 +4608,56 @@ func (p KubernetesPersistentVolumePaginator) NextPage(ctx context.Context) ([]Ku
 	return values, nil
 }
 
-var listKubernetesPersistentVolumeFilters = map[string]string{
-	"access_modes":                     "Description.PV.Spec.AccessModes",
-	"capacity":                         "Description.PV.Spec.Capacity",
-	"claim_ref":                        "Description.PV.Spec.ClaimRef",
-	"message":                          "Description.PV.Status.Message",
-	"mount_options":                    "Description.PV.Spec.MountOptions",
-	"node_affinity":                    "Description.PV.Spec.NodeAffinity",
-	"persistent_volume_reclaim_policy": "Description.PV.Spec.PersistentVolumeReclaimPolicy",
-	"persistent_volume_source":         "Description.PV.Spec.PersistentVolumeSource",
-	"phase":                            "Description.PV.Status.Phase",
-	"reason":                           "Description.PV.Status.Reason",
-	"storage_class":                    "Description.PV.Spec.StorageClassName",
-	"title":                            "Description.PV.Name",
-	"volume_mode":                      "Description.PV.Spec.VolumeMode",
+var listKubernetesEndpointFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"subsets":                 "Description.Endpoint.Subsets",
+	"title":                   "Description.Endpoint.Name",
 }
 
-func ListKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesPersistentVolume")
+func ListKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesEndpoint")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpoint NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpoint NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpoint GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpoint GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpoint GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesPersistentVolumePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPersistentVolumeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesEndpointPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesEndpointFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume NewKubernetesPersistentVolumePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEndpoint NewKubernetesEndpointPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesPersistentVolume paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesEndpoint paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -4490,24 +4674,16 @@ func ListKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _
 	return nil, nil
 }
 
-var getKubernetesPersistentVolumeFilters = map[string]string{
-	"access_modes":                     "Description.PV.Spec.AccessModes",
-	"capacity":                         "Description.PV.Spec.Capacity",
-	"claim_ref":                        "Description.PV.Spec.ClaimRef",
-	"message":                          "Description.PV.Status.Message",
-	"mount_options":                    "Description.PV.Spec.MountOptions",
-	"node_affinity":                    "Description.PV.Spec.NodeAffinity",
-	"persistent_volume_reclaim_policy": "Description.PV.Spec.PersistentVolumeReclaimPolicy",
-	"persistent_volume_source":         "Description.PV.Spec.PersistentVolumeSource",
-	"phase":                            "Description.PV.Status.Phase",
-	"reason":                           "Description.PV.Status.Reason",
-	"storage_class":                    "Description.PV.Spec.StorageClassName",
-	"title":                            "Description.PV.Name",
-	"volume_mode":                      "Description.PV.Spec.VolumeMode",
+var getKubernetesEndpointFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"subsets":                 "Description.Endpoint.Subsets",
+	"title":                   "Description.Endpoint.Name",
 }
 
-func GetKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesPersistentVolume")
+func GetKubernetesEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesEndpoint")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -4535,7 +4711,7 @@ func GetKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _ *
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesPersistentVolumePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPersistentVolumeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesEndpointPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesEndpointFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -4559,74 +4735,74 @@ func GetKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _ *
 	return nil, nil
 }
 
-// ==========================  END: KubernetesPersistentVolume =============================
+// ==========================  END: KubernetesEndpoint =============================
 
-// ==========================  START: KubernetesPersistentVolumeClaim =============================
+// ==========================  START: KubernetesEvent =============================
 
-type KubernetesPersistentVolumeClaim struct {
-	ResourceID      string                                                `json:"resource_id"`
-	PlatformID      string                                                `json:"platform_id"`
-	Description     kubernetes.KubernetesPersistentVolumeClaimDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                                   `json:"metadata"`
-	DescribedBy     string                                                `json:"described_by"`
-	ResourceType    string                                                `json:"resource_type"`
-	IntegrationType string                                                `json:"integration_type"`
-	IntegrationID   string                                                `json:"integration_id"`
+type KubernetesEvent struct {
+	ResourceID      string                                `json:"resource_id"`
+	PlatformID      string                                `json:"platform_id"`
+	Description     kubernetes.KubernetesEventDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                   `json:"metadata"`
+	DescribedBy     string                                `json:"described_by"`
+	ResourceType    string                                `json:"resource_type"`
+	IntegrationType string                                `json:"integration_type"`
+	IntegrationID   string                                `json:"integration_id"`
 }
 
-type KubernetesPersistentVolumeClaimHit struct {
-	ID      string                          `json:"_id"`
-	Score   float64                         `json:"_score"`
-	Index   string                          `json:"_index"`
-	Type    string                          `json:"_type"`
-	Version int64                           `json:"_version,omitempty"`
-	Source  KubernetesPersistentVolumeClaim `json:"_source"`
-	Sort    []interface{}                   `json:"sort"`
+type KubernetesEventHit struct {
+	ID      string          `json:"_id"`
+	Score   float64         `json:"_score"`
+	Index   string          `json:"_index"`
+	Type    string          `json:"_type"`
+	Version int64           `json:"_version,omitempty"`
+	Source  KubernetesEvent `json:"_source"`
+	Sort    []interface{}   `json:"sort"`
 }
 
-type KubernetesPersistentVolumeClaimHits struct {
-	Total essdk.SearchTotal                    `json:"total"`
-	Hits  []KubernetesPersistentVolumeClaimHit `json:"hits"`
+type KubernetesEventHits struct {
+	Total essdk.SearchTotal    `json:"total"`
+	Hits  []KubernetesEventHit `json:"hits"`
 }
 
-type KubernetesPersistentVolumeClaimSearchResponse struct {
-	PitID string                              `json:"pit_id"`
-	Hits  KubernetesPersistentVolumeClaimHits `json:"hits"`
+type KubernetesEventSearchResponse struct {
+	PitID string              `json:"pit_id"`
+	Hits  KubernetesEventHits `json:"hits"`
 }
 
-type KubernetesPersistentVolumeClaimPaginator struct {
+type KubernetesEventPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesPersistentVolumeClaimPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPersistentVolumeClaimPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_persistentvolumeclaim", filters, limit)
+func (k Client) NewKubernetesEventPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesEventPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_event", filters, limit)
 	if err != nil {
-		return KubernetesPersistentVolumeClaimPaginator{}, err
+		return KubernetesEventPaginator{}, err
 	}
 
-	p := KubernetesPersistentVolumeClaimPaginator{
+	p := KubernetesEventPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesPersistentVolumeClaimPaginator) HasNext() bool {
+func (p KubernetesEventPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesPersistentVolumeClaimPaginator) Close(ctx context.Context) error {
+func (p KubernetesEventPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesPersistentVolumeClaimPaginator) NextPage(ctx context.Context) ([]KubernetesPersistentVolumeClaim, error) {
-	var response KubernetesPersistentVolumeClaimSearchResponse
+func (p KubernetesEventPaginator) NextPage(ctx context.Context) ([]KubernetesEvent, error) {
+	var response KubernetesEventSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesPersistentVolumeClaim
+	var values []KubernetesEvent
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -4641,65 +4817,65 @@ func (p KubernetesPersistentVolumeClaimPaginator) NextPage(ctx context.Context)
 	return values, nil
 }
 
-var listKubernetesPersistentVolumeClaimFilters = map[string]string{
-	"access_modes":        "Description.PVC.Spec.AccessModes",
-	"capacity":            "Description.PVC.Status.Capacity",
-	"conditions":          "Description.PVC.Status.Conditions",
-	"data_source":         "Description.PVC.Spec.DataSource",
-	"phase":               "Description.PVC.Status.Phase",
-	"resources":           "Description.PVC.Spec.Resources",
-	"selector":            "Description.PVC.Spec.Selector",
-	"status_access_modes": "Description.PVC.Status.AccessModes",
-	"storage_class":       "Description.PVC.Spec.StorageClassName",
-	"title":               "Description.PVC.Name",
-	"volume_mode":         "Description.PVC.Spec.VolumeMode",
-	"volume_name":         "Description.PVC.Spec.VolumeName",
+var listKubernetesEventFilters = map[string]string{
+	"action":                  "Description.Event.Action",
+	"count":                   "Description.Event.Count",
+	"involved_object":         "Description.Event.InvolvedObject",
+	"message":                 "Description.Event.Message",
+	"platform_integration_id": "IntegrationID",
+	"reason":                  "Description.Event.Reason",
+	"related":                 "Description.Event.Related",
+	"reporting_component":     "Description.Event.ReportingComponent",
+	"reporting_instance":      "Description.Event.ReportingInstance",
+	"series":                  "Description.Event.Series",
+	"source":                  "Description.Event.Source",
+	"type":                    "Description.Event.Type",
 }
 
-func ListKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesPersistentVolumeClaim")
+func ListKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesEvent")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEvent NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEvent NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEvent GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEvent GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEvent GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesPersistentVolumeClaimPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPersistentVolumeClaimFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesEventPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesEventFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim NewKubernetesPersistentVolumeClaimPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesEvent NewKubernetesEventPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesEvent paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -4716,23 +4892,25 @@ func ListKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryDat
 	return nil, nil
 }
 
-var getKubernetesPersistentVolumeClaimFilters = map[string]string{
-	"access_modes":        "Description.PVC.Spec.AccessModes",
-	"capacity":            "Description.PVC.Status.Capacity",
-	"conditions":          "Description.PVC.Status.Conditions",
-	"data_source":         "Description.PVC.Spec.DataSource",
-	"phase":               "Description.PVC.Status.Phase",
-	"resources":           "Description.PVC.Spec.Resources",
-	"selector":            "Description.PVC.Spec.Selector",
-	"status_access_modes": "Description.PVC.Status.AccessModes",
-	"storage_class":       "Description.PVC.Spec.StorageClassName",
-	"title":               "Description.PVC.Name",
-	"volume_mode":         "Description.PVC.Spec.VolumeMode",
-	"volume_name":         "Description.PVC.Spec.VolumeName",
+var getKubernetesEventFilters = map[string]string{
+	"action":                  "Description.Event.Action",
+	"count":                   "Description.Event.Count",
+	"involved_object":         "Description.Event.InvolvedObject",
+	"message":                 "Description.Event.Message",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"reason":                  "Description.Event.Reason",
+	"related":                 "Description.Event.Related",
+	"reporting_component":     "Description.Event.ReportingComponent",
+	"reporting_instance":      "Description.Event.ReportingInstance",
+	"series":                  "Description.Event.Series",
+	"source":                  "Description.Event.Source",
+	"type":                    "Description.Event.Type",
 }
 
-func GetKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesPersistentVolumeClaim")
+func GetKubernetesEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesEvent")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -4760,7 +4938,7 @@ func GetKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryData
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesPersistentVolumeClaimPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPersistentVolumeClaimFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesEventPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesEventFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -4784,74 +4962,74 @@ func GetKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryData
 	return nil, nil
 }
 
-// ==========================  END: KubernetesPersistentVolumeClaim =============================
+// ==========================  END: KubernetesEvent =============================
 
-// ==========================  START: KubernetesPod =============================
+// ==========================  START: KubernetesFlowSchema =============================
 
-type KubernetesPod struct {
-	ResourceID      string                              `json:"resource_id"`
-	PlatformID      string                              `json:"platform_id"`
-	Description     kubernetes.KubernetesPodDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                 `json:"metadata"`
-	DescribedBy     string                              `json:"described_by"`
-	ResourceType    string                              `json:"resource_type"`
-	IntegrationType string                              `json:"integration_type"`
-	IntegrationID   string                              `json:"integration_id"`
+type KubernetesFlowSchema struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesFlowSchemaDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
 }
 
-type KubernetesPodHit struct {
-	ID      string        `json:"_id"`
-	Score   float64       `json:"_score"`
-	Index   string        `json:"_index"`
-	Type    string        `json:"_type"`
-	Version int64         `json:"_version,omitempty"`
-	Source  KubernetesPod `json:"_source"`
-	Sort    []interface{} `json:"sort"`
+type KubernetesFlowSchemaHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesFlowSchema `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
 }
 
-type KubernetesPodHits struct {
-	Total essdk.SearchTotal  `json:"total"`
-	Hits  []KubernetesPodHit `json:"hits"`
+type KubernetesFlowSchemaHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesFlowSchemaHit `json:"hits"`
 }
 
-type KubernetesPodSearchResponse struct {
-	PitID string            `json:"pit_id"`
-	Hits  KubernetesPodHits `json:"hits"`
+type KubernetesFlowSchemaSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesFlowSchemaHits `json:"hits"`
 }
 
-type KubernetesPodPaginator struct {
+type KubernetesFlowSchemaPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesPodPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_pod", filters, limit)
+func (k Client) NewKubernetesFlowSchemaPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesFlowSchemaPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_flowschema", filters, limit)
 	if err != nil {
-		return KubernetesPodPaginator{}, err
+		return KubernetesFlowSchemaPaginator{}, err
 	}
 
-	p := KubernetesPodPaginator{
+	p := KubernetesFlowSchemaPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesPodPaginator) HasNext() bool {
+func (p KubernetesFlowSchemaPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesPodPaginator) Close(ctx context.Context) error {
+func (p KubernetesFlowSchemaPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesPodPaginator) NextPage(ctx context.Context) ([]KubernetesPod, error) {
-	var response KubernetesPodSearchResponse
+func (p KubernetesFlowSchemaPaginator) NextPage(ctx context.Context) ([]KubernetesFlowSchema, error) {
+	var response KubernetesFlowSchemaSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesPod
+	var values []KubernetesFlowSchema
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -4866,32 +5044,5725 @@ func (p KubernetesPodPaginator) NextPage(ctx context.Context) ([]KubernetesPod,
 	return values, nil
 }
 
-var listKubernetesPodFilters = map[string]string{
-	"active_deadline_seconds":          "Description.Pod.Spec.ActiveDeadlineSeconds",
-	"affinity":                         "Description.Pod.Spec.Affinity",
-	"automount_service_account_token":  "Description.Pod.Spec.AutomountServiceAccountToken",
-	"conditions":                       "Description.Pod.Status.Conditions",
-	"container_statuses":               "Description.Pod.Status.ContainerStatuses",
-	"containers":                       "Description.Pod.Spec.Containers",
-	"dns_config":                       "Description.Pod.Spec.DNSConfig",
-	"dns_policy":                       "Description.Pod.Spec.DNSPolicy",
-	"enable_service_links":             "Description.Pod.Spec.EnableServiceLinks",
-	"ephemeral_container_statuses":     "Description.Pod.Status.EphemeralContainerStatuses",
-	"ephemeral_containers":             "Description.Pod.Spec.EphemeralContainers",
-	"host_aliases":                     "Description.Pod.Spec.HostAliases",
-	"host_ip":                          "Description.Pod.Status.HostIP",
-	"host_ipc":                         "Description.Pod.Spec.HostIPC",
-	"host_network":                     "Description.Pod.Spec.HostNetwork",
-	"host_pid":                         "Description.Pod.Spec.HostPID",
-	"hostname":                         "Description.Pod.Spec.Hostname",
+var listKubernetesFlowSchemaFilters = map[string]string{
+	"conditions":                   "Description.FlowSchema.Status.Conditions",
+	"distinguisher_method":         "Description.FlowSchema.Spec.DistinguisherMethod",
+	"matching_precedence":          "Description.FlowSchema.Spec.MatchingPrecedence",
+	"platform_integration_id":      "IntegrationID",
+	"priority_level_configuration": "Description.FlowSchema.Spec.PriorityLevelConfiguration",
+	"rules":                        "Description.FlowSchema.Spec.Rules",
+	"title":                        "Description.MetaObject.Name",
+}
+
+func ListKubernetesFlowSchema(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesFlowSchema")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFlowSchema NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFlowSchema NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFlowSchema GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFlowSchema GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFlowSchema GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesFlowSchemaPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesFlowSchemaFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFlowSchema NewKubernetesFlowSchemaPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesFlowSchema paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesFlowSchemaFilters = map[string]string{
+	"conditions":                   "Description.FlowSchema.Status.Conditions",
+	"distinguisher_method":         "Description.FlowSchema.Spec.DistinguisherMethod",
+	"matching_precedence":          "Description.FlowSchema.Spec.MatchingPrecedence",
+	"name":                         "Description.MetaObject.Name",
+	"platform_integration_id":      "IntegrationID",
+	"priority_level_configuration": "Description.FlowSchema.Spec.PriorityLevelConfiguration",
+	"rules":                        "Description.FlowSchema.Spec.Rules",
+	"title":                        "Description.MetaObject.Name",
+}
+
+func GetKubernetesFlowSchema(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesFlowSchema")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesFlowSchemaPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesFlowSchemaFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesFlowSchema =============================
+
+// ==========================  START: KubernetesFluxGitRepository =============================
+
+type KubernetesFluxGitRepository struct {
+	ResourceID      string                                            `json:"resource_id"`
+	PlatformID      string                                            `json:"platform_id"`
+	Description     kubernetes.KubernetesFluxGitRepositoryDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                               `json:"metadata"`
+	DescribedBy     string                                            `json:"described_by"`
+	ResourceType    string                                            `json:"resource_type"`
+	IntegrationType string                                            `json:"integration_type"`
+	IntegrationID   string                                            `json:"integration_id"`
+}
+
+type KubernetesFluxGitRepositoryHit struct {
+	ID      string                      `json:"_id"`
+	Score   float64                     `json:"_score"`
+	Index   string                      `json:"_index"`
+	Type    string                      `json:"_type"`
+	Version int64                       `json:"_version,omitempty"`
+	Source  KubernetesFluxGitRepository `json:"_source"`
+	Sort    []interface{}               `json:"sort"`
+}
+
+type KubernetesFluxGitRepositoryHits struct {
+	Total essdk.SearchTotal                `json:"total"`
+	Hits  []KubernetesFluxGitRepositoryHit `json:"hits"`
+}
+
+type KubernetesFluxGitRepositorySearchResponse struct {
+	PitID string                          `json:"pit_id"`
+	Hits  KubernetesFluxGitRepositoryHits `json:"hits"`
+}
+
+type KubernetesFluxGitRepositoryPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesFluxGitRepositoryPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesFluxGitRepositoryPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_fluxgitrepository", filters, limit)
+	if err != nil {
+		return KubernetesFluxGitRepositoryPaginator{}, err
+	}
+
+	p := KubernetesFluxGitRepositoryPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesFluxGitRepositoryPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesFluxGitRepositoryPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesFluxGitRepositoryPaginator) NextPage(ctx context.Context) ([]KubernetesFluxGitRepository, error) {
+	var response KubernetesFluxGitRepositorySearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesFluxGitRepository
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesFluxGitRepositoryFilters = map[string]string{
+	"artifact":                "Description.FluxGitRepository.Status.Artifact",
+	"artifact_revision":       "Description.FluxGitRepository.Status.Artifact.Revision",
+	"conditions":              "Description.FluxGitRepository.Status.Conditions",
+	"interval":                "Description.FluxGitRepository.Spec.Interval",
+	"platform_integration_id": "IntegrationID",
+	"provider":                "Description.FluxGitRepository.Spec.Provider",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"reference":               "Description.FluxGitRepository.Spec.Reference",
+	"secret_ref":              "Description.FluxGitRepository.Spec.SecretRef",
+	"suspend":                 "Description.FluxGitRepository.Spec.Suspend",
+	"title":                   "Description.MetaObject.Name",
+	"url":                     "Description.FluxGitRepository.Spec.URL",
+}
+
+func ListKubernetesFluxGitRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesFluxGitRepository")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesFluxGitRepositoryPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesFluxGitRepositoryFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository NewKubernetesFluxGitRepositoryPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesFluxGitRepository paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesFluxGitRepositoryFilters = map[string]string{
+	"artifact":                "Description.FluxGitRepository.Status.Artifact",
+	"artifact_revision":       "Description.FluxGitRepository.Status.Artifact.Revision",
+	"conditions":              "Description.FluxGitRepository.Status.Conditions",
+	"interval":                "Description.FluxGitRepository.Spec.Interval",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"provider":                "Description.FluxGitRepository.Spec.Provider",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"reference":               "Description.FluxGitRepository.Spec.Reference",
+	"secret_ref":              "Description.FluxGitRepository.Spec.SecretRef",
+	"suspend":                 "Description.FluxGitRepository.Spec.Suspend",
+	"title":                   "Description.MetaObject.Name",
+	"url":                     "Description.FluxGitRepository.Spec.URL",
+}
+
+func GetKubernetesFluxGitRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesFluxGitRepository")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesFluxGitRepositoryPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesFluxGitRepositoryFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesFluxGitRepository =============================
+
+// ==========================  START: KubernetesFluxHelmRelease =============================
+
+type KubernetesFluxHelmRelease struct {
+	ResourceID      string                                          `json:"resource_id"`
+	PlatformID      string                                          `json:"platform_id"`
+	Description     kubernetes.KubernetesFluxHelmReleaseDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                             `json:"metadata"`
+	DescribedBy     string                                          `json:"described_by"`
+	ResourceType    string                                          `json:"resource_type"`
+	IntegrationType string                                          `json:"integration_type"`
+	IntegrationID   string                                          `json:"integration_id"`
+}
+
+type KubernetesFluxHelmReleaseHit struct {
+	ID      string                    `json:"_id"`
+	Score   float64                   `json:"_score"`
+	Index   string                    `json:"_index"`
+	Type    string                    `json:"_type"`
+	Version int64                     `json:"_version,omitempty"`
+	Source  KubernetesFluxHelmRelease `json:"_source"`
+	Sort    []interface{}             `json:"sort"`
+}
+
+type KubernetesFluxHelmReleaseHits struct {
+	Total essdk.SearchTotal              `json:"total"`
+	Hits  []KubernetesFluxHelmReleaseHit `json:"hits"`
+}
+
+type KubernetesFluxHelmReleaseSearchResponse struct {
+	PitID string                        `json:"pit_id"`
+	Hits  KubernetesFluxHelmReleaseHits `json:"hits"`
+}
+
+type KubernetesFluxHelmReleasePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesFluxHelmReleasePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesFluxHelmReleasePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_fluxhelmrelease", filters, limit)
+	if err != nil {
+		return KubernetesFluxHelmReleasePaginator{}, err
+	}
+
+	p := KubernetesFluxHelmReleasePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesFluxHelmReleasePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesFluxHelmReleasePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesFluxHelmReleasePaginator) NextPage(ctx context.Context) ([]KubernetesFluxHelmRelease, error) {
+	var response KubernetesFluxHelmReleaseSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesFluxHelmRelease
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesFluxHelmReleaseFilters = map[string]string{
+	"chart":                   "Description.FluxHelmRelease.Spec.Chart.Spec.Chart",
+	"chart_ref":               "Description.FluxHelmRelease.Spec.ChartRef",
+	"chart_version":           "Description.FluxHelmRelease.Spec.Chart.Spec.Version",
+	"conditions":              "Description.FluxHelmRelease.Status.Conditions",
+	"depends_on":              "Description.FluxHelmRelease.Spec.DependsOn",
+	"failures":                "Description.FluxHelmRelease.Status.Failures",
+	"history":                 "Description.FluxHelmRelease.Status.History",
+	"interval":                "Description.FluxHelmRelease.Spec.Interval",
+	"last_applied_revision":   "Description.FluxHelmRelease.Status.LastAppliedRevision",
+	"last_attempted_revision": "Description.FluxHelmRelease.Status.LastAttemptedRevision",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"release_name":            "Description.FluxHelmRelease.Spec.ReleaseName",
+	"service_account_name":    "Description.FluxHelmRelease.Spec.ServiceAccountName",
+	"source_ref":              "Description.FluxHelmRelease.Spec.Chart.Spec.SourceRef",
+	"suspend":                 "Description.FluxHelmRelease.Spec.Suspend",
+	"target_namespace":        "Description.FluxHelmRelease.Spec.TargetNamespace",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesFluxHelmRelease(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesFluxHelmRelease")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesFluxHelmReleasePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesFluxHelmReleaseFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease NewKubernetesFluxHelmReleasePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesFluxHelmRelease paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesFluxHelmReleaseFilters = map[string]string{
+	"chart":                   "Description.FluxHelmRelease.Spec.Chart.Spec.Chart",
+	"chart_ref":               "Description.FluxHelmRelease.Spec.ChartRef",
+	"chart_version":           "Description.FluxHelmRelease.Spec.Chart.Spec.Version",
+	"conditions":              "Description.FluxHelmRelease.Status.Conditions",
+	"depends_on":              "Description.FluxHelmRelease.Spec.DependsOn",
+	"failures":                "Description.FluxHelmRelease.Status.Failures",
+	"history":                 "Description.FluxHelmRelease.Status.History",
+	"interval":                "Description.FluxHelmRelease.Spec.Interval",
+	"last_applied_revision":   "Description.FluxHelmRelease.Status.LastAppliedRevision",
+	"last_attempted_revision": "Description.FluxHelmRelease.Status.LastAttemptedRevision",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"release_name":            "Description.FluxHelmRelease.Spec.ReleaseName",
+	"service_account_name":    "Description.FluxHelmRelease.Spec.ServiceAccountName",
+	"source_ref":              "Description.FluxHelmRelease.Spec.Chart.Spec.SourceRef",
+	"suspend":                 "Description.FluxHelmRelease.Spec.Suspend",
+	"target_namespace":        "Description.FluxHelmRelease.Spec.TargetNamespace",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesFluxHelmRelease(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesFluxHelmRelease")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesFluxHelmReleasePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesFluxHelmReleaseFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesFluxHelmRelease =============================
+
+// ==========================  START: KubernetesFluxHelmRepository =============================
+
+type KubernetesFluxHelmRepository struct {
+	ResourceID      string                                             `json:"resource_id"`
+	PlatformID      string                                             `json:"platform_id"`
+	Description     kubernetes.KubernetesFluxHelmRepositoryDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                `json:"metadata"`
+	DescribedBy     string                                             `json:"described_by"`
+	ResourceType    string                                             `json:"resource_type"`
+	IntegrationType string                                             `json:"integration_type"`
+	IntegrationID   string                                             `json:"integration_id"`
+}
+
+type KubernetesFluxHelmRepositoryHit struct {
+	ID      string                       `json:"_id"`
+	Score   float64                      `json:"_score"`
+	Index   string                       `json:"_index"`
+	Type    string                       `json:"_type"`
+	Version int64                        `json:"_version,omitempty"`
+	Source  KubernetesFluxHelmRepository `json:"_source"`
+	Sort    []interface{}                `json:"sort"`
+}
+
+type KubernetesFluxHelmRepositoryHits struct {
+	Total essdk.SearchTotal                 `json:"total"`
+	Hits  []KubernetesFluxHelmRepositoryHit `json:"hits"`
+}
+
+type KubernetesFluxHelmRepositorySearchResponse struct {
+	PitID string                           `json:"pit_id"`
+	Hits  KubernetesFluxHelmRepositoryHits `json:"hits"`
+}
+
+type KubernetesFluxHelmRepositoryPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesFluxHelmRepositoryPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesFluxHelmRepositoryPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_fluxhelmrepository", filters, limit)
+	if err != nil {
+		return KubernetesFluxHelmRepositoryPaginator{}, err
+	}
+
+	p := KubernetesFluxHelmRepositoryPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesFluxHelmRepositoryPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesFluxHelmRepositoryPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesFluxHelmRepositoryPaginator) NextPage(ctx context.Context) ([]KubernetesFluxHelmRepository, error) {
+	var response KubernetesFluxHelmRepositorySearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesFluxHelmRepository
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesFluxHelmRepositoryFilters = map[string]string{
+	"artifact":                "Description.FluxHelmRepository.Status.Artifact",
+	"artifact_revision":       "Description.FluxHelmRepository.Status.Artifact.Revision",
+	"conditions":              "Description.FluxHelmRepository.Status.Conditions",
+	"interval":                "Description.FluxHelmRepository.Spec.Interval",
+	"pass_credentials":        "Description.FluxHelmRepository.Spec.PassCredentials",
+	"platform_integration_id": "IntegrationID",
+	"provider":                "Description.FluxHelmRepository.Spec.Provider",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"secret_ref":              "Description.FluxHelmRepository.Spec.SecretRef",
+	"suspend":                 "Description.FluxHelmRepository.Spec.Suspend",
+	"title":                   "Description.MetaObject.Name",
+	"type":                    "Description.FluxHelmRepository.Spec.Type",
+	"url":                     "Description.FluxHelmRepository.Spec.URL",
+}
+
+func ListKubernetesFluxHelmRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesFluxHelmRepository")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesFluxHelmRepositoryPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesFluxHelmRepositoryFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository NewKubernetesFluxHelmRepositoryPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesFluxHelmRepository paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesFluxHelmRepositoryFilters = map[string]string{
+	"artifact":                "Description.FluxHelmRepository.Status.Artifact",
+	"artifact_revision":       "Description.FluxHelmRepository.Status.Artifact.Revision",
+	"conditions":              "Description.FluxHelmRepository.Status.Conditions",
+	"interval":                "Description.FluxHelmRepository.Spec.Interval",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"pass_credentials":        "Description.FluxHelmRepository.Spec.PassCredentials",
+	"platform_integration_id": "IntegrationID",
+	"provider":                "Description.FluxHelmRepository.Spec.Provider",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"secret_ref":              "Description.FluxHelmRepository.Spec.SecretRef",
+	"suspend":                 "Description.FluxHelmRepository.Spec.Suspend",
+	"title":                   "Description.MetaObject.Name",
+	"type":                    "Description.FluxHelmRepository.Spec.Type",
+	"url":                     "Description.FluxHelmRepository.Spec.URL",
+}
+
+func GetKubernetesFluxHelmRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesFluxHelmRepository")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesFluxHelmRepositoryPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesFluxHelmRepositoryFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesFluxHelmRepository =============================
+
+// ==========================  START: KubernetesFluxKustomization =============================
+
+type KubernetesFluxKustomization struct {
+	ResourceID      string                                            `json:"resource_id"`
+	PlatformID      string                                            `json:"platform_id"`
+	Description     kubernetes.KubernetesFluxKustomizationDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                               `json:"metadata"`
+	DescribedBy     string                                            `json:"described_by"`
+	ResourceType    string                                            `json:"resource_type"`
+	IntegrationType string                                            `json:"integration_type"`
+	IntegrationID   string                                            `json:"integration_id"`
+}
+
+type KubernetesFluxKustomizationHit struct {
+	ID      string                      `json:"_id"`
+	Score   float64                     `json:"_score"`
+	Index   string                      `json:"_index"`
+	Type    string                      `json:"_type"`
+	Version int64                       `json:"_version,omitempty"`
+	Source  KubernetesFluxKustomization `json:"_source"`
+	Sort    []interface{}               `json:"sort"`
+}
+
+type KubernetesFluxKustomizationHits struct {
+	Total essdk.SearchTotal                `json:"total"`
+	Hits  []KubernetesFluxKustomizationHit `json:"hits"`
+}
+
+type KubernetesFluxKustomizationSearchResponse struct {
+	PitID string                          `json:"pit_id"`
+	Hits  KubernetesFluxKustomizationHits `json:"hits"`
+}
+
+type KubernetesFluxKustomizationPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesFluxKustomizationPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesFluxKustomizationPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_fluxkustomization", filters, limit)
+	if err != nil {
+		return KubernetesFluxKustomizationPaginator{}, err
+	}
+
+	p := KubernetesFluxKustomizationPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesFluxKustomizationPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesFluxKustomizationPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesFluxKustomizationPaginator) NextPage(ctx context.Context) ([]KubernetesFluxKustomization, error) {
+	var response KubernetesFluxKustomizationSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesFluxKustomization
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesFluxKustomizationFilters = map[string]string{
+	"conditions":              "Description.FluxKustomization.Status.Conditions",
+	"depends_on":              "Description.FluxKustomization.Spec.DependsOn",
+	"interval":                "Description.FluxKustomization.Spec.Interval",
+	"last_applied_revision":   "Description.FluxKustomization.Status.LastAppliedRevision",
+	"last_attempted_revision": "Description.FluxKustomization.Status.LastAttemptedRevision",
+	"path":                    "Description.FluxKustomization.Spec.Path",
+	"platform_integration_id": "IntegrationID",
+	"prune":                   "Description.FluxKustomization.Spec.Prune",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"retry_interval":          "Description.FluxKustomization.Spec.RetryInterval",
+	"service_account_name":    "Description.FluxKustomization.Spec.ServiceAccountName",
+	"source_ref":              "Description.FluxKustomization.Spec.SourceRef",
+	"suspend":                 "Description.FluxKustomization.Spec.Suspend",
+	"target_namespace":        "Description.FluxKustomization.Spec.TargetNamespace",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesFluxKustomization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesFluxKustomization")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxKustomization NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxKustomization NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxKustomization GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxKustomization GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxKustomization GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesFluxKustomizationPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesFluxKustomizationFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxKustomization NewKubernetesFluxKustomizationPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesFluxKustomization paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesFluxKustomizationFilters = map[string]string{
+	"conditions":              "Description.FluxKustomization.Status.Conditions",
+	"depends_on":              "Description.FluxKustomization.Spec.DependsOn",
+	"interval":                "Description.FluxKustomization.Spec.Interval",
+	"last_applied_revision":   "Description.FluxKustomization.Status.LastAppliedRevision",
+	"last_attempted_revision": "Description.FluxKustomization.Status.LastAttemptedRevision",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"path":                    "Description.FluxKustomization.Spec.Path",
+	"platform_integration_id": "IntegrationID",
+	"prune":                   "Description.FluxKustomization.Spec.Prune",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"retry_interval":          "Description.FluxKustomization.Spec.RetryInterval",
+	"service_account_name":    "Description.FluxKustomization.Spec.ServiceAccountName",
+	"source_ref":              "Description.FluxKustomization.Spec.SourceRef",
+	"suspend":                 "Description.FluxKustomization.Spec.Suspend",
+	"target_namespace":        "Description.FluxKustomization.Spec.TargetNamespace",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesFluxKustomization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesFluxKustomization")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesFluxKustomizationPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesFluxKustomizationFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesFluxKustomization =============================
+
+// ==========================  START: KubernetesFluxOCIRepository =============================
+
+type KubernetesFluxOCIRepository struct {
+	ResourceID      string                                            `json:"resource_id"`
+	PlatformID      string                                            `json:"platform_id"`
+	Description     kubernetes.KubernetesFluxOCIRepositoryDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                               `json:"metadata"`
+	DescribedBy     string                                            `json:"described_by"`
+	ResourceType    string                                            `json:"resource_type"`
+	IntegrationType string                                            `json:"integration_type"`
+	IntegrationID   string                                            `json:"integration_id"`
+}
+
+type KubernetesFluxOCIRepositoryHit struct {
+	ID      string                      `json:"_id"`
+	Score   float64                     `json:"_score"`
+	Index   string                      `json:"_index"`
+	Type    string                      `json:"_type"`
+	Version int64                       `json:"_version,omitempty"`
+	Source  KubernetesFluxOCIRepository `json:"_source"`
+	Sort    []interface{}               `json:"sort"`
+}
+
+type KubernetesFluxOCIRepositoryHits struct {
+	Total essdk.SearchTotal                `json:"total"`
+	Hits  []KubernetesFluxOCIRepositoryHit `json:"hits"`
+}
+
+type KubernetesFluxOCIRepositorySearchResponse struct {
+	PitID string                          `json:"pit_id"`
+	Hits  KubernetesFluxOCIRepositoryHits `json:"hits"`
+}
+
+type KubernetesFluxOCIRepositoryPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesFluxOCIRepositoryPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesFluxOCIRepositoryPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_fluxocirepository", filters, limit)
+	if err != nil {
+		return KubernetesFluxOCIRepositoryPaginator{}, err
+	}
+
+	p := KubernetesFluxOCIRepositoryPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesFluxOCIRepositoryPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesFluxOCIRepositoryPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesFluxOCIRepositoryPaginator) NextPage(ctx context.Context) ([]KubernetesFluxOCIRepository, error) {
+	var response KubernetesFluxOCIRepositorySearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesFluxOCIRepository
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesFluxOCIRepositoryFilters = map[string]string{
+	"artifact":                "Description.FluxOCIRepository.Status.Artifact",
+	"artifact_revision":       "Description.FluxOCIRepository.Status.Artifact.Revision",
+	"conditions":              "Description.FluxOCIRepository.Status.Conditions",
+	"insecure":                "Description.FluxOCIRepository.Spec.Insecure",
+	"interval":                "Description.FluxOCIRepository.Spec.Interval",
+	"platform_integration_id": "IntegrationID",
+	"provider":                "Description.FluxOCIRepository.Spec.Provider",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"reference":               "Description.FluxOCIRepository.Spec.Reference",
+	"secret_ref":              "Description.FluxOCIRepository.Spec.SecretRef",
+	"suspend":                 "Description.FluxOCIRepository.Spec.Suspend",
+	"title":                   "Description.MetaObject.Name",
+	"url":                     "Description.FluxOCIRepository.Spec.URL",
+}
+
+func ListKubernetesFluxOCIRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesFluxOCIRepository")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesFluxOCIRepositoryPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesFluxOCIRepositoryFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository NewKubernetesFluxOCIRepositoryPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesFluxOCIRepository paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesFluxOCIRepositoryFilters = map[string]string{
+	"artifact":                "Description.FluxOCIRepository.Status.Artifact",
+	"artifact_revision":       "Description.FluxOCIRepository.Status.Artifact.Revision",
+	"conditions":              "Description.FluxOCIRepository.Status.Conditions",
+	"insecure":                "Description.FluxOCIRepository.Spec.Insecure",
+	"interval":                "Description.FluxOCIRepository.Spec.Interval",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"provider":                "Description.FluxOCIRepository.Spec.Provider",
+	"ready":                   "Description.Ready",
+	"ready_condition":         "Description.ReadyCondition",
+	"reference":               "Description.FluxOCIRepository.Spec.Reference",
+	"secret_ref":              "Description.FluxOCIRepository.Spec.SecretRef",
+	"suspend":                 "Description.FluxOCIRepository.Spec.Suspend",
+	"title":                   "Description.MetaObject.Name",
+	"url":                     "Description.FluxOCIRepository.Spec.URL",
+}
+
+func GetKubernetesFluxOCIRepository(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesFluxOCIRepository")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesFluxOCIRepositoryPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesFluxOCIRepositoryFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesFluxOCIRepository =============================
+
+// ==========================  START: KubernetesGateway =============================
+
+type KubernetesGateway struct {
+	ResourceID      string                                  `json:"resource_id"`
+	PlatformID      string                                  `json:"platform_id"`
+	Description     kubernetes.KubernetesGatewayDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                     `json:"metadata"`
+	DescribedBy     string                                  `json:"described_by"`
+	ResourceType    string                                  `json:"resource_type"`
+	IntegrationType string                                  `json:"integration_type"`
+	IntegrationID   string                                  `json:"integration_id"`
+}
+
+type KubernetesGatewayHit struct {
+	ID      string            `json:"_id"`
+	Score   float64           `json:"_score"`
+	Index   string            `json:"_index"`
+	Type    string            `json:"_type"`
+	Version int64             `json:"_version,omitempty"`
+	Source  KubernetesGateway `json:"_source"`
+	Sort    []interface{}     `json:"sort"`
+}
+
+type KubernetesGatewayHits struct {
+	Total essdk.SearchTotal      `json:"total"`
+	Hits  []KubernetesGatewayHit `json:"hits"`
+}
+
+type KubernetesGatewaySearchResponse struct {
+	PitID string                `json:"pit_id"`
+	Hits  KubernetesGatewayHits `json:"hits"`
+}
+
+type KubernetesGatewayPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesGatewayPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesGatewayPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_gateway", filters, limit)
+	if err != nil {
+		return KubernetesGatewayPaginator{}, err
+	}
+
+	p := KubernetesGatewayPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesGatewayPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesGatewayPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesGatewayPaginator) NextPage(ctx context.Context) ([]KubernetesGateway, error) {
+	var response KubernetesGatewaySearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesGateway
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesGatewayFilters = map[string]string{
+	"addresses":               "Description.Gateway.Spec.Addresses",
+	"conditions":              "Description.Gateway.Status.Conditions",
+	"gateway_class_name":      "Description.Gateway.Spec.GatewayClassName",
+	"listener_statuses":       "Description.Gateway.Status.Listeners",
+	"listeners":               "Description.Gateway.Spec.Listeners",
+	"platform_integration_id": "IntegrationID",
+	"status_addresses":        "Description.Gateway.Status.Addresses",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesGateway")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGateway NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGateway NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGateway GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGateway GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGateway GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesGatewayPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesGatewayFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGateway NewKubernetesGatewayPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesGateway paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesGatewayFilters = map[string]string{
+	"addresses":               "Description.Gateway.Spec.Addresses",
+	"conditions":              "Description.Gateway.Status.Conditions",
+	"gateway_class_name":      "Description.Gateway.Spec.GatewayClassName",
+	"listener_statuses":       "Description.Gateway.Status.Listeners",
+	"listeners":               "Description.Gateway.Spec.Listeners",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"status_addresses":        "Description.Gateway.Status.Addresses",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesGateway")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesGatewayPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesGatewayFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesGateway =============================
+
+// ==========================  START: KubernetesGatewayClass =============================
+
+type KubernetesGatewayClass struct {
+	ResourceID      string                                       `json:"resource_id"`
+	PlatformID      string                                       `json:"platform_id"`
+	Description     kubernetes.KubernetesGatewayClassDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                          `json:"metadata"`
+	DescribedBy     string                                       `json:"described_by"`
+	ResourceType    string                                       `json:"resource_type"`
+	IntegrationType string                                       `json:"integration_type"`
+	IntegrationID   string                                       `json:"integration_id"`
+}
+
+type KubernetesGatewayClassHit struct {
+	ID      string                 `json:"_id"`
+	Score   float64                `json:"_score"`
+	Index   string                 `json:"_index"`
+	Type    string                 `json:"_type"`
+	Version int64                  `json:"_version,omitempty"`
+	Source  KubernetesGatewayClass `json:"_source"`
+	Sort    []interface{}          `json:"sort"`
+}
+
+type KubernetesGatewayClassHits struct {
+	Total essdk.SearchTotal           `json:"total"`
+	Hits  []KubernetesGatewayClassHit `json:"hits"`
+}
+
+type KubernetesGatewayClassSearchResponse struct {
+	PitID string                     `json:"pit_id"`
+	Hits  KubernetesGatewayClassHits `json:"hits"`
+}
+
+type KubernetesGatewayClassPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesGatewayClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesGatewayClassPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_gatewayclass", filters, limit)
+	if err != nil {
+		return KubernetesGatewayClassPaginator{}, err
+	}
+
+	p := KubernetesGatewayClassPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesGatewayClassPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesGatewayClassPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesGatewayClassPaginator) NextPage(ctx context.Context) ([]KubernetesGatewayClass, error) {
+	var response KubernetesGatewayClassSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesGatewayClass
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesGatewayClassFilters = map[string]string{
+	"class_description":       "Description.GatewayClass.Spec.Description",
+	"conditions":              "Description.GatewayClass.Status.Conditions",
+	"controller_name":         "Description.GatewayClass.Spec.ControllerName",
+	"parameters_ref":          "Description.GatewayClass.Spec.ParametersRef",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesGatewayClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesGatewayClass")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGatewayClass NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGatewayClass NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGatewayClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGatewayClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGatewayClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesGatewayClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesGatewayClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGatewayClass NewKubernetesGatewayClassPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesGatewayClass paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesGatewayClassFilters = map[string]string{
+	"class_description":       "Description.GatewayClass.Spec.Description",
+	"conditions":              "Description.GatewayClass.Status.Conditions",
+	"controller_name":         "Description.GatewayClass.Spec.ControllerName",
+	"name":                    "Description.MetaObject.Name",
+	"parameters_ref":          "Description.GatewayClass.Spec.ParametersRef",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesGatewayClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesGatewayClass")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesGatewayClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesGatewayClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesGatewayClass =============================
+
+// ==========================  START: KubernetesGRPCRoute =============================
+
+type KubernetesGRPCRoute struct {
+	ResourceID      string                                    `json:"resource_id"`
+	PlatformID      string                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesGRPCRouteDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                       `json:"metadata"`
+	DescribedBy     string                                    `json:"described_by"`
+	ResourceType    string                                    `json:"resource_type"`
+	IntegrationType string                                    `json:"integration_type"`
+	IntegrationID   string                                    `json:"integration_id"`
+}
+
+type KubernetesGRPCRouteHit struct {
+	ID      string              `json:"_id"`
+	Score   float64             `json:"_score"`
+	Index   string              `json:"_index"`
+	Type    string              `json:"_type"`
+	Version int64               `json:"_version,omitempty"`
+	Source  KubernetesGRPCRoute `json:"_source"`
+	Sort    []interface{}       `json:"sort"`
+}
+
+type KubernetesGRPCRouteHits struct {
+	Total essdk.SearchTotal        `json:"total"`
+	Hits  []KubernetesGRPCRouteHit `json:"hits"`
+}
+
+type KubernetesGRPCRouteSearchResponse struct {
+	PitID string                  `json:"pit_id"`
+	Hits  KubernetesGRPCRouteHits `json:"hits"`
+}
+
+type KubernetesGRPCRoutePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesGRPCRoutePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesGRPCRoutePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_grpcroute", filters, limit)
+	if err != nil {
+		return KubernetesGRPCRoutePaginator{}, err
+	}
+
+	p := KubernetesGRPCRoutePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesGRPCRoutePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesGRPCRoutePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesGRPCRoutePaginator) NextPage(ctx context.Context) ([]KubernetesGRPCRoute, error) {
+	var response KubernetesGRPCRouteSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesGRPCRoute
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesGRPCRouteFilters = map[string]string{
+	"backend_refs":            "Description.BackendRefs",
+	"hostnames":               "Description.GRPCRoute.Spec.Hostnames",
+	"parent_refs":             "Description.GRPCRoute.Spec.ParentRefs",
+	"parent_statuses":         "Description.GRPCRoute.Status.Parents",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.GRPCRoute.Spec.Rules",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesGRPCRoute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesGRPCRoute")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGRPCRoute NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGRPCRoute NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGRPCRoute GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGRPCRoute GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGRPCRoute GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesGRPCRoutePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesGRPCRouteFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesGRPCRoute NewKubernetesGRPCRoutePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesGRPCRoute paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesGRPCRouteFilters = map[string]string{
+	"backend_refs":            "Description.BackendRefs",
+	"hostnames":               "Description.GRPCRoute.Spec.Hostnames",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"parent_refs":             "Description.GRPCRoute.Spec.ParentRefs",
+	"parent_statuses":         "Description.GRPCRoute.Status.Parents",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.GRPCRoute.Spec.Rules",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesGRPCRoute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesGRPCRoute")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesGRPCRoutePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesGRPCRouteFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesGRPCRoute =============================
+
+// ==========================  START: KubernetesHelmRelease =============================
+
+type KubernetesHelmRelease struct {
+	ResourceID      string                                      `json:"resource_id"`
+	PlatformID      string                                      `json:"platform_id"`
+	Description     kubernetes.KubernetesHelmReleaseDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                         `json:"metadata"`
+	DescribedBy     string                                      `json:"described_by"`
+	ResourceType    string                                      `json:"resource_type"`
+	IntegrationType string                                      `json:"integration_type"`
+	IntegrationID   string                                      `json:"integration_id"`
+}
+
+type KubernetesHelmReleaseHit struct {
+	ID      string                `json:"_id"`
+	Score   float64               `json:"_score"`
+	Index   string                `json:"_index"`
+	Type    string                `json:"_type"`
+	Version int64                 `json:"_version,omitempty"`
+	Source  KubernetesHelmRelease `json:"_source"`
+	Sort    []interface{}         `json:"sort"`
+}
+
+type KubernetesHelmReleaseHits struct {
+	Total essdk.SearchTotal          `json:"total"`
+	Hits  []KubernetesHelmReleaseHit `json:"hits"`
+}
+
+type KubernetesHelmReleaseSearchResponse struct {
+	PitID string                    `json:"pit_id"`
+	Hits  KubernetesHelmReleaseHits `json:"hits"`
+}
+
+type KubernetesHelmReleasePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesHelmReleasePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesHelmReleasePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_helmrelease", filters, limit)
+	if err != nil {
+		return KubernetesHelmReleasePaginator{}, err
+	}
+
+	p := KubernetesHelmReleasePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesHelmReleasePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesHelmReleasePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesHelmReleasePaginator) NextPage(ctx context.Context) ([]KubernetesHelmRelease, error) {
+	var response KubernetesHelmReleaseSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesHelmRelease
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesHelmReleaseFilters = map[string]string{
+	"app_version":    "Description.HelmRelease.AppVersion",
+	"chart_name":     "Description.HelmRelease.ChartName",
+	"chart_version":  "Description.HelmRelease.ChartVersion",
+	"description":    "Description.HelmRelease.Description",
+	"first_deployed": "Description.HelmRelease.FirstDeployed",
+	"labels":         "Description.HelmRelease.Labels",
+	"last_deployed":  "Description.HelmRelease.LastDeployed",
+	"name":           "Description.HelmRelease.Name",
+	"namespace":      "Description.HelmRelease.Namespace",
+	"revision":       "Description.HelmRelease.Revision",
+	"status":         "Description.HelmRelease.Status",
+	"title":          "Description.HelmRelease.Name",
+	"values":         "Description.HelmRelease.Values",
+}
+
+func ListKubernetesHelmRelease(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesHelmRelease")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHelmRelease NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHelmRelease NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHelmRelease GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHelmRelease GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHelmRelease GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesHelmReleasePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesHelmReleaseFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHelmRelease NewKubernetesHelmReleasePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesHelmRelease paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesHelmReleaseFilters = map[string]string{
+	"app_version":    "Description.HelmRelease.AppVersion",
+	"chart_name":     "Description.HelmRelease.ChartName",
+	"chart_version":  "Description.HelmRelease.ChartVersion",
+	"description":    "Description.HelmRelease.Description",
+	"first_deployed": "Description.HelmRelease.FirstDeployed",
+	"labels":         "Description.HelmRelease.Labels",
+	"last_deployed":  "Description.HelmRelease.LastDeployed",
+	"name":           "Description.HelmRelease.Name",
+	"namespace":      "Description.HelmRelease.Namespace",
+	"revision":       "Description.HelmRelease.Revision",
+	"status":         "Description.HelmRelease.Status",
+	"title":          "Description.HelmRelease.Name",
+	"values":         "Description.HelmRelease.Values",
+}
+
+func GetKubernetesHelmRelease(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesHelmRelease")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesHelmReleasePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesHelmReleaseFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesHelmRelease =============================
+
+// ==========================  START: KubernetesHTTPRoute =============================
+
+type KubernetesHTTPRoute struct {
+	ResourceID      string                                    `json:"resource_id"`
+	PlatformID      string                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesHTTPRouteDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                       `json:"metadata"`
+	DescribedBy     string                                    `json:"described_by"`
+	ResourceType    string                                    `json:"resource_type"`
+	IntegrationType string                                    `json:"integration_type"`
+	IntegrationID   string                                    `json:"integration_id"`
+}
+
+type KubernetesHTTPRouteHit struct {
+	ID      string              `json:"_id"`
+	Score   float64             `json:"_score"`
+	Index   string              `json:"_index"`
+	Type    string              `json:"_type"`
+	Version int64               `json:"_version,omitempty"`
+	Source  KubernetesHTTPRoute `json:"_source"`
+	Sort    []interface{}       `json:"sort"`
+}
+
+type KubernetesHTTPRouteHits struct {
+	Total essdk.SearchTotal        `json:"total"`
+	Hits  []KubernetesHTTPRouteHit `json:"hits"`
+}
+
+type KubernetesHTTPRouteSearchResponse struct {
+	PitID string                  `json:"pit_id"`
+	Hits  KubernetesHTTPRouteHits `json:"hits"`
+}
+
+type KubernetesHTTPRoutePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesHTTPRoutePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesHTTPRoutePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_httproute", filters, limit)
+	if err != nil {
+		return KubernetesHTTPRoutePaginator{}, err
+	}
+
+	p := KubernetesHTTPRoutePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesHTTPRoutePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesHTTPRoutePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesHTTPRoutePaginator) NextPage(ctx context.Context) ([]KubernetesHTTPRoute, error) {
+	var response KubernetesHTTPRouteSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesHTTPRoute
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesHTTPRouteFilters = map[string]string{
+	"backend_refs":            "Description.BackendRefs",
+	"hostnames":               "Description.HTTPRoute.Spec.Hostnames",
+	"parent_refs":             "Description.HTTPRoute.Spec.ParentRefs",
+	"parent_statuses":         "Description.HTTPRoute.Status.Parents",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.HTTPRoute.Spec.Rules",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesHTTPRoute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesHTTPRoute")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHTTPRoute NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHTTPRoute NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHTTPRoute GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHTTPRoute GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHTTPRoute GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesHTTPRoutePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesHTTPRouteFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHTTPRoute NewKubernetesHTTPRoutePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesHTTPRoute paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesHTTPRouteFilters = map[string]string{
+	"backend_refs":            "Description.BackendRefs",
+	"hostnames":               "Description.HTTPRoute.Spec.Hostnames",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"parent_refs":             "Description.HTTPRoute.Spec.ParentRefs",
+	"parent_statuses":         "Description.HTTPRoute.Status.Parents",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.HTTPRoute.Spec.Rules",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesHTTPRoute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesHTTPRoute")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesHTTPRoutePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesHTTPRouteFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesHTTPRoute =============================
+
+// ==========================  START: KubernetesHorizontalPodAutoscaler =============================
+
+type KubernetesHorizontalPodAutoscaler struct {
+	ResourceID      string                                                  `json:"resource_id"`
+	PlatformID      string                                                  `json:"platform_id"`
+	Description     kubernetes.KubernetesHorizontalPodAutoscalerDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                     `json:"metadata"`
+	DescribedBy     string                                                  `json:"described_by"`
+	ResourceType    string                                                  `json:"resource_type"`
+	IntegrationType string                                                  `json:"integration_type"`
+	IntegrationID   string                                                  `json:"integration_id"`
+}
+
+type KubernetesHorizontalPodAutoscalerHit struct {
+	ID      string                            `json:"_id"`
+	Score   float64                           `json:"_score"`
+	Index   string                            `json:"_index"`
+	Type    string                            `json:"_type"`
+	Version int64                             `json:"_version,omitempty"`
+	Source  KubernetesHorizontalPodAutoscaler `json:"_source"`
+	Sort    []interface{}                     `json:"sort"`
+}
+
+type KubernetesHorizontalPodAutoscalerHits struct {
+	Total essdk.SearchTotal                      `json:"total"`
+	Hits  []KubernetesHorizontalPodAutoscalerHit `json:"hits"`
+}
+
+type KubernetesHorizontalPodAutoscalerSearchResponse struct {
+	PitID string                                `json:"pit_id"`
+	Hits  KubernetesHorizontalPodAutoscalerHits `json:"hits"`
+}
+
+type KubernetesHorizontalPodAutoscalerPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesHorizontalPodAutoscalerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesHorizontalPodAutoscalerPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_horizontalpodautoscaler", filters, limit)
+	if err != nil {
+		return KubernetesHorizontalPodAutoscalerPaginator{}, err
+	}
+
+	p := KubernetesHorizontalPodAutoscalerPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesHorizontalPodAutoscalerPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesHorizontalPodAutoscalerPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesHorizontalPodAutoscalerPaginator) NextPage(ctx context.Context) ([]KubernetesHorizontalPodAutoscaler, error) {
+	var response KubernetesHorizontalPodAutoscalerSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesHorizontalPodAutoscaler
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesHorizontalPodAutoscalerFilters = map[string]string{
+	"conditions":              "Description.HorizontalPodAutoscaler.Status.Conditions",
+	"current_metrics":         "Description.HorizontalPodAutoscaler.Status.CurrentMetrics",
+	"current_replicas":        "Description.HorizontalPodAutoscaler.Status.CurrentReplicas",
+	"desired_replicas":        "Description.HorizontalPodAutoscaler.Status.DesiredReplicas",
+	"max_replicas":            "Description.HorizontalPodAutoscaler.Spec.MaxReplicas",
+	"metrics":                 "Description.HorizontalPodAutoscaler.Spec.Metrics",
+	"min_replicas":            "Description.HorizontalPodAutoscaler.Spec.MinReplicas",
+	"observed_generation":     "Description.HorizontalPodAutoscaler.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"scale_down_behavior":     "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleDown",
+	"scale_target_ref":        "Description.HorizontalPodAutoscaler.Spec.ScaleTargetRef",
+	"scale_up_behavior":       "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleUp",
+	"title":                   "Description.HorizontalPodAutoscaler.Name",
+}
+
+func ListKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesHorizontalPodAutoscaler")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesHorizontalPodAutoscalerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesHorizontalPodAutoscalerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler NewKubernetesHorizontalPodAutoscalerPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesHorizontalPodAutoscaler paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesHorizontalPodAutoscalerFilters = map[string]string{
+	"conditions":              "Description.HorizontalPodAutoscaler.Status.Conditions",
+	"current_metrics":         "Description.HorizontalPodAutoscaler.Status.CurrentMetrics",
+	"current_replicas":        "Description.HorizontalPodAutoscaler.Status.CurrentReplicas",
+	"desired_replicas":        "Description.HorizontalPodAutoscaler.Status.DesiredReplicas",
+	"max_replicas":            "Description.HorizontalPodAutoscaler.Spec.MaxReplicas",
+	"metrics":                 "Description.HorizontalPodAutoscaler.Spec.Metrics",
+	"min_replicas":            "Description.HorizontalPodAutoscaler.Spec.MinReplicas",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"observed_generation":     "Description.HorizontalPodAutoscaler.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"scale_down_behavior":     "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleDown",
+	"scale_target_ref":        "Description.HorizontalPodAutoscaler.Spec.ScaleTargetRef",
+	"scale_up_behavior":       "Description.HorizontalPodAutoscaler.Spec.Behavior.ScaleUp",
+	"title":                   "Description.HorizontalPodAutoscaler.Name",
+}
+
+func GetKubernetesHorizontalPodAutoscaler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesHorizontalPodAutoscaler")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesHorizontalPodAutoscalerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesHorizontalPodAutoscalerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesHorizontalPodAutoscaler =============================
+
+// ==========================  START: KubernetesIngress =============================
+
+type KubernetesIngress struct {
+	ResourceID      string                                  `json:"resource_id"`
+	PlatformID      string                                  `json:"platform_id"`
+	Description     kubernetes.KubernetesIngressDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                     `json:"metadata"`
+	DescribedBy     string                                  `json:"described_by"`
+	ResourceType    string                                  `json:"resource_type"`
+	IntegrationType string                                  `json:"integration_type"`
+	IntegrationID   string                                  `json:"integration_id"`
+}
+
+type KubernetesIngressHit struct {
+	ID      string            `json:"_id"`
+	Score   float64           `json:"_score"`
+	Index   string            `json:"_index"`
+	Type    string            `json:"_type"`
+	Version int64             `json:"_version,omitempty"`
+	Source  KubernetesIngress `json:"_source"`
+	Sort    []interface{}     `json:"sort"`
+}
+
+type KubernetesIngressHits struct {
+	Total essdk.SearchTotal      `json:"total"`
+	Hits  []KubernetesIngressHit `json:"hits"`
+}
+
+type KubernetesIngressSearchResponse struct {
+	PitID string                `json:"pit_id"`
+	Hits  KubernetesIngressHits `json:"hits"`
+}
+
+type KubernetesIngressPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesIngressPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesIngressPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_ingress", filters, limit)
+	if err != nil {
+		return KubernetesIngressPaginator{}, err
+	}
+
+	p := KubernetesIngressPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesIngressPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesIngressPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesIngressPaginator) NextPage(ctx context.Context) ([]KubernetesIngress, error) {
+	var response KubernetesIngressSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesIngress
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesIngressFilters = map[string]string{
+	"default_backend":         "Description.Ingress.Spec.DefaultBackend",
+	"ingress_class_name":      "Description.Ingress.Spec.IngressClassName",
+	"load_balancer":           "Description.Ingress.Status.LoadBalancer.Ingress",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.Ingress.Spec.Rules",
+	"title":                   "Description.Ingress.Name",
+	"tls":                     "Description.Ingress.Spec.TLS",
+}
+
+func ListKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesIngress")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngress NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngress NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngress GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngress GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngress GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesIngressPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesIngressFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngress NewKubernetesIngressPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesIngress paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesIngressFilters = map[string]string{
+	"default_backend":         "Description.Ingress.Spec.DefaultBackend",
+	"ingress_class_name":      "Description.Ingress.Spec.IngressClassName",
+	"load_balancer":           "Description.Ingress.Status.LoadBalancer.Ingress",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.Ingress.Spec.Rules",
+	"title":                   "Description.Ingress.Name",
+	"tls":                     "Description.Ingress.Spec.TLS",
+}
+
+func GetKubernetesIngress(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesIngress")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesIngressPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesIngressFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesIngress =============================
+
+// ==========================  START: KubernetesIngressClass =============================
+
+type KubernetesIngressClass struct {
+	ResourceID      string                                       `json:"resource_id"`
+	PlatformID      string                                       `json:"platform_id"`
+	Description     kubernetes.KubernetesIngressClassDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                          `json:"metadata"`
+	DescribedBy     string                                       `json:"described_by"`
+	ResourceType    string                                       `json:"resource_type"`
+	IntegrationType string                                       `json:"integration_type"`
+	IntegrationID   string                                       `json:"integration_id"`
+}
+
+type KubernetesIngressClassHit struct {
+	ID      string                 `json:"_id"`
+	Score   float64                `json:"_score"`
+	Index   string                 `json:"_index"`
+	Type    string                 `json:"_type"`
+	Version int64                  `json:"_version,omitempty"`
+	Source  KubernetesIngressClass `json:"_source"`
+	Sort    []interface{}          `json:"sort"`
+}
+
+type KubernetesIngressClassHits struct {
+	Total essdk.SearchTotal           `json:"total"`
+	Hits  []KubernetesIngressClassHit `json:"hits"`
+}
+
+type KubernetesIngressClassSearchResponse struct {
+	PitID string                     `json:"pit_id"`
+	Hits  KubernetesIngressClassHits `json:"hits"`
+}
+
+type KubernetesIngressClassPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesIngressClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesIngressClassPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_ingressclass", filters, limit)
+	if err != nil {
+		return KubernetesIngressClassPaginator{}, err
+	}
+
+	p := KubernetesIngressClassPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesIngressClassPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesIngressClassPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesIngressClassPaginator) NextPage(ctx context.Context) ([]KubernetesIngressClass, error) {
+	var response KubernetesIngressClassSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesIngressClass
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesIngressClassFilters = map[string]string{
+	"controller":              "Description.IngressClass.Spec.Controller",
+	"parameters":              "Description.IngressClass.Spec.Parameters",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesIngressClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesIngressClass")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngressClass NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngressClass NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngressClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngressClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngressClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesIngressClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesIngressClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIngressClass NewKubernetesIngressClassPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesIngressClass paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesIngressClassFilters = map[string]string{
+	"controller":              "Description.IngressClass.Spec.Controller",
+	"name":                    "Description.MetaObject.Name",
+	"parameters":              "Description.IngressClass.Spec.Parameters",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesIngressClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesIngressClass")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesIngressClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesIngressClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesIngressClass =============================
+
+// ==========================  START: KubernetesIssuer =============================
+
+type KubernetesIssuer struct {
+	ResourceID      string                                 `json:"resource_id"`
+	PlatformID      string                                 `json:"platform_id"`
+	Description     kubernetes.KubernetesIssuerDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                    `json:"metadata"`
+	DescribedBy     string                                 `json:"described_by"`
+	ResourceType    string                                 `json:"resource_type"`
+	IntegrationType string                                 `json:"integration_type"`
+	IntegrationID   string                                 `json:"integration_id"`
+}
+
+type KubernetesIssuerHit struct {
+	ID      string           `json:"_id"`
+	Score   float64          `json:"_score"`
+	Index   string           `json:"_index"`
+	Type    string           `json:"_type"`
+	Version int64            `json:"_version,omitempty"`
+	Source  KubernetesIssuer `json:"_source"`
+	Sort    []interface{}    `json:"sort"`
+}
+
+type KubernetesIssuerHits struct {
+	Total essdk.SearchTotal     `json:"total"`
+	Hits  []KubernetesIssuerHit `json:"hits"`
+}
+
+type KubernetesIssuerSearchResponse struct {
+	PitID string               `json:"pit_id"`
+	Hits  KubernetesIssuerHits `json:"hits"`
+}
+
+type KubernetesIssuerPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesIssuerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesIssuerPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_issuer", filters, limit)
+	if err != nil {
+		return KubernetesIssuerPaginator{}, err
+	}
+
+	p := KubernetesIssuerPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesIssuerPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesIssuerPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesIssuerPaginator) NextPage(ctx context.Context) ([]KubernetesIssuer, error) {
+	var response KubernetesIssuerSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesIssuer
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesIssuerFilters = map[string]string{
+	"acme":                    "Description.Issuer.Spec.ACME",
+	"acme_status":             "Description.Issuer.Status.ACME",
+	"ca":                      "Description.Issuer.Spec.CA",
+	"conditions":              "Description.Issuer.Status.Conditions",
+	"issuer_type":             "Description.IssuerType",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_message":           "Description.ReadyCondition.Message",
+	"ready_reason":            "Description.ReadyCondition.Reason",
+	"self_signed":             "Description.Issuer.Spec.SelfSigned",
+	"title":                   "Description.MetaObject.Name",
+	"vault":                   "Description.Issuer.Spec.Vault",
+	"venafi":                  "Description.Issuer.Spec.Venafi",
+}
+
+func ListKubernetesIssuer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesIssuer")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIssuer NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIssuer NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIssuer GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIssuer GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIssuer GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesIssuerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesIssuerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesIssuer NewKubernetesIssuerPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesIssuer paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesIssuerFilters = map[string]string{
+	"acme":                    "Description.Issuer.Spec.ACME",
+	"acme_status":             "Description.Issuer.Status.ACME",
+	"ca":                      "Description.Issuer.Spec.CA",
+	"conditions":              "Description.Issuer.Status.Conditions",
+	"issuer_type":             "Description.IssuerType",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"ready":                   "Description.Ready",
+	"ready_message":           "Description.ReadyCondition.Message",
+	"ready_reason":            "Description.ReadyCondition.Reason",
+	"self_signed":             "Description.Issuer.Spec.SelfSigned",
+	"title":                   "Description.MetaObject.Name",
+	"vault":                   "Description.Issuer.Spec.Vault",
+	"venafi":                  "Description.Issuer.Spec.Venafi",
+}
+
+func GetKubernetesIssuer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesIssuer")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesIssuerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesIssuerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesIssuer =============================
+
+// ==========================  START: KubernetesJob =============================
+
+type KubernetesJob struct {
+	ResourceID      string                              `json:"resource_id"`
+	PlatformID      string                              `json:"platform_id"`
+	Description     kubernetes.KubernetesJobDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                 `json:"metadata"`
+	DescribedBy     string                              `json:"described_by"`
+	ResourceType    string                              `json:"resource_type"`
+	IntegrationType string                              `json:"integration_type"`
+	IntegrationID   string                              `json:"integration_id"`
+}
+
+type KubernetesJobHit struct {
+	ID      string        `json:"_id"`
+	Score   float64       `json:"_score"`
+	Index   string        `json:"_index"`
+	Type    string        `json:"_type"`
+	Version int64         `json:"_version,omitempty"`
+	Source  KubernetesJob `json:"_source"`
+	Sort    []interface{} `json:"sort"`
+}
+
+type KubernetesJobHits struct {
+	Total essdk.SearchTotal  `json:"total"`
+	Hits  []KubernetesJobHit `json:"hits"`
+}
+
+type KubernetesJobSearchResponse struct {
+	PitID string            `json:"pit_id"`
+	Hits  KubernetesJobHits `json:"hits"`
+}
+
+type KubernetesJobPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesJobPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesJobPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_job", filters, limit)
+	if err != nil {
+		return KubernetesJobPaginator{}, err
+	}
+
+	p := KubernetesJobPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesJobPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesJobPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesJobPaginator) NextPage(ctx context.Context) ([]KubernetesJob, error) {
+	var response KubernetesJobSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesJob
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesJobFilters = map[string]string{
+	"active":                     "Description.Job.Status.Active",
+	"active_deadline_seconds":    "Description.Job.Spec.ActiveDeadlineSeconds",
+	"backoff_limit":              "Description.Job.Spec.BackoffLimit",
+	"completions":                "Description.Job.Spec.Completions",
+	"conditions":                 "Description.Job.Status.Conditions",
+	"failed":                     "Description.Job.Status.Failed",
+	"manual_selector":            "Description.Job.Spec.ManualSelector",
+	"parallelism":                "Description.Job.Spec.Parallelism",
+	"platform_integration_id":    "IntegrationID",
+	"selector":                   "Description.Job.Spec.Selector",
+	"selector_query":             "Description.LabelSelectorString",
+	"succeeded":                  "Description.Job.Status.Succeeded",
+	"template":                   "Description.Job.Spec.Template",
+	"title":                      "Description.Job.Name",
+	"ttl_seconds_after_finished": "Description.Job.Spec.TTLSecondsAfterFinished",
+}
+
+func ListKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesJob")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesJob NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesJob NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesJob GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesJobFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesJob NewKubernetesJobPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesJob paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesJobFilters = map[string]string{
+	"active":                     "Description.Job.Status.Active",
+	"active_deadline_seconds":    "Description.Job.Spec.ActiveDeadlineSeconds",
+	"backoff_limit":              "Description.Job.Spec.BackoffLimit",
+	"completions":                "Description.Job.Spec.Completions",
+	"conditions":                 "Description.Job.Status.Conditions",
+	"failed":                     "Description.Job.Status.Failed",
+	"manual_selector":            "Description.Job.Spec.ManualSelector",
+	"name":                       "Description.MetaObject.Name",
+	"namespace":                  "Description.MetaObject.Namespace",
+	"parallelism":                "Description.Job.Spec.Parallelism",
+	"platform_integration_id":    "IntegrationID",
+	"selector":                   "Description.Job.Spec.Selector",
+	"selector_query":             "Description.LabelSelectorString",
+	"succeeded":                  "Description.Job.Status.Succeeded",
+	"template":                   "Description.Job.Spec.Template",
+	"title":                      "Description.Job.Name",
+	"ttl_seconds_after_finished": "Description.Job.Spec.TTLSecondsAfterFinished",
+}
+
+func GetKubernetesJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesJob")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesJobPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesJobFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesJob =============================
+
+// ==========================  START: KubernetesLease =============================
+
+type KubernetesLease struct {
+	ResourceID      string                                `json:"resource_id"`
+	PlatformID      string                                `json:"platform_id"`
+	Description     kubernetes.KubernetesLeaseDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                   `json:"metadata"`
+	DescribedBy     string                                `json:"described_by"`
+	ResourceType    string                                `json:"resource_type"`
+	IntegrationType string                                `json:"integration_type"`
+	IntegrationID   string                                `json:"integration_id"`
+}
+
+type KubernetesLeaseHit struct {
+	ID      string          `json:"_id"`
+	Score   float64         `json:"_score"`
+	Index   string          `json:"_index"`
+	Type    string          `json:"_type"`
+	Version int64           `json:"_version,omitempty"`
+	Source  KubernetesLease `json:"_source"`
+	Sort    []interface{}   `json:"sort"`
+}
+
+type KubernetesLeaseHits struct {
+	Total essdk.SearchTotal    `json:"total"`
+	Hits  []KubernetesLeaseHit `json:"hits"`
+}
+
+type KubernetesLeaseSearchResponse struct {
+	PitID string              `json:"pit_id"`
+	Hits  KubernetesLeaseHits `json:"hits"`
+}
+
+type KubernetesLeasePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesLeasePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesLeasePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_lease", filters, limit)
+	if err != nil {
+		return KubernetesLeasePaginator{}, err
+	}
+
+	p := KubernetesLeasePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesLeasePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesLeasePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesLeasePaginator) NextPage(ctx context.Context) ([]KubernetesLease, error) {
+	var response KubernetesLeaseSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesLease
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesLeaseFilters = map[string]string{
+	"acquire_time":            "Description.Lease.Spec.AcquireTime",
+	"expires_at":              "Description.ExpiresAt",
+	"holder_identity":         "Description.Lease.Spec.HolderIdentity",
+	"lease_duration_seconds":  "Description.Lease.Spec.LeaseDurationSeconds",
+	"lease_transitions":       "Description.Lease.Spec.LeaseTransitions",
+	"platform_integration_id": "IntegrationID",
+	"preferred_holder":        "Description.Lease.Spec.PreferredHolder",
+	"renew_time":              "Description.Lease.Spec.RenewTime",
+	"stale":                   "Description.Stale",
+	"strategy":                "Description.Lease.Spec.Strategy",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesLease(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesLease")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLease NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLease NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLease GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLease GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLease GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesLeasePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesLeaseFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLease NewKubernetesLeasePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesLease paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesLeaseFilters = map[string]string{
+	"acquire_time":            "Description.Lease.Spec.AcquireTime",
+	"expires_at":              "Description.ExpiresAt",
+	"holder_identity":         "Description.Lease.Spec.HolderIdentity",
+	"lease_duration_seconds":  "Description.Lease.Spec.LeaseDurationSeconds",
+	"lease_transitions":       "Description.Lease.Spec.LeaseTransitions",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"preferred_holder":        "Description.Lease.Spec.PreferredHolder",
+	"renew_time":              "Description.Lease.Spec.RenewTime",
+	"stale":                   "Description.Stale",
+	"strategy":                "Description.Lease.Spec.Strategy",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesLease(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesLease")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesLeasePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesLeaseFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesLease =============================
+
+// ==========================  START: KubernetesLimitRange =============================
+
+type KubernetesLimitRange struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesLimitRangeDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
+}
+
+type KubernetesLimitRangeHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesLimitRange `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
+}
+
+type KubernetesLimitRangeHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesLimitRangeHit `json:"hits"`
+}
+
+type KubernetesLimitRangeSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesLimitRangeHits `json:"hits"`
+}
+
+type KubernetesLimitRangePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesLimitRangePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesLimitRangePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_limitrange", filters, limit)
+	if err != nil {
+		return KubernetesLimitRangePaginator{}, err
+	}
+
+	p := KubernetesLimitRangePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesLimitRangePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesLimitRangePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesLimitRangePaginator) NextPage(ctx context.Context) ([]KubernetesLimitRange, error) {
+	var response KubernetesLimitRangeSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesLimitRange
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesLimitRangeFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"spec_limits":             "Description.LimitRange.Spec.Limits",
+	"title":                   "Description.LimitRange.Name",
+}
+
+func ListKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesLimitRange")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLimitRange NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLimitRange NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLimitRange GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLimitRange GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLimitRange GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesLimitRangePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesLimitRangeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesLimitRange NewKubernetesLimitRangePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesLimitRange paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesLimitRangeFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"spec_limits":             "Description.LimitRange.Spec.Limits",
+	"title":                   "Description.LimitRange.Name",
+}
+
+func GetKubernetesLimitRange(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesLimitRange")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesLimitRangePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesLimitRangeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesLimitRange =============================
+
+// ==========================  START: KubernetesMutatingWebhookConfiguration =============================
+
+type KubernetesMutatingWebhookConfiguration struct {
+	ResourceID      string                                                       `json:"resource_id"`
+	PlatformID      string                                                       `json:"platform_id"`
+	Description     kubernetes.KubernetesMutatingWebhookConfigurationDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                          `json:"metadata"`
+	DescribedBy     string                                                       `json:"described_by"`
+	ResourceType    string                                                       `json:"resource_type"`
+	IntegrationType string                                                       `json:"integration_type"`
+	IntegrationID   string                                                       `json:"integration_id"`
+}
+
+type KubernetesMutatingWebhookConfigurationHit struct {
+	ID      string                                 `json:"_id"`
+	Score   float64                                `json:"_score"`
+	Index   string                                 `json:"_index"`
+	Type    string                                 `json:"_type"`
+	Version int64                                  `json:"_version,omitempty"`
+	Source  KubernetesMutatingWebhookConfiguration `json:"_source"`
+	Sort    []interface{}                          `json:"sort"`
+}
+
+type KubernetesMutatingWebhookConfigurationHits struct {
+	Total essdk.SearchTotal                           `json:"total"`
+	Hits  []KubernetesMutatingWebhookConfigurationHit `json:"hits"`
+}
+
+type KubernetesMutatingWebhookConfigurationSearchResponse struct {
+	PitID string                                     `json:"pit_id"`
+	Hits  KubernetesMutatingWebhookConfigurationHits `json:"hits"`
+}
+
+type KubernetesMutatingWebhookConfigurationPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesMutatingWebhookConfigurationPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesMutatingWebhookConfigurationPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_mutatingwebhookconfiguration", filters, limit)
+	if err != nil {
+		return KubernetesMutatingWebhookConfigurationPaginator{}, err
+	}
+
+	p := KubernetesMutatingWebhookConfigurationPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesMutatingWebhookConfigurationPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesMutatingWebhookConfigurationPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesMutatingWebhookConfigurationPaginator) NextPage(ctx context.Context) ([]KubernetesMutatingWebhookConfiguration, error) {
+	var response KubernetesMutatingWebhookConfigurationSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesMutatingWebhookConfiguration
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesMutatingWebhookConfigurationFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"webhooks":                "Description.MutatingWebhookConfiguration.Webhooks",
+}
+
+func ListKubernetesMutatingWebhookConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesMutatingWebhookConfiguration")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesMutatingWebhookConfigurationPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesMutatingWebhookConfigurationFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration NewKubernetesMutatingWebhookConfigurationPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesMutatingWebhookConfiguration paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesMutatingWebhookConfigurationFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"webhooks":                "Description.MutatingWebhookConfiguration.Webhooks",
+}
+
+func GetKubernetesMutatingWebhookConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesMutatingWebhookConfiguration")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesMutatingWebhookConfigurationPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesMutatingWebhookConfigurationFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesMutatingWebhookConfiguration =============================
+
+// ==========================  START: KubernetesNamespace =============================
+
+type KubernetesNamespace struct {
+	ResourceID      string                                    `json:"resource_id"`
+	PlatformID      string                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesNamespaceDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                       `json:"metadata"`
+	DescribedBy     string                                    `json:"described_by"`
+	ResourceType    string                                    `json:"resource_type"`
+	IntegrationType string                                    `json:"integration_type"`
+	IntegrationID   string                                    `json:"integration_id"`
+}
+
+type KubernetesNamespaceHit struct {
+	ID      string              `json:"_id"`
+	Score   float64             `json:"_score"`
+	Index   string              `json:"_index"`
+	Type    string              `json:"_type"`
+	Version int64               `json:"_version,omitempty"`
+	Source  KubernetesNamespace `json:"_source"`
+	Sort    []interface{}       `json:"sort"`
+}
+
+type KubernetesNamespaceHits struct {
+	Total essdk.SearchTotal        `json:"total"`
+	Hits  []KubernetesNamespaceHit `json:"hits"`
+}
+
+type KubernetesNamespaceSearchResponse struct {
+	PitID string                  `json:"pit_id"`
+	Hits  KubernetesNamespaceHits `json:"hits"`
+}
+
+type KubernetesNamespacePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesNamespacePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNamespacePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_namespace", filters, limit)
+	if err != nil {
+		return KubernetesNamespacePaginator{}, err
+	}
+
+	p := KubernetesNamespacePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesNamespacePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesNamespacePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesNamespacePaginator) NextPage(ctx context.Context) ([]KubernetesNamespace, error) {
+	var response KubernetesNamespaceSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesNamespace
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesNamespaceFilters = map[string]string{
+	"conditions":              "Description.Namespace.Status.NamespaceCondition",
+	"phase":                   "Description.Namespace.Status.Phase",
+	"platform_integration_id": "IntegrationID",
+	"spec_finalizers":         "Description.Namespace.Spec.Finalizers",
+	"title":                   "Description.Namespace.Name",
+}
+
+func ListKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesNamespace")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNamespace NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNamespace NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNamespace GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNamespace GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNamespace GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesNamespacePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNamespaceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNamespace NewKubernetesNamespacePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesNamespace paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesNamespaceFilters = map[string]string{
+	"conditions":              "Description.Namespace.Status.NamespaceCondition",
+	"name":                    "Description.MetaObject.Name",
+	"phase":                   "Description.Namespace.Status.Phase",
+	"platform_integration_id": "IntegrationID",
+	"spec_finalizers":         "Description.Namespace.Spec.Finalizers",
+	"title":                   "Description.Namespace.Name",
+}
+
+func GetKubernetesNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesNamespace")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesNamespacePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNamespaceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesNamespace =============================
+
+// ==========================  START: KubernetesNetworkPolicy =============================
+
+type KubernetesNetworkPolicy struct {
+	ResourceID      string                                        `json:"resource_id"`
+	PlatformID      string                                        `json:"platform_id"`
+	Description     kubernetes.KubernetesNetworkPolicyDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                           `json:"metadata"`
+	DescribedBy     string                                        `json:"described_by"`
+	ResourceType    string                                        `json:"resource_type"`
+	IntegrationType string                                        `json:"integration_type"`
+	IntegrationID   string                                        `json:"integration_id"`
+}
+
+type KubernetesNetworkPolicyHit struct {
+	ID      string                  `json:"_id"`
+	Score   float64                 `json:"_score"`
+	Index   string                  `json:"_index"`
+	Type    string                  `json:"_type"`
+	Version int64                   `json:"_version,omitempty"`
+	Source  KubernetesNetworkPolicy `json:"_source"`
+	Sort    []interface{}           `json:"sort"`
+}
+
+type KubernetesNetworkPolicyHits struct {
+	Total essdk.SearchTotal            `json:"total"`
+	Hits  []KubernetesNetworkPolicyHit `json:"hits"`
+}
+
+type KubernetesNetworkPolicySearchResponse struct {
+	PitID string                      `json:"pit_id"`
+	Hits  KubernetesNetworkPolicyHits `json:"hits"`
+}
+
+type KubernetesNetworkPolicyPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesNetworkPolicyPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNetworkPolicyPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_networkpolicy", filters, limit)
+	if err != nil {
+		return KubernetesNetworkPolicyPaginator{}, err
+	}
+
+	p := KubernetesNetworkPolicyPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesNetworkPolicyPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesNetworkPolicyPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesNetworkPolicyPaginator) NextPage(ctx context.Context) ([]KubernetesNetworkPolicy, error) {
+	var response KubernetesNetworkPolicySearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesNetworkPolicy
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesNetworkPolicyFilters = map[string]string{
+	"egress":                  "Description.NetworkPolicy.Spec.Egress",
+	"ingress":                 "Description.NetworkPolicy.Spec.Ingress",
+	"platform_integration_id": "IntegrationID",
+	"pod_selector":            "Description.NetworkPolicy.Spec.PodSelector",
+	"policy_types":            "Description.NetworkPolicy.Spec.PolicyTypes",
+	"title":                   "Description.NetworkPolicy.Name",
+}
+
+func ListKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesNetworkPolicy")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesNetworkPolicyPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNetworkPolicyFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy NewKubernetesNetworkPolicyPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesNetworkPolicy paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesNetworkPolicyFilters = map[string]string{
+	"egress":                  "Description.NetworkPolicy.Spec.Egress",
+	"ingress":                 "Description.NetworkPolicy.Spec.Ingress",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"pod_selector":            "Description.NetworkPolicy.Spec.PodSelector",
+	"policy_types":            "Description.NetworkPolicy.Spec.PolicyTypes",
+	"title":                   "Description.NetworkPolicy.Name",
+}
+
+func GetKubernetesNetworkPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesNetworkPolicy")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesNetworkPolicyPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNetworkPolicyFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesNetworkPolicy =============================
+
+// ==========================  START: KubernetesNode =============================
+
+type KubernetesNode struct {
+	ResourceID      string                               `json:"resource_id"`
+	PlatformID      string                               `json:"platform_id"`
+	Description     kubernetes.KubernetesNodeDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                  `json:"metadata"`
+	DescribedBy     string                               `json:"described_by"`
+	ResourceType    string                               `json:"resource_type"`
+	IntegrationType string                               `json:"integration_type"`
+	IntegrationID   string                               `json:"integration_id"`
+}
+
+type KubernetesNodeHit struct {
+	ID      string         `json:"_id"`
+	Score   float64        `json:"_score"`
+	Index   string         `json:"_index"`
+	Type    string         `json:"_type"`
+	Version int64          `json:"_version,omitempty"`
+	Source  KubernetesNode `json:"_source"`
+	Sort    []interface{}  `json:"sort"`
+}
+
+type KubernetesNodeHits struct {
+	Total essdk.SearchTotal   `json:"total"`
+	Hits  []KubernetesNodeHit `json:"hits"`
+}
+
+type KubernetesNodeSearchResponse struct {
+	PitID string             `json:"pit_id"`
+	Hits  KubernetesNodeHits `json:"hits"`
+}
+
+type KubernetesNodePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesNodePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNodePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_node", filters, limit)
+	if err != nil {
+		return KubernetesNodePaginator{}, err
+	}
+
+	p := KubernetesNodePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesNodePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesNodePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesNodePaginator) NextPage(ctx context.Context) ([]KubernetesNode, error) {
+	var response KubernetesNodeSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesNode
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesNodeFilters = map[string]string{
+	"addresses":               "Description.Node.Status.Addresses",
+	"allocatable":             "Description.Node.Status.Allocatable",
+	"capacity":                "Description.Node.Status.Capacity",
+	"conditions":              "Description.Node.Status.Conditions",
+	"config":                  "Description.Node.Status.Config",
+	"config_source":           "Description.Node.Spec.ConfigSource",
+	"daemon_endpoints":        "Description.Node.Status.DaemonEndpoints",
+	"images":                  "Description.Node.Status.Images",
+	"node_info":               "Description.Node.Status.NodeInfo",
+	"phase":                   "Description.Node.Status.Phase",
+	"platform_integration_id": "IntegrationID",
+	"pod_cidr":                "Description.Node.Spec.PodCIDR",
+	"pod_cidrs":               "Description.Node.Spec.PodCIDRs",
+	"provider_id":             "Description.Node.Spec.ProviderID",
+	"taints":                  "Description.Node.Spec.Taints",
+	"title":                   "Description.Node.Name",
+	"unschedulable":           "Description.Node.Spec.Unschedulable",
+	"volumes_attached":        "Description.Node.Status.VolumesAttached",
+	"volumes_in_use":          "Description.Node.Status.VolumesInUse",
+}
+
+func ListKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesNode")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNode NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNode NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNode GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNode GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNode GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesNodePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNodeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNode NewKubernetesNodePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesNode paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesNodeFilters = map[string]string{
+	"addresses":               "Description.Node.Status.Addresses",
+	"allocatable":             "Description.Node.Status.Allocatable",
+	"capacity":                "Description.Node.Status.Capacity",
+	"conditions":              "Description.Node.Status.Conditions",
+	"config":                  "Description.Node.Status.Config",
+	"config_source":           "Description.Node.Spec.ConfigSource",
+	"daemon_endpoints":        "Description.Node.Status.DaemonEndpoints",
+	"images":                  "Description.Node.Status.Images",
+	"name":                    "Description.MetaObject.Name",
+	"node_info":               "Description.Node.Status.NodeInfo",
+	"phase":                   "Description.Node.Status.Phase",
+	"platform_integration_id": "IntegrationID",
+	"pod_cidr":                "Description.Node.Spec.PodCIDR",
+	"pod_cidrs":               "Description.Node.Spec.PodCIDRs",
+	"provider_id":             "Description.Node.Spec.ProviderID",
+	"taints":                  "Description.Node.Spec.Taints",
+	"title":                   "Description.Node.Name",
+	"unschedulable":           "Description.Node.Spec.Unschedulable",
+	"volumes_attached":        "Description.Node.Status.VolumesAttached",
+	"volumes_in_use":          "Description.Node.Status.VolumesInUse",
+}
+
+func GetKubernetesNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesNode")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesNodePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNodeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesNode =============================
+
+// ==========================  START: KubernetesNodeMetric =============================
+
+type KubernetesNodeMetric struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesNodeMetricDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
+}
+
+type KubernetesNodeMetricHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesNodeMetric `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
+}
+
+type KubernetesNodeMetricHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesNodeMetricHit `json:"hits"`
+}
+
+type KubernetesNodeMetricSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesNodeMetricHits `json:"hits"`
+}
+
+type KubernetesNodeMetricPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesNodeMetricPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesNodeMetricPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_nodemetric", filters, limit)
+	if err != nil {
+		return KubernetesNodeMetricPaginator{}, err
+	}
+
+	p := KubernetesNodeMetricPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesNodeMetricPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesNodeMetricPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesNodeMetricPaginator) NextPage(ctx context.Context) ([]KubernetesNodeMetric, error) {
+	var response KubernetesNodeMetricSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesNodeMetric
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesNodeMetricFilters = map[string]string{
+	"cpu_millicores":          "Description.NodeMetric.CPUMillicores",
+	"memory_bytes":            "Description.NodeMetric.MemoryBytes",
+	"platform_integration_id": "IntegrationID",
+	"timestamp":               "Description.NodeMetric.Timestamp",
+	"title":                   "Description.MetaObject.Name",
+	"usage":                   "Description.NodeMetric.Usage",
+	"window":                  "Description.NodeMetric.Window",
+}
+
+func ListKubernetesNodeMetric(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesNodeMetric")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNodeMetric NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNodeMetric NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNodeMetric GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNodeMetric GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNodeMetric GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesNodeMetricPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesNodeMetricFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesNodeMetric NewKubernetesNodeMetricPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesNodeMetric paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesNodeMetricFilters = map[string]string{
+	"cpu_millicores":          "Description.NodeMetric.CPUMillicores",
+	"memory_bytes":            "Description.NodeMetric.MemoryBytes",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"timestamp":               "Description.NodeMetric.Timestamp",
+	"title":                   "Description.MetaObject.Name",
+	"usage":                   "Description.NodeMetric.Usage",
+	"window":                  "Description.NodeMetric.Window",
+}
+
+func GetKubernetesNodeMetric(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesNodeMetric")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesNodeMetricPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesNodeMetricFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesNodeMetric =============================
+
+// ==========================  START: KubernetesPersistentVolume =============================
+
+type KubernetesPersistentVolume struct {
+	ResourceID      string                                           `json:"resource_id"`
+	PlatformID      string                                           `json:"platform_id"`
+	Description     kubernetes.KubernetesPersistentVolumeDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                              `json:"metadata"`
+	DescribedBy     string                                           `json:"described_by"`
+	ResourceType    string                                           `json:"resource_type"`
+	IntegrationType string                                           `json:"integration_type"`
+	IntegrationID   string                                           `json:"integration_id"`
+}
+
+type KubernetesPersistentVolumeHit struct {
+	ID      string                     `json:"_id"`
+	Score   float64                    `json:"_score"`
+	Index   string                     `json:"_index"`
+	Type    string                     `json:"_type"`
+	Version int64                      `json:"_version,omitempty"`
+	Source  KubernetesPersistentVolume `json:"_source"`
+	Sort    []interface{}              `json:"sort"`
+}
+
+type KubernetesPersistentVolumeHits struct {
+	Total essdk.SearchTotal               `json:"total"`
+	Hits  []KubernetesPersistentVolumeHit `json:"hits"`
+}
+
+type KubernetesPersistentVolumeSearchResponse struct {
+	PitID string                         `json:"pit_id"`
+	Hits  KubernetesPersistentVolumeHits `json:"hits"`
+}
+
+type KubernetesPersistentVolumePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPersistentVolumePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPersistentVolumePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_persistentvolume", filters, limit)
+	if err != nil {
+		return KubernetesPersistentVolumePaginator{}, err
+	}
+
+	p := KubernetesPersistentVolumePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPersistentVolumePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPersistentVolumePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPersistentVolumePaginator) NextPage(ctx context.Context) ([]KubernetesPersistentVolume, error) {
+	var response KubernetesPersistentVolumeSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPersistentVolume
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPersistentVolumeFilters = map[string]string{
+	"access_modes":                     "Description.PV.Spec.AccessModes",
+	"capacity":                         "Description.PV.Spec.Capacity",
+	"claim_ref":                        "Description.PV.Spec.ClaimRef",
+	"message":                          "Description.PV.Status.Message",
+	"mount_options":                    "Description.PV.Spec.MountOptions",
+	"node_affinity":                    "Description.PV.Spec.NodeAffinity",
+	"persistent_volume_reclaim_policy": "Description.PV.Spec.PersistentVolumeReclaimPolicy",
+	"persistent_volume_source":         "Description.PV.Spec.PersistentVolumeSource",
+	"phase":                            "Description.PV.Status.Phase",
+	"platform_integration_id":          "IntegrationID",
+	"reason":                           "Description.PV.Status.Reason",
+	"storage_class":                    "Description.PV.Spec.StorageClassName",
+	"title":                            "Description.PV.Name",
+	"volume_mode":                      "Description.PV.Spec.VolumeMode",
+}
+
+func ListKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPersistentVolume")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPersistentVolumePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPersistentVolumeFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolume NewKubernetesPersistentVolumePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPersistentVolume paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPersistentVolumeFilters = map[string]string{
+	"access_modes":                     "Description.PV.Spec.AccessModes",
+	"capacity":                         "Description.PV.Spec.Capacity",
+	"claim_ref":                        "Description.PV.Spec.ClaimRef",
+	"message":                          "Description.PV.Status.Message",
+	"mount_options":                    "Description.PV.Spec.MountOptions",
+	"name":                             "Description.MetaObject.Name",
+	"node_affinity":                    "Description.PV.Spec.NodeAffinity",
+	"persistent_volume_reclaim_policy": "Description.PV.Spec.PersistentVolumeReclaimPolicy",
+	"persistent_volume_source":         "Description.PV.Spec.PersistentVolumeSource",
+	"phase":                            "Description.PV.Status.Phase",
+	"platform_integration_id":          "IntegrationID",
+	"reason":                           "Description.PV.Status.Reason",
+	"storage_class":                    "Description.PV.Spec.StorageClassName",
+	"title":                            "Description.PV.Name",
+	"volume_mode":                      "Description.PV.Spec.VolumeMode",
+}
+
+func GetKubernetesPersistentVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPersistentVolume")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPersistentVolumePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPersistentVolumeFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPersistentVolume =============================
+
+// ==========================  START: KubernetesPersistentVolumeClaim =============================
+
+type KubernetesPersistentVolumeClaim struct {
+	ResourceID      string                                                `json:"resource_id"`
+	PlatformID      string                                                `json:"platform_id"`
+	Description     kubernetes.KubernetesPersistentVolumeClaimDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                   `json:"metadata"`
+	DescribedBy     string                                                `json:"described_by"`
+	ResourceType    string                                                `json:"resource_type"`
+	IntegrationType string                                                `json:"integration_type"`
+	IntegrationID   string                                                `json:"integration_id"`
+}
+
+type KubernetesPersistentVolumeClaimHit struct {
+	ID      string                          `json:"_id"`
+	Score   float64                         `json:"_score"`
+	Index   string                          `json:"_index"`
+	Type    string                          `json:"_type"`
+	Version int64                           `json:"_version,omitempty"`
+	Source  KubernetesPersistentVolumeClaim `json:"_source"`
+	Sort    []interface{}                   `json:"sort"`
+}
+
+type KubernetesPersistentVolumeClaimHits struct {
+	Total essdk.SearchTotal                    `json:"total"`
+	Hits  []KubernetesPersistentVolumeClaimHit `json:"hits"`
+}
+
+type KubernetesPersistentVolumeClaimSearchResponse struct {
+	PitID string                              `json:"pit_id"`
+	Hits  KubernetesPersistentVolumeClaimHits `json:"hits"`
+}
+
+type KubernetesPersistentVolumeClaimPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPersistentVolumeClaimPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPersistentVolumeClaimPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_persistentvolumeclaim", filters, limit)
+	if err != nil {
+		return KubernetesPersistentVolumeClaimPaginator{}, err
+	}
+
+	p := KubernetesPersistentVolumeClaimPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPersistentVolumeClaimPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPersistentVolumeClaimPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPersistentVolumeClaimPaginator) NextPage(ctx context.Context) ([]KubernetesPersistentVolumeClaim, error) {
+	var response KubernetesPersistentVolumeClaimSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPersistentVolumeClaim
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPersistentVolumeClaimFilters = map[string]string{
+	"access_modes":            "Description.PVC.Spec.AccessModes",
+	"capacity":                "Description.PVC.Status.Capacity",
+	"conditions":              "Description.PVC.Status.Conditions",
+	"data_source":             "Description.PVC.Spec.DataSource",
+	"phase":                   "Description.PVC.Status.Phase",
+	"platform_integration_id": "IntegrationID",
+	"resources":               "Description.PVC.Spec.Resources",
+	"selector":                "Description.PVC.Spec.Selector",
+	"status_access_modes":     "Description.PVC.Status.AccessModes",
+	"storage_class":           "Description.PVC.Spec.StorageClassName",
+	"title":                   "Description.PVC.Name",
+	"volume_mode":             "Description.PVC.Spec.VolumeMode",
+	"volume_name":             "Description.PVC.Spec.VolumeName",
+}
+
+func ListKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPersistentVolumeClaim")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPersistentVolumeClaimPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPersistentVolumeClaimFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim NewKubernetesPersistentVolumeClaimPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPersistentVolumeClaim paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPersistentVolumeClaimFilters = map[string]string{
+	"access_modes":            "Description.PVC.Spec.AccessModes",
+	"capacity":                "Description.PVC.Status.Capacity",
+	"conditions":              "Description.PVC.Status.Conditions",
+	"data_source":             "Description.PVC.Spec.DataSource",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"phase":                   "Description.PVC.Status.Phase",
+	"platform_integration_id": "IntegrationID",
+	"resources":               "Description.PVC.Spec.Resources",
+	"selector":                "Description.PVC.Spec.Selector",
+	"status_access_modes":     "Description.PVC.Status.AccessModes",
+	"storage_class":           "Description.PVC.Spec.StorageClassName",
+	"title":                   "Description.PVC.Name",
+	"volume_mode":             "Description.PVC.Spec.VolumeMode",
+	"volume_name":             "Description.PVC.Spec.VolumeName",
+}
+
+func GetKubernetesPersistentVolumeClaim(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPersistentVolumeClaim")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPersistentVolumeClaimPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPersistentVolumeClaimFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPersistentVolumeClaim =============================
+
+// ==========================  START: KubernetesPod =============================
+
+type KubernetesPod struct {
+	ResourceID      string                              `json:"resource_id"`
+	PlatformID      string                              `json:"platform_id"`
+	Description     kubernetes.KubernetesPodDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                 `json:"metadata"`
+	DescribedBy     string                              `json:"described_by"`
+	ResourceType    string                              `json:"resource_type"`
+	IntegrationType string                              `json:"integration_type"`
+	IntegrationID   string                              `json:"integration_id"`
+}
+
+type KubernetesPodHit struct {
+	ID      string        `json:"_id"`
+	Score   float64       `json:"_score"`
+	Index   string        `json:"_index"`
+	Type    string        `json:"_type"`
+	Version int64         `json:"_version,omitempty"`
+	Source  KubernetesPod `json:"_source"`
+	Sort    []interface{} `json:"sort"`
+}
+
+type KubernetesPodHits struct {
+	Total essdk.SearchTotal  `json:"total"`
+	Hits  []KubernetesPodHit `json:"hits"`
+}
+
+type KubernetesPodSearchResponse struct {
+	PitID string            `json:"pit_id"`
+	Hits  KubernetesPodHits `json:"hits"`
+}
+
+type KubernetesPodPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPodPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_pod", filters, limit)
+	if err != nil {
+		return KubernetesPodPaginator{}, err
+	}
+
+	p := KubernetesPodPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPodPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPodPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPodPaginator) NextPage(ctx context.Context) ([]KubernetesPod, error) {
+	var response KubernetesPodSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPod
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPodFilters = map[string]string{
+	"active_deadline_seconds":          "Description.Pod.Spec.ActiveDeadlineSeconds",
+	"affinity":                         "Description.Pod.Spec.Affinity",
+	"automount_service_account_token":  "Description.Pod.Spec.AutomountServiceAccountToken",
+	"conditions":                       "Description.Pod.Status.Conditions",
+	"container_statuses":               "Description.Pod.Status.ContainerStatuses",
+	"containers":                       "Description.Pod.Spec.Containers",
+	"dns_config":                       "Description.Pod.Spec.DNSConfig",
+	"dns_policy":                       "Description.Pod.Spec.DNSPolicy",
+	"enable_service_links":             "Description.Pod.Spec.EnableServiceLinks",
+	"ephemeral_container_statuses":     "Description.Pod.Status.EphemeralContainerStatuses",
+	"ephemeral_containers":             "Description.Pod.Spec.EphemeralContainers",
+	"host_aliases":                     "Description.Pod.Spec.HostAliases",
+	"host_ip":                          "Description.Pod.Status.HostIP",
+	"host_ipc":                         "Description.Pod.Spec.HostIPC",
+	"host_network":                     "Description.Pod.Spec.HostNetwork",
+	"host_pid":                         "Description.Pod.Spec.HostPID",
+	"hostname":                         "Description.Pod.Spec.Hostname",
+	"image_pull_secrets":               "Description.Pod.Spec.ImagePullSecrets",
+	"init_container_statuses":          "Description.Pod.Status.InitContainerStatuses",
+	"init_containers":                  "Description.Pod.Spec.InitContainers",
+	"node_name":                        "Description.Pod.Spec.NodeName",
+	"node_selector":                    "Description.Pod.Spec.NodeSelector",
+	"nominated_node_name":              "Description.Pod.Status.NominatedNodeName",
+	"overhead":                         "Description.Pod.Spec.Overhead",
+	"phase":                            "Description.Pod.Status.Phase",
+	"platform_integration_id":          "IntegrationID",
+	"pod_ip":                           "Description.Pod.Status.PodIP",
+	"pod_ips":                          "Description.Pod.Status.PodIPs",
+	"preemption_policy":                "Description.Pod.Spec.PreemptionPolicy",
+	"priority":                         "Description.Pod.Spec.Priority",
+	"priority_class_name":              "Description.Pod.Spec.PriorityClassName",
+	"qos_class":                        "Description.Pod.Status.QOSClass",
+	"readiness_gates":                  "Description.Pod.Spec.ReadinessGates",
+	"restart_policy":                   "Description.Pod.Spec.RestartPolicy",
+	"runtime_class_name":               "Description.Pod.Spec.RuntimeClassName",
+	"scheduler_name":                   "Description.Pod.Spec.SchedulerName",
+	"security_context":                 "Description.Pod.Spec.SecurityContext",
+	"service_account_name":             "Description.Pod.Spec.ServiceAccountName",
+	"set_hostname_as_fqdn":             "Description.Pod.Spec.SetHostnameAsFQDN",
+	"share_process_namespace":          "Description.Pod.Spec.ShareProcessNamespace",
+	"status_message":                   "Description.Pod.Status.Message",
+	"status_reason":                    "Description.Pod.Status.Reason",
+	"subdomain":                        "Description.Pod.Spec.Subdomain",
+	"termination_grace_period_seconds": "Description.Pod.Spec.TerminationGracePeriodSeconds",
+	"title":                            "Description.Pod.Name",
+	"tolerations":                      "Description.Pod.Spec.Tolerations",
+	"topology_spread_constraints":      "Description.Pod.Spec.TopologySpreadConstraints",
+	"volumes":                          "Description.Pod.Spec.Volumes",
+}
+
+func ListKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPod")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPod NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPod NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPod GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPod GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPod GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPodPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPod NewKubernetesPodPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPod paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPodFilters = map[string]string{
+	"active_deadline_seconds":          "Description.Pod.Spec.ActiveDeadlineSeconds",
+	"affinity":                         "Description.Pod.Spec.Affinity",
+	"automount_service_account_token":  "Description.Pod.Spec.AutomountServiceAccountToken",
+	"conditions":                       "Description.Pod.Status.Conditions",
+	"container_statuses":               "Description.Pod.Status.ContainerStatuses",
+	"containers":                       "Description.Pod.Spec.Containers",
+	"dns_config":                       "Description.Pod.Spec.DNSConfig",
+	"dns_policy":                       "Description.Pod.Spec.DNSPolicy",
+	"enable_service_links":             "Description.Pod.Spec.EnableServiceLinks",
+	"ephemeral_container_statuses":     "Description.Pod.Status.EphemeralContainerStatuses",
+	"ephemeral_containers":             "Description.Pod.Spec.EphemeralContainers",
+	"host_aliases":                     "Description.Pod.Spec.HostAliases",
+	"host_ip":                          "Description.Pod.Status.HostIP",
+	"host_ipc":                         "Description.Pod.Spec.HostIPC",
+	"host_network":                     "Description.Pod.Spec.HostNetwork",
+	"host_pid":                         "Description.Pod.Spec.HostPID",
+	"hostname":                         "Description.Pod.Spec.Hostname",
 	"image_pull_secrets":               "Description.Pod.Spec.ImagePullSecrets",
 	"init_container_statuses":          "Description.Pod.Status.InitContainerStatuses",
 	"init_containers":                  "Description.Pod.Spec.InitContainers",
+	"name":                             "Description.MetaObject.Name",
+	"namespace":                        "Description.MetaObject.Namespace",
 	"node_name":                        "Description.Pod.Spec.NodeName",
 	"node_selector":                    "Description.Pod.Spec.NodeSelector",
 	"nominated_node_name":              "Description.Pod.Status.NominatedNodeName",
 	"overhead":                         "Description.Pod.Spec.Overhead",
 	"phase":                            "Description.Pod.Status.Phase",
+	"platform_integration_id":          "IntegrationID",
 	"pod_ip":                           "Description.Pod.Status.PodIP",
 	"pod_ips":                          "Description.Pod.Status.PodIPs",
 	"preemption_policy":                "Description.Pod.Spec.PreemptionPolicy",
@@ -4916,50 +10787,3696 @@ var listKubernetesPodFilters = map[string]string{
 	"volumes":                          "Description.Pod.Spec.Volumes",
 }
 
-func ListKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesPod")
+func GetKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPod")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPodPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPod =============================
+
+// ==========================  START: KubernetesPodDisruptionBudget =============================
+
+type KubernetesPodDisruptionBudget struct {
+	ResourceID      string                                              `json:"resource_id"`
+	PlatformID      string                                              `json:"platform_id"`
+	Description     kubernetes.KubernetesPodDisruptionBudgetDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                 `json:"metadata"`
+	DescribedBy     string                                              `json:"described_by"`
+	ResourceType    string                                              `json:"resource_type"`
+	IntegrationType string                                              `json:"integration_type"`
+	IntegrationID   string                                              `json:"integration_id"`
+}
+
+type KubernetesPodDisruptionBudgetHit struct {
+	ID      string                        `json:"_id"`
+	Score   float64                       `json:"_score"`
+	Index   string                        `json:"_index"`
+	Type    string                        `json:"_type"`
+	Version int64                         `json:"_version,omitempty"`
+	Source  KubernetesPodDisruptionBudget `json:"_source"`
+	Sort    []interface{}                 `json:"sort"`
+}
+
+type KubernetesPodDisruptionBudgetHits struct {
+	Total essdk.SearchTotal                  `json:"total"`
+	Hits  []KubernetesPodDisruptionBudgetHit `json:"hits"`
+}
+
+type KubernetesPodDisruptionBudgetSearchResponse struct {
+	PitID string                            `json:"pit_id"`
+	Hits  KubernetesPodDisruptionBudgetHits `json:"hits"`
+}
+
+type KubernetesPodDisruptionBudgetPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPodDisruptionBudgetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodDisruptionBudgetPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_poddisruptionbudget", filters, limit)
+	if err != nil {
+		return KubernetesPodDisruptionBudgetPaginator{}, err
+	}
+
+	p := KubernetesPodDisruptionBudgetPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPodDisruptionBudgetPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPodDisruptionBudgetPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPodDisruptionBudgetPaginator) NextPage(ctx context.Context) ([]KubernetesPodDisruptionBudget, error) {
+	var response KubernetesPodDisruptionBudgetSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPodDisruptionBudget
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPodDisruptionBudgetFilters = map[string]string{
+	"max_unavailable":         "Description.PodDisruptionBudget.Spec.MaxUnavailable",
+	"min_available":           "Description.PodDisruptionBudget.Spec.MinAvailable",
+	"platform_integration_id": "IntegrationID",
+	"selector":                "Description.PodDisruptionBudget.Spec.Selector",
+	"title":                   "Description.PodDisruptionBudget.Name",
+}
+
+func ListKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPodDisruptionBudget")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPodDisruptionBudgetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodDisruptionBudgetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget NewKubernetesPodDisruptionBudgetPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPodDisruptionBudgetFilters = map[string]string{
+	"max_unavailable":         "Description.PodDisruptionBudget.Spec.MaxUnavailable",
+	"min_available":           "Description.PodDisruptionBudget.Spec.MinAvailable",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"selector":                "Description.PodDisruptionBudget.Spec.Selector",
+	"title":                   "Description.PodDisruptionBudget.Name",
+}
+
+func GetKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPodDisruptionBudget")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPodDisruptionBudgetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodDisruptionBudgetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPodDisruptionBudget =============================
+
+// ==========================  START: KubernetesPodMetric =============================
+
+type KubernetesPodMetric struct {
+	ResourceID      string                                    `json:"resource_id"`
+	PlatformID      string                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesPodMetricDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                       `json:"metadata"`
+	DescribedBy     string                                    `json:"described_by"`
+	ResourceType    string                                    `json:"resource_type"`
+	IntegrationType string                                    `json:"integration_type"`
+	IntegrationID   string                                    `json:"integration_id"`
+}
+
+type KubernetesPodMetricHit struct {
+	ID      string              `json:"_id"`
+	Score   float64             `json:"_score"`
+	Index   string              `json:"_index"`
+	Type    string              `json:"_type"`
+	Version int64               `json:"_version,omitempty"`
+	Source  KubernetesPodMetric `json:"_source"`
+	Sort    []interface{}       `json:"sort"`
+}
+
+type KubernetesPodMetricHits struct {
+	Total essdk.SearchTotal        `json:"total"`
+	Hits  []KubernetesPodMetricHit `json:"hits"`
+}
+
+type KubernetesPodMetricSearchResponse struct {
+	PitID string                  `json:"pit_id"`
+	Hits  KubernetesPodMetricHits `json:"hits"`
+}
+
+type KubernetesPodMetricPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPodMetricPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodMetricPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_podmetric", filters, limit)
+	if err != nil {
+		return KubernetesPodMetricPaginator{}, err
+	}
+
+	p := KubernetesPodMetricPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPodMetricPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPodMetricPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPodMetricPaginator) NextPage(ctx context.Context) ([]KubernetesPodMetric, error) {
+	var response KubernetesPodMetricSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPodMetric
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPodMetricFilters = map[string]string{
+	"containers":              "Description.PodMetric.Containers",
+	"cpu_millicores":          "Description.PodMetric.CPUMillicores",
+	"memory_bytes":            "Description.PodMetric.MemoryBytes",
+	"platform_integration_id": "IntegrationID",
+	"timestamp":               "Description.PodMetric.Timestamp",
+	"title":                   "Description.MetaObject.Name",
+	"window":                  "Description.PodMetric.Window",
+}
+
+func ListKubernetesPodMetric(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPodMetric")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMetric NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMetric NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMetric GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMetric GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMetric GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPodMetricPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodMetricFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMetric NewKubernetesPodMetricPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPodMetric paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPodMetricFilters = map[string]string{
+	"containers":              "Description.PodMetric.Containers",
+	"cpu_millicores":          "Description.PodMetric.CPUMillicores",
+	"memory_bytes":            "Description.PodMetric.MemoryBytes",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"timestamp":               "Description.PodMetric.Timestamp",
+	"title":                   "Description.MetaObject.Name",
+	"window":                  "Description.PodMetric.Window",
+}
+
+func GetKubernetesPodMetric(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPodMetric")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPodMetricPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodMetricFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPodMetric =============================
+
+// ==========================  START: KubernetesPodMonitor =============================
+
+type KubernetesPodMonitor struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesPodMonitorDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
+}
+
+type KubernetesPodMonitorHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesPodMonitor `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
+}
+
+type KubernetesPodMonitorHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesPodMonitorHit `json:"hits"`
+}
+
+type KubernetesPodMonitorSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesPodMonitorHits `json:"hits"`
+}
+
+type KubernetesPodMonitorPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPodMonitorPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodMonitorPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_podmonitor", filters, limit)
+	if err != nil {
+		return KubernetesPodMonitorPaginator{}, err
+	}
+
+	p := KubernetesPodMonitorPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPodMonitorPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPodMonitorPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPodMonitorPaginator) NextPage(ctx context.Context) ([]KubernetesPodMonitor, error) {
+	var response KubernetesPodMonitorSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPodMonitor
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPodMonitorFilters = map[string]string{
+	"job_label":               "Description.PodMonitor.Spec.JobLabel",
+	"namespace_selector":      "Description.PodMonitor.Spec.NamespaceSelector",
+	"platform_integration_id": "IntegrationID",
+	"pod_metrics_endpoints":   "Description.PodMonitor.Spec.PodMetricsEndpoints",
+	"pod_target_labels":       "Description.PodMonitor.Spec.PodTargetLabels",
+	"sample_limit":            "Description.PodMonitor.Spec.SampleLimit",
+	"selector":                "Description.PodMonitor.Spec.Selector",
+	"selector_query":          "Description.LabelSelectorString",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesPodMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPodMonitor")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMonitor NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMonitor NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMonitor GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMonitor GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMonitor GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPodMonitorPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodMonitorFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodMonitor NewKubernetesPodMonitorPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPodMonitor paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPodMonitorFilters = map[string]string{
+	"job_label":               "Description.PodMonitor.Spec.JobLabel",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"namespace_selector":      "Description.PodMonitor.Spec.NamespaceSelector",
+	"platform_integration_id": "IntegrationID",
+	"pod_metrics_endpoints":   "Description.PodMonitor.Spec.PodMetricsEndpoints",
+	"pod_target_labels":       "Description.PodMonitor.Spec.PodTargetLabels",
+	"sample_limit":            "Description.PodMonitor.Spec.SampleLimit",
+	"selector":                "Description.PodMonitor.Spec.Selector",
+	"selector_query":          "Description.LabelSelectorString",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesPodMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPodMonitor")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPodMonitorPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodMonitorFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPodMonitor =============================
+
+// ==========================  START: KubernetesPodTemplate =============================
+
+type KubernetesPodTemplate struct {
+	ResourceID      string                                      `json:"resource_id"`
+	PlatformID      string                                      `json:"platform_id"`
+	Description     kubernetes.KubernetesPodTemplateDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                         `json:"metadata"`
+	DescribedBy     string                                      `json:"described_by"`
+	ResourceType    string                                      `json:"resource_type"`
+	IntegrationType string                                      `json:"integration_type"`
+	IntegrationID   string                                      `json:"integration_id"`
+}
+
+type KubernetesPodTemplateHit struct {
+	ID      string                `json:"_id"`
+	Score   float64               `json:"_score"`
+	Index   string                `json:"_index"`
+	Type    string                `json:"_type"`
+	Version int64                 `json:"_version,omitempty"`
+	Source  KubernetesPodTemplate `json:"_source"`
+	Sort    []interface{}         `json:"sort"`
+}
+
+type KubernetesPodTemplateHits struct {
+	Total essdk.SearchTotal          `json:"total"`
+	Hits  []KubernetesPodTemplateHit `json:"hits"`
+}
+
+type KubernetesPodTemplateSearchResponse struct {
+	PitID string                    `json:"pit_id"`
+	Hits  KubernetesPodTemplateHits `json:"hits"`
+}
+
+type KubernetesPodTemplatePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPodTemplatePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodTemplatePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_podtemplate", filters, limit)
+	if err != nil {
+		return KubernetesPodTemplatePaginator{}, err
+	}
+
+	p := KubernetesPodTemplatePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPodTemplatePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPodTemplatePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPodTemplatePaginator) NextPage(ctx context.Context) ([]KubernetesPodTemplate, error) {
+	var response KubernetesPodTemplateSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPodTemplate
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPodTemplateFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"template":                "Description.PodTemplate.Template",
+	"title":                   "Description.PodTemplate.Name",
+}
+
+func ListKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPodTemplate")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodTemplate NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodTemplate NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodTemplate GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodTemplate GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodTemplate GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPodTemplatePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodTemplateFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPodTemplate NewKubernetesPodTemplatePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPodTemplate paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPodTemplateFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"template":                "Description.PodTemplate.Template",
+	"title":                   "Description.PodTemplate.Name",
+}
+
+func GetKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPodTemplate")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPodTemplatePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodTemplateFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPodTemplate =============================
+
+// ==========================  START: KubernetesPriorityClass =============================
+
+type KubernetesPriorityClass struct {
+	ResourceID      string                                        `json:"resource_id"`
+	PlatformID      string                                        `json:"platform_id"`
+	Description     kubernetes.KubernetesPriorityClassDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                           `json:"metadata"`
+	DescribedBy     string                                        `json:"described_by"`
+	ResourceType    string                                        `json:"resource_type"`
+	IntegrationType string                                        `json:"integration_type"`
+	IntegrationID   string                                        `json:"integration_id"`
+}
+
+type KubernetesPriorityClassHit struct {
+	ID      string                  `json:"_id"`
+	Score   float64                 `json:"_score"`
+	Index   string                  `json:"_index"`
+	Type    string                  `json:"_type"`
+	Version int64                   `json:"_version,omitempty"`
+	Source  KubernetesPriorityClass `json:"_source"`
+	Sort    []interface{}           `json:"sort"`
+}
+
+type KubernetesPriorityClassHits struct {
+	Total essdk.SearchTotal            `json:"total"`
+	Hits  []KubernetesPriorityClassHit `json:"hits"`
+}
+
+type KubernetesPriorityClassSearchResponse struct {
+	PitID string                      `json:"pit_id"`
+	Hits  KubernetesPriorityClassHits `json:"hits"`
+}
+
+type KubernetesPriorityClassPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPriorityClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPriorityClassPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_priorityclass", filters, limit)
+	if err != nil {
+		return KubernetesPriorityClassPaginator{}, err
+	}
+
+	p := KubernetesPriorityClassPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPriorityClassPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPriorityClassPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPriorityClassPaginator) NextPage(ctx context.Context) ([]KubernetesPriorityClass, error) {
+	var response KubernetesPriorityClassSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPriorityClass
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPriorityClassFilters = map[string]string{
+	"class_description":       "Description.PriorityClass.Description",
+	"global_default":          "Description.PriorityClass.GlobalDefault",
+	"platform_integration_id": "IntegrationID",
+	"preemption_policy":       "Description.PriorityClass.PreemptionPolicy",
+	"title":                   "Description.MetaObject.Name",
+	"value":                   "Description.PriorityClass.Value",
+}
+
+func ListKubernetesPriorityClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPriorityClass")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityClass NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityClass NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPriorityClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPriorityClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityClass NewKubernetesPriorityClassPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPriorityClass paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPriorityClassFilters = map[string]string{
+	"class_description":       "Description.PriorityClass.Description",
+	"global_default":          "Description.PriorityClass.GlobalDefault",
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"preemption_policy":       "Description.PriorityClass.PreemptionPolicy",
+	"title":                   "Description.MetaObject.Name",
+	"value":                   "Description.PriorityClass.Value",
+}
+
+func GetKubernetesPriorityClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPriorityClass")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPriorityClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPriorityClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPriorityClass =============================
+
+// ==========================  START: KubernetesPriorityLevelConfiguration =============================
+
+type KubernetesPriorityLevelConfiguration struct {
+	ResourceID      string                                                     `json:"resource_id"`
+	PlatformID      string                                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesPriorityLevelConfigurationDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                        `json:"metadata"`
+	DescribedBy     string                                                     `json:"described_by"`
+	ResourceType    string                                                     `json:"resource_type"`
+	IntegrationType string                                                     `json:"integration_type"`
+	IntegrationID   string                                                     `json:"integration_id"`
+}
+
+type KubernetesPriorityLevelConfigurationHit struct {
+	ID      string                               `json:"_id"`
+	Score   float64                              `json:"_score"`
+	Index   string                               `json:"_index"`
+	Type    string                               `json:"_type"`
+	Version int64                                `json:"_version,omitempty"`
+	Source  KubernetesPriorityLevelConfiguration `json:"_source"`
+	Sort    []interface{}                        `json:"sort"`
+}
+
+type KubernetesPriorityLevelConfigurationHits struct {
+	Total essdk.SearchTotal                         `json:"total"`
+	Hits  []KubernetesPriorityLevelConfigurationHit `json:"hits"`
+}
+
+type KubernetesPriorityLevelConfigurationSearchResponse struct {
+	PitID string                                   `json:"pit_id"`
+	Hits  KubernetesPriorityLevelConfigurationHits `json:"hits"`
+}
+
+type KubernetesPriorityLevelConfigurationPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPriorityLevelConfigurationPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPriorityLevelConfigurationPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_prioritylevelconfiguration", filters, limit)
+	if err != nil {
+		return KubernetesPriorityLevelConfigurationPaginator{}, err
+	}
+
+	p := KubernetesPriorityLevelConfigurationPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPriorityLevelConfigurationPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPriorityLevelConfigurationPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPriorityLevelConfigurationPaginator) NextPage(ctx context.Context) ([]KubernetesPriorityLevelConfiguration, error) {
+	var response KubernetesPriorityLevelConfigurationSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPriorityLevelConfiguration
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPriorityLevelConfigurationFilters = map[string]string{
+	"borrowing_limit_percent":    "Description.PriorityLevelConfiguration.Spec.Limited.BorrowingLimitPercent",
+	"conditions":                 "Description.PriorityLevelConfiguration.Status.Conditions",
+	"exempt":                     "Description.PriorityLevelConfiguration.Spec.Exempt",
+	"lendable_percent":           "Description.PriorityLevelConfiguration.Spec.Limited.LendablePercent",
+	"limit_response_type":        "Description.PriorityLevelConfiguration.Spec.Limited.LimitResponse.Type",
+	"limited":                    "Description.PriorityLevelConfiguration.Spec.Limited",
+	"nominal_concurrency_shares": "Description.NominalConcurrencyShares",
+	"platform_integration_id":    "IntegrationID",
+	"queuing":                    "Description.PriorityLevelConfiguration.Spec.Limited.LimitResponse.Queuing",
+	"title":                      "Description.MetaObject.Name",
+	"type":                       "Description.PriorityLevelConfiguration.Spec.Type",
+}
+
+func ListKubernetesPriorityLevelConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPriorityLevelConfiguration")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPriorityLevelConfigurationPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPriorityLevelConfigurationFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration NewKubernetesPriorityLevelConfigurationPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPriorityLevelConfiguration paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPriorityLevelConfigurationFilters = map[string]string{
+	"borrowing_limit_percent":    "Description.PriorityLevelConfiguration.Spec.Limited.BorrowingLimitPercent",
+	"conditions":                 "Description.PriorityLevelConfiguration.Status.Conditions",
+	"exempt":                     "Description.PriorityLevelConfiguration.Spec.Exempt",
+	"lendable_percent":           "Description.PriorityLevelConfiguration.Spec.Limited.LendablePercent",
+	"limit_response_type":        "Description.PriorityLevelConfiguration.Spec.Limited.LimitResponse.Type",
+	"limited":                    "Description.PriorityLevelConfiguration.Spec.Limited",
+	"name":                       "Description.MetaObject.Name",
+	"nominal_concurrency_shares": "Description.NominalConcurrencyShares",
+	"platform_integration_id":    "IntegrationID",
+	"queuing":                    "Description.PriorityLevelConfiguration.Spec.Limited.LimitResponse.Queuing",
+	"title":                      "Description.MetaObject.Name",
+	"type":                       "Description.PriorityLevelConfiguration.Spec.Type",
+}
+
+func GetKubernetesPriorityLevelConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPriorityLevelConfiguration")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPriorityLevelConfigurationPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPriorityLevelConfigurationFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPriorityLevelConfiguration =============================
+
+// ==========================  START: KubernetesPrometheus =============================
+
+type KubernetesPrometheus struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesPrometheusDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
+}
+
+type KubernetesPrometheusHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesPrometheus `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
+}
+
+type KubernetesPrometheusHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesPrometheusHit `json:"hits"`
+}
+
+type KubernetesPrometheusSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesPrometheusHits `json:"hits"`
+}
+
+type KubernetesPrometheusPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPrometheusPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPrometheusPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_prometheus", filters, limit)
+	if err != nil {
+		return KubernetesPrometheusPaginator{}, err
+	}
+
+	p := KubernetesPrometheusPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPrometheusPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPrometheusPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPrometheusPaginator) NextPage(ctx context.Context) ([]KubernetesPrometheus, error) {
+	var response KubernetesPrometheusSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPrometheus
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPrometheusFilters = map[string]string{
+	"alerting":                           "Description.Prometheus.Spec.Alerting",
+	"available_replicas":                 "Description.Prometheus.Status.AvailableReplicas",
+	"conditions":                         "Description.Prometheus.Status.Conditions",
+	"external_labels":                    "Description.Prometheus.Spec.ExternalLabels",
+	"paused":                             "Description.Prometheus.Status.Paused",
+	"platform_integration_id":            "IntegrationID",
+	"pod_monitor_namespace_selector":     "Description.Prometheus.Spec.PodMonitorNamespaceSelector",
+	"pod_monitor_selector":               "Description.Prometheus.Spec.PodMonitorSelector",
+	"probe_namespace_selector":           "Description.Prometheus.Spec.ProbeNamespaceSelector",
+	"probe_selector":                     "Description.Prometheus.Spec.ProbeSelector",
+	"replicas":                           "Description.Prometheus.Spec.Replicas",
+	"retention":                          "Description.Prometheus.Spec.Retention",
+	"retention_size":                     "Description.Prometheus.Spec.RetentionSize",
+	"rule_namespace_selector":            "Description.Prometheus.Spec.RuleNamespaceSelector",
+	"rule_selector":                      "Description.Prometheus.Spec.RuleSelector",
+	"service_account_name":               "Description.Prometheus.Spec.ServiceAccountName",
+	"service_monitor_namespace_selector": "Description.Prometheus.Spec.ServiceMonitorNamespaceSelector",
+	"service_monitor_selector":           "Description.Prometheus.Spec.ServiceMonitorSelector",
+	"shards":                             "Description.Prometheus.Spec.Shards",
+	"title":                              "Description.MetaObject.Name",
+	"unavailable_replicas":               "Description.Prometheus.Status.UnavailableReplicas",
+	"version":                            "Description.Prometheus.Spec.Version",
+}
+
+func ListKubernetesPrometheus(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPrometheus")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheus NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheus NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheus GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheus GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheus GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPrometheusPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPrometheusFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheus NewKubernetesPrometheusPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPrometheus paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPrometheusFilters = map[string]string{
+	"alerting":                           "Description.Prometheus.Spec.Alerting",
+	"available_replicas":                 "Description.Prometheus.Status.AvailableReplicas",
+	"conditions":                         "Description.Prometheus.Status.Conditions",
+	"external_labels":                    "Description.Prometheus.Spec.ExternalLabels",
+	"name":                               "Description.MetaObject.Name",
+	"namespace":                          "Description.MetaObject.Namespace",
+	"paused":                             "Description.Prometheus.Status.Paused",
+	"platform_integration_id":            "IntegrationID",
+	"pod_monitor_namespace_selector":     "Description.Prometheus.Spec.PodMonitorNamespaceSelector",
+	"pod_monitor_selector":               "Description.Prometheus.Spec.PodMonitorSelector",
+	"probe_namespace_selector":           "Description.Prometheus.Spec.ProbeNamespaceSelector",
+	"probe_selector":                     "Description.Prometheus.Spec.ProbeSelector",
+	"replicas":                           "Description.Prometheus.Spec.Replicas",
+	"retention":                          "Description.Prometheus.Spec.Retention",
+	"retention_size":                     "Description.Prometheus.Spec.RetentionSize",
+	"rule_namespace_selector":            "Description.Prometheus.Spec.RuleNamespaceSelector",
+	"rule_selector":                      "Description.Prometheus.Spec.RuleSelector",
+	"service_account_name":               "Description.Prometheus.Spec.ServiceAccountName",
+	"service_monitor_namespace_selector": "Description.Prometheus.Spec.ServiceMonitorNamespaceSelector",
+	"service_monitor_selector":           "Description.Prometheus.Spec.ServiceMonitorSelector",
+	"shards":                             "Description.Prometheus.Spec.Shards",
+	"title":                              "Description.MetaObject.Name",
+	"unavailable_replicas":               "Description.Prometheus.Status.UnavailableReplicas",
+	"version":                            "Description.Prometheus.Spec.Version",
+}
+
+func GetKubernetesPrometheus(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPrometheus")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPrometheusPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPrometheusFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPrometheus =============================
+
+// ==========================  START: KubernetesPrometheusRule =============================
+
+type KubernetesPrometheusRule struct {
+	ResourceID      string                                         `json:"resource_id"`
+	PlatformID      string                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesPrometheusRuleDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                            `json:"metadata"`
+	DescribedBy     string                                         `json:"described_by"`
+	ResourceType    string                                         `json:"resource_type"`
+	IntegrationType string                                         `json:"integration_type"`
+	IntegrationID   string                                         `json:"integration_id"`
+}
+
+type KubernetesPrometheusRuleHit struct {
+	ID      string                   `json:"_id"`
+	Score   float64                  `json:"_score"`
+	Index   string                   `json:"_index"`
+	Type    string                   `json:"_type"`
+	Version int64                    `json:"_version,omitempty"`
+	Source  KubernetesPrometheusRule `json:"_source"`
+	Sort    []interface{}            `json:"sort"`
+}
+
+type KubernetesPrometheusRuleHits struct {
+	Total essdk.SearchTotal             `json:"total"`
+	Hits  []KubernetesPrometheusRuleHit `json:"hits"`
+}
+
+type KubernetesPrometheusRuleSearchResponse struct {
+	PitID string                       `json:"pit_id"`
+	Hits  KubernetesPrometheusRuleHits `json:"hits"`
+}
+
+type KubernetesPrometheusRulePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesPrometheusRulePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPrometheusRulePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_prometheusrule", filters, limit)
+	if err != nil {
+		return KubernetesPrometheusRulePaginator{}, err
+	}
+
+	p := KubernetesPrometheusRulePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesPrometheusRulePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesPrometheusRulePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesPrometheusRulePaginator) NextPage(ctx context.Context) ([]KubernetesPrometheusRule, error) {
+	var response KubernetesPrometheusRuleSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesPrometheusRule
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesPrometheusRuleFilters = map[string]string{
+	"alerts":                  "Description.Alerts",
+	"groups":                  "Description.PrometheusRule.Spec.Groups",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func ListKubernetesPrometheusRule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesPrometheusRule")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheusRule NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheusRule NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheusRule GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheusRule GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheusRule GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesPrometheusRulePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPrometheusRuleFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesPrometheusRule NewKubernetesPrometheusRulePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesPrometheusRule paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesPrometheusRuleFilters = map[string]string{
+	"alerts":                  "Description.Alerts",
+	"groups":                  "Description.PrometheusRule.Spec.Groups",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+}
+
+func GetKubernetesPrometheusRule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesPrometheusRule")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesPrometheusRulePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPrometheusRuleFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesPrometheusRule =============================
+
+// ==========================  START: KubernetesReferenceGrant =============================
+
+type KubernetesReferenceGrant struct {
+	ResourceID      string                                         `json:"resource_id"`
+	PlatformID      string                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesReferenceGrantDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                            `json:"metadata"`
+	DescribedBy     string                                         `json:"described_by"`
+	ResourceType    string                                         `json:"resource_type"`
+	IntegrationType string                                         `json:"integration_type"`
+	IntegrationID   string                                         `json:"integration_id"`
+}
+
+type KubernetesReferenceGrantHit struct {
+	ID      string                   `json:"_id"`
+	Score   float64                  `json:"_score"`
+	Index   string                   `json:"_index"`
+	Type    string                   `json:"_type"`
+	Version int64                    `json:"_version,omitempty"`
+	Source  KubernetesReferenceGrant `json:"_source"`
+	Sort    []interface{}            `json:"sort"`
+}
+
+type KubernetesReferenceGrantHits struct {
+	Total essdk.SearchTotal             `json:"total"`
+	Hits  []KubernetesReferenceGrantHit `json:"hits"`
+}
+
+type KubernetesReferenceGrantSearchResponse struct {
+	PitID string                       `json:"pit_id"`
+	Hits  KubernetesReferenceGrantHits `json:"hits"`
+}
+
+type KubernetesReferenceGrantPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesReferenceGrantPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesReferenceGrantPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_referencegrant", filters, limit)
+	if err != nil {
+		return KubernetesReferenceGrantPaginator{}, err
+	}
+
+	p := KubernetesReferenceGrantPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesReferenceGrantPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesReferenceGrantPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesReferenceGrantPaginator) NextPage(ctx context.Context) ([]KubernetesReferenceGrant, error) {
+	var response KubernetesReferenceGrantSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesReferenceGrant
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesReferenceGrantFilters = map[string]string{
+	"from":                    "Description.ReferenceGrant.Spec.From",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"to":                      "Description.ReferenceGrant.Spec.To",
+}
+
+func ListKubernetesReferenceGrant(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesReferenceGrant")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReferenceGrant NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReferenceGrant NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReferenceGrant GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReferenceGrant GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReferenceGrant GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesReferenceGrantPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesReferenceGrantFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReferenceGrant NewKubernetesReferenceGrantPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesReferenceGrant paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesReferenceGrantFilters = map[string]string{
+	"from":                    "Description.ReferenceGrant.Spec.From",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"to":                      "Description.ReferenceGrant.Spec.To",
+}
+
+func GetKubernetesReferenceGrant(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesReferenceGrant")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesReferenceGrantPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesReferenceGrantFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesReferenceGrant =============================
+
+// ==========================  START: KubernetesReplicaSet =============================
+
+type KubernetesReplicaSet struct {
+	ResourceID      string                                     `json:"resource_id"`
+	PlatformID      string                                     `json:"platform_id"`
+	Description     kubernetes.KubernetesReplicaSetDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                        `json:"metadata"`
+	DescribedBy     string                                     `json:"described_by"`
+	ResourceType    string                                     `json:"resource_type"`
+	IntegrationType string                                     `json:"integration_type"`
+	IntegrationID   string                                     `json:"integration_id"`
+}
+
+type KubernetesReplicaSetHit struct {
+	ID      string               `json:"_id"`
+	Score   float64              `json:"_score"`
+	Index   string               `json:"_index"`
+	Type    string               `json:"_type"`
+	Version int64                `json:"_version,omitempty"`
+	Source  KubernetesReplicaSet `json:"_source"`
+	Sort    []interface{}        `json:"sort"`
+}
+
+type KubernetesReplicaSetHits struct {
+	Total essdk.SearchTotal         `json:"total"`
+	Hits  []KubernetesReplicaSetHit `json:"hits"`
+}
+
+type KubernetesReplicaSetSearchResponse struct {
+	PitID string                   `json:"pit_id"`
+	Hits  KubernetesReplicaSetHits `json:"hits"`
+}
+
+type KubernetesReplicaSetPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesReplicaSetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesReplicaSetPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_replicaset", filters, limit)
+	if err != nil {
+		return KubernetesReplicaSetPaginator{}, err
+	}
+
+	p := KubernetesReplicaSetPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesReplicaSetPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesReplicaSetPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesReplicaSetPaginator) NextPage(ctx context.Context) ([]KubernetesReplicaSet, error) {
+	var response KubernetesReplicaSetSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesReplicaSet
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesReplicaSetFilters = map[string]string{
+	"available_replicas":      "Description.ReplicaSet.Status.AvailableReplicas",
+	"conditions":              "Description.ReplicaSet.Status.Conditions",
+	"fully_labeled_replicas":  "Description.ReplicaSet.Status.FullyLabeledReplicas",
+	"min_ready_seconds":       "Description.ReplicaSet.Spec.MinReadySeconds",
+	"observed_generation":     "Description.ReplicaSet.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"ready_replicas":          "Description.ReplicaSet.Status.ReadyReplicas",
+	"replicas":                "Description.ReplicaSet.Spec.Replicas",
+	"selector":                "Description.ReplicaSet.Spec.Selector",
+	"selector_query":          "Description.LabelSelectorString",
+	"status_replicas":         "Description.ReplicaSet.Status.Replicas",
+	"template":                "Description.ReplicaSet.Spec.Template",
+	"title":                   "Description.ReplicaSet.Name",
+}
+
+func ListKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesReplicaSet")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicaSet NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicaSet NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicaSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicaSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicaSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesReplicaSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesReplicaSetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicaSet NewKubernetesReplicaSetPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesReplicaSet paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesReplicaSetFilters = map[string]string{
+	"available_replicas":      "Description.ReplicaSet.Status.AvailableReplicas",
+	"conditions":              "Description.ReplicaSet.Status.Conditions",
+	"fully_labeled_replicas":  "Description.ReplicaSet.Status.FullyLabeledReplicas",
+	"min_ready_seconds":       "Description.ReplicaSet.Spec.MinReadySeconds",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"observed_generation":     "Description.ReplicaSet.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"ready_replicas":          "Description.ReplicaSet.Status.ReadyReplicas",
+	"replicas":                "Description.ReplicaSet.Spec.Replicas",
+	"selector":                "Description.ReplicaSet.Spec.Selector",
+	"selector_query":          "Description.LabelSelectorString",
+	"status_replicas":         "Description.ReplicaSet.Status.Replicas",
+	"template":                "Description.ReplicaSet.Spec.Template",
+	"title":                   "Description.ReplicaSet.Name",
+}
+
+func GetKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesReplicaSet")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesReplicaSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesReplicaSetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesReplicaSet =============================
+
+// ==========================  START: KubernetesReplicationController =============================
+
+type KubernetesReplicationController struct {
+	ResourceID      string                                                `json:"resource_id"`
+	PlatformID      string                                                `json:"platform_id"`
+	Description     kubernetes.KubernetesReplicationControllerDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                   `json:"metadata"`
+	DescribedBy     string                                                `json:"described_by"`
+	ResourceType    string                                                `json:"resource_type"`
+	IntegrationType string                                                `json:"integration_type"`
+	IntegrationID   string                                                `json:"integration_id"`
+}
+
+type KubernetesReplicationControllerHit struct {
+	ID      string                          `json:"_id"`
+	Score   float64                         `json:"_score"`
+	Index   string                          `json:"_index"`
+	Type    string                          `json:"_type"`
+	Version int64                           `json:"_version,omitempty"`
+	Source  KubernetesReplicationController `json:"_source"`
+	Sort    []interface{}                   `json:"sort"`
+}
+
+type KubernetesReplicationControllerHits struct {
+	Total essdk.SearchTotal                    `json:"total"`
+	Hits  []KubernetesReplicationControllerHit `json:"hits"`
+}
+
+type KubernetesReplicationControllerSearchResponse struct {
+	PitID string                              `json:"pit_id"`
+	Hits  KubernetesReplicationControllerHits `json:"hits"`
+}
+
+type KubernetesReplicationControllerPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesReplicationControllerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesReplicationControllerPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_replicationcontroller", filters, limit)
+	if err != nil {
+		return KubernetesReplicationControllerPaginator{}, err
+	}
+
+	p := KubernetesReplicationControllerPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesReplicationControllerPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesReplicationControllerPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesReplicationControllerPaginator) NextPage(ctx context.Context) ([]KubernetesReplicationController, error) {
+	var response KubernetesReplicationControllerSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesReplicationController
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesReplicationControllerFilters = map[string]string{
+	"available_replicas":      "Description.ReplicationController.Status.AvailableReplicas",
+	"conditions":              "Description.ReplicationController.Status.Conditions",
+	"fully_labeled_replicas":  "Description.ReplicationController.Status.FullyLabeledReplicas",
+	"min_ready_seconds":       "Description.ReplicationController.Spec.MinReadySeconds",
+	"observed_generation":     "Description.ReplicationController.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"ready_replicas":          "Description.ReplicationController.Status.ReadyReplicas",
+	"replicas":                "Description.ReplicationController.Spec.Replicas",
+	"selector":                "Description.ReplicationController.Spec.Selector",
+	"status_replicas":         "Description.ReplicationController.Status.Replicas",
+	"template":                "Description.ReplicationController.Spec.Template",
+	"title":                   "Description.ReplicationController.Name",
+}
+
+func ListKubernetesReplicationController(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesReplicationController")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicationController NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicationController NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicationController GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicationController GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicationController GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesReplicationControllerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesReplicationControllerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesReplicationController NewKubernetesReplicationControllerPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesReplicationController paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesReplicationControllerFilters = map[string]string{
+	"available_replicas":      "Description.ReplicationController.Status.AvailableReplicas",
+	"conditions":              "Description.ReplicationController.Status.Conditions",
+	"fully_labeled_replicas":  "Description.ReplicationController.Status.FullyLabeledReplicas",
+	"min_ready_seconds":       "Description.ReplicationController.Spec.MinReadySeconds",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"observed_generation":     "Description.ReplicationController.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"ready_replicas":          "Description.ReplicationController.Status.ReadyReplicas",
+	"replicas":                "Description.ReplicationController.Spec.Replicas",
+	"selector":                "Description.ReplicationController.Spec.Selector",
+	"status_replicas":         "Description.ReplicationController.Status.Replicas",
+	"template":                "Description.ReplicationController.Spec.Template",
+	"title":                   "Description.ReplicationController.Name",
+}
+
+func GetKubernetesReplicationController(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesReplicationController")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesReplicationControllerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesReplicationControllerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesReplicationController =============================
+
+// ==========================  START: KubernetesResourceQuota =============================
+
+type KubernetesResourceQuota struct {
+	ResourceID      string                                        `json:"resource_id"`
+	PlatformID      string                                        `json:"platform_id"`
+	Description     kubernetes.KubernetesResourceQuotaDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                           `json:"metadata"`
+	DescribedBy     string                                        `json:"described_by"`
+	ResourceType    string                                        `json:"resource_type"`
+	IntegrationType string                                        `json:"integration_type"`
+	IntegrationID   string                                        `json:"integration_id"`
+}
+
+type KubernetesResourceQuotaHit struct {
+	ID      string                  `json:"_id"`
+	Score   float64                 `json:"_score"`
+	Index   string                  `json:"_index"`
+	Type    string                  `json:"_type"`
+	Version int64                   `json:"_version,omitempty"`
+	Source  KubernetesResourceQuota `json:"_source"`
+	Sort    []interface{}           `json:"sort"`
+}
+
+type KubernetesResourceQuotaHits struct {
+	Total essdk.SearchTotal            `json:"total"`
+	Hits  []KubernetesResourceQuotaHit `json:"hits"`
+}
+
+type KubernetesResourceQuotaSearchResponse struct {
+	PitID string                      `json:"pit_id"`
+	Hits  KubernetesResourceQuotaHits `json:"hits"`
+}
+
+type KubernetesResourceQuotaPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesResourceQuotaPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesResourceQuotaPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_ressourcequota", filters, limit)
+	if err != nil {
+		return KubernetesResourceQuotaPaginator{}, err
+	}
+
+	p := KubernetesResourceQuotaPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesResourceQuotaPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesResourceQuotaPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesResourceQuotaPaginator) NextPage(ctx context.Context) ([]KubernetesResourceQuota, error) {
+	var response KubernetesResourceQuotaSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesResourceQuota
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesResourceQuotaFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"spec_hard":               "Description.ResourceQuota.Spec.Hard",
+	"spec_scope_selector":     "Description.ResourceQuota.Spec.ScopeSelector",
+	"spec_scopes":             "Description.ResourceQuota.Spec.Scopes",
+	"status_hard":             "Description.ResourceQuota.Status.Hard",
+	"status_used":             "Description.ResourceQuota.Status.Used",
+	"title":                   "Description.ResourceQuota.Name",
+}
+
+func ListKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesResourceQuota")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesResourceQuota NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesResourceQuota NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesResourceQuota GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesResourceQuota GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesResourceQuota GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesResourceQuotaPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesResourceQuotaFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesResourceQuota NewKubernetesResourceQuotaPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesResourceQuota paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesResourceQuotaFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"spec_hard":               "Description.ResourceQuota.Spec.Hard",
+	"spec_scope_selector":     "Description.ResourceQuota.Spec.ScopeSelector",
+	"spec_scopes":             "Description.ResourceQuota.Spec.Scopes",
+	"status_hard":             "Description.ResourceQuota.Status.Hard",
+	"status_used":             "Description.ResourceQuota.Status.Used",
+	"title":                   "Description.ResourceQuota.Name",
+}
+
+func GetKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesResourceQuota")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesResourceQuotaPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesResourceQuotaFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesResourceQuota =============================
+
+// ==========================  START: KubernetesRole =============================
+
+type KubernetesRole struct {
+	ResourceID      string                               `json:"resource_id"`
+	PlatformID      string                               `json:"platform_id"`
+	Description     kubernetes.KubernetesRoleDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                  `json:"metadata"`
+	DescribedBy     string                               `json:"described_by"`
+	ResourceType    string                               `json:"resource_type"`
+	IntegrationType string                               `json:"integration_type"`
+	IntegrationID   string                               `json:"integration_id"`
+}
+
+type KubernetesRoleHit struct {
+	ID      string         `json:"_id"`
+	Score   float64        `json:"_score"`
+	Index   string         `json:"_index"`
+	Type    string         `json:"_type"`
+	Version int64          `json:"_version,omitempty"`
+	Source  KubernetesRole `json:"_source"`
+	Sort    []interface{}  `json:"sort"`
+}
+
+type KubernetesRoleHits struct {
+	Total essdk.SearchTotal   `json:"total"`
+	Hits  []KubernetesRoleHit `json:"hits"`
+}
+
+type KubernetesRoleSearchResponse struct {
+	PitID string             `json:"pit_id"`
+	Hits  KubernetesRoleHits `json:"hits"`
+}
+
+type KubernetesRolePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesRolePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesRolePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_role", filters, limit)
+	if err != nil {
+		return KubernetesRolePaginator{}, err
+	}
+
+	p := KubernetesRolePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesRolePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesRolePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesRolePaginator) NextPage(ctx context.Context) ([]KubernetesRole, error) {
+	var response KubernetesRoleSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesRole
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesRoleFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.Role.Rules",
+	"title":                   "Description.Role.Name",
+}
+
+func ListKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesRole")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRole NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRole NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRole NewKubernetesRolePaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesRole paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesRoleFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"rules":                   "Description.Role.Rules",
+	"title":                   "Description.Role.Name",
+}
+
+func GetKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesRole")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesRole =============================
+
+// ==========================  START: KubernetesRoleBinding =============================
+
+type KubernetesRoleBinding struct {
+	ResourceID      string                                      `json:"resource_id"`
+	PlatformID      string                                      `json:"platform_id"`
+	Description     kubernetes.KubernetesRoleBindingDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                         `json:"metadata"`
+	DescribedBy     string                                      `json:"described_by"`
+	ResourceType    string                                      `json:"resource_type"`
+	IntegrationType string                                      `json:"integration_type"`
+	IntegrationID   string                                      `json:"integration_id"`
+}
+
+type KubernetesRoleBindingHit struct {
+	ID      string                `json:"_id"`
+	Score   float64               `json:"_score"`
+	Index   string                `json:"_index"`
+	Type    string                `json:"_type"`
+	Version int64                 `json:"_version,omitempty"`
+	Source  KubernetesRoleBinding `json:"_source"`
+	Sort    []interface{}         `json:"sort"`
+}
+
+type KubernetesRoleBindingHits struct {
+	Total essdk.SearchTotal          `json:"total"`
+	Hits  []KubernetesRoleBindingHit `json:"hits"`
+}
+
+type KubernetesRoleBindingSearchResponse struct {
+	PitID string                    `json:"pit_id"`
+	Hits  KubernetesRoleBindingHits `json:"hits"`
+}
+
+type KubernetesRoleBindingPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesRoleBindingPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesRoleBindingPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_rolebinding", filters, limit)
+	if err != nil {
+		return KubernetesRoleBindingPaginator{}, err
+	}
+
+	p := KubernetesRoleBindingPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesRoleBindingPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesRoleBindingPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesRoleBindingPaginator) NextPage(ctx context.Context) ([]KubernetesRoleBinding, error) {
+	var response KubernetesRoleBindingSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesRoleBinding
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesRoleBindingFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"role_api_group":          "Description.RoleBinding.RoleRef.APIGroup",
+	"role_kind":               "Description.RoleBinding.RoleRef.Kind",
+	"role_name":               "Description.RoleBinding.RoleRef.Name",
+	"subjects":                "Description.RoleBinding.Subjects",
+	"title":                   "Description.RoleBinding.Name",
+}
+
+func ListKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesRoleBinding")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRoleBinding NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRoleBinding NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRoleBinding NewKubernetesRoleBindingPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesRoleBinding paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesRoleBindingFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"role_api_group":          "Description.RoleBinding.RoleRef.APIGroup",
+	"role_kind":               "Description.RoleBinding.RoleRef.Kind",
+	"role_name":               "Description.RoleBinding.RoleRef.Name",
+	"subjects":                "Description.RoleBinding.Subjects",
+	"title":                   "Description.RoleBinding.Name",
+}
+
+func GetKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesRoleBinding")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesRoleBinding =============================
+
+// ==========================  START: KubernetesRuntimeClass =============================
+
+type KubernetesRuntimeClass struct {
+	ResourceID      string                                       `json:"resource_id"`
+	PlatformID      string                                       `json:"platform_id"`
+	Description     kubernetes.KubernetesRuntimeClassDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                          `json:"metadata"`
+	DescribedBy     string                                       `json:"described_by"`
+	ResourceType    string                                       `json:"resource_type"`
+	IntegrationType string                                       `json:"integration_type"`
+	IntegrationID   string                                       `json:"integration_id"`
+}
+
+type KubernetesRuntimeClassHit struct {
+	ID      string                 `json:"_id"`
+	Score   float64                `json:"_score"`
+	Index   string                 `json:"_index"`
+	Type    string                 `json:"_type"`
+	Version int64                  `json:"_version,omitempty"`
+	Source  KubernetesRuntimeClass `json:"_source"`
+	Sort    []interface{}          `json:"sort"`
+}
+
+type KubernetesRuntimeClassHits struct {
+	Total essdk.SearchTotal           `json:"total"`
+	Hits  []KubernetesRuntimeClassHit `json:"hits"`
+}
+
+type KubernetesRuntimeClassSearchResponse struct {
+	PitID string                     `json:"pit_id"`
+	Hits  KubernetesRuntimeClassHits `json:"hits"`
+}
+
+type KubernetesRuntimeClassPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesRuntimeClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesRuntimeClassPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_runtimeclass", filters, limit)
+	if err != nil {
+		return KubernetesRuntimeClassPaginator{}, err
+	}
+
+	p := KubernetesRuntimeClassPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesRuntimeClassPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesRuntimeClassPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesRuntimeClassPaginator) NextPage(ctx context.Context) ([]KubernetesRuntimeClass, error) {
+	var response KubernetesRuntimeClassSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesRuntimeClass
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesRuntimeClassFilters = map[string]string{
+	"handler":                 "Description.RuntimeClass.Handler",
+	"node_selector":           "Description.RuntimeClass.Scheduling.NodeSelector",
+	"overhead":                "Description.RuntimeClass.Overhead.PodFixed",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"tolerations":             "Description.RuntimeClass.Scheduling.Tolerations",
+}
+
+func ListKubernetesRuntimeClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesRuntimeClass")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRuntimeClass NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRuntimeClass NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRuntimeClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRuntimeClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRuntimeClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesRuntimeClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesRuntimeClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesRuntimeClass NewKubernetesRuntimeClassPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesRuntimeClass paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesRuntimeClassFilters = map[string]string{
+	"handler":                 "Description.RuntimeClass.Handler",
+	"name":                    "Description.MetaObject.Name",
+	"node_selector":           "Description.RuntimeClass.Scheduling.NodeSelector",
+	"overhead":                "Description.RuntimeClass.Overhead.PodFixed",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"tolerations":             "Description.RuntimeClass.Scheduling.Tolerations",
+}
+
+func GetKubernetesRuntimeClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesRuntimeClass")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesRuntimeClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesRuntimeClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesRuntimeClass =============================
+
+// ==========================  START: KubernetesSecret =============================
+
+type KubernetesSecret struct {
+	ResourceID      string                                 `json:"resource_id"`
+	PlatformID      string                                 `json:"platform_id"`
+	Description     kubernetes.KubernetesSecretDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                    `json:"metadata"`
+	DescribedBy     string                                 `json:"described_by"`
+	ResourceType    string                                 `json:"resource_type"`
+	IntegrationType string                                 `json:"integration_type"`
+	IntegrationID   string                                 `json:"integration_id"`
+}
+
+type KubernetesSecretHit struct {
+	ID      string           `json:"_id"`
+	Score   float64          `json:"_score"`
+	Index   string           `json:"_index"`
+	Type    string           `json:"_type"`
+	Version int64            `json:"_version,omitempty"`
+	Source  KubernetesSecret `json:"_source"`
+	Sort    []interface{}    `json:"sort"`
+}
+
+type KubernetesSecretHits struct {
+	Total essdk.SearchTotal     `json:"total"`
+	Hits  []KubernetesSecretHit `json:"hits"`
+}
+
+type KubernetesSecretSearchResponse struct {
+	PitID string               `json:"pit_id"`
+	Hits  KubernetesSecretHits `json:"hits"`
+}
+
+type KubernetesSecretPaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesSecretPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesSecretPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_secret", filters, limit)
+	if err != nil {
+		return KubernetesSecretPaginator{}, err
+	}
+
+	p := KubernetesSecretPaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesSecretPaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesSecretPaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesSecretPaginator) NextPage(ctx context.Context) ([]KubernetesSecret, error) {
+	var response KubernetesSecretSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesSecret
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesSecretFilters = map[string]string{
+	"data_number":             "Description.DataNumber",
+	"immutable":               "Description.Secret.Immutable",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.Secret.Name",
+	"type":                    "Description.Secret.Type",
+}
+
+func ListKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesSecret")
+	runtime.GC()
+
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesSecret NewClientCached", "error", err)
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesSecret NewSelfClientCached", "error", err)
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesSecret GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesSecret GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesSecret GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		return nil, err
+	}
+
+	paginator, err := k.NewKubernetesSecretPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesSecretFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	if err != nil {
+		plugin.Logger(ctx).Error("ListKubernetesSecret NewKubernetesSecretPaginator", "error", err)
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("ListKubernetesSecret paginator.NextPage", "error", err)
+			return nil, err
+		}
+
+		for _, v := range page {
+			d.StreamListItem(ctx, v)
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+var getKubernetesSecretFilters = map[string]string{
+	"data_number":             "Description.DataNumber",
+	"immutable":               "Description.Secret.Immutable",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.Secret.Name",
+	"type":                    "Description.Secret.Type",
+}
+
+func GetKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesSecret")
+	runtime.GC()
+	// create service
+	cfg := essdk.GetConfig(d.Connection)
+	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
+	if err != nil {
+		return nil, err
+	}
+	k := Client{Client: ke}
+
+	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
+	if err != nil {
+		return nil, err
+	}
+	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
+	if err != nil {
+		return nil, err
+	}
+	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	paginator, err := k.NewKubernetesSecretPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesSecretFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page {
+			return v, nil
+		}
+	}
+
+	err = paginator.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ==========================  END: KubernetesSecret =============================
+
+// ==========================  START: KubernetesService =============================
+
+type KubernetesService struct {
+	ResourceID      string                                  `json:"resource_id"`
+	PlatformID      string                                  `json:"platform_id"`
+	Description     kubernetes.KubernetesServiceDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                     `json:"metadata"`
+	DescribedBy     string                                  `json:"described_by"`
+	ResourceType    string                                  `json:"resource_type"`
+	IntegrationType string                                  `json:"integration_type"`
+	IntegrationID   string                                  `json:"integration_id"`
+}
+
+type KubernetesServiceHit struct {
+	ID      string            `json:"_id"`
+	Score   float64           `json:"_score"`
+	Index   string            `json:"_index"`
+	Type    string            `json:"_type"`
+	Version int64             `json:"_version,omitempty"`
+	Source  KubernetesService `json:"_source"`
+	Sort    []interface{}     `json:"sort"`
+}
+
+type KubernetesServiceHits struct {
+	Total essdk.SearchTotal      `json:"total"`
+	Hits  []KubernetesServiceHit `json:"hits"`
+}
+
+type KubernetesServiceSearchResponse struct {
+	PitID string                `json:"pit_id"`
+	Hits  KubernetesServiceHits `json:"hits"`
+}
+
+type KubernetesServicePaginator struct {
+	paginator *essdk.BaseESPaginator
+}
+
+func (k Client) NewKubernetesServicePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesServicePaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_service", filters, limit)
+	if err != nil {
+		return KubernetesServicePaginator{}, err
+	}
+
+	p := KubernetesServicePaginator{
+		paginator: paginator,
+	}
+
+	return p, nil
+}
+
+func (p KubernetesServicePaginator) HasNext() bool {
+	return !p.paginator.Done()
+}
+
+func (p KubernetesServicePaginator) Close(ctx context.Context) error {
+	return p.paginator.Deallocate(ctx)
+}
+
+func (p KubernetesServicePaginator) NextPage(ctx context.Context) ([]KubernetesService, error) {
+	var response KubernetesServiceSearchResponse
+	err := p.paginator.Search(ctx, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []KubernetesService
+	for _, hit := range response.Hits.Hits {
+		values = append(values, hit.Source)
+	}
+
+	hits := int64(len(response.Hits.Hits))
+	if hits > 0 {
+		p.paginator.UpdateState(hits, response.Hits.Hits[hits-1].Sort, response.PitID)
+	} else {
+		p.paginator.UpdateState(hits, nil, "")
+	}
+
+	return values, nil
+}
+
+var listKubernetesServiceFilters = map[string]string{
+	"allocate_load_balancer_node_ports":  "Description.Service.Spec.AllocateLoadBalancerNodePorts",
+	"cluster_ip":                         "Description.Service.Spec.ClusterIP",
+	"cluster_ips":                        "Description.Service.Spec.ClusterIPs",
+	"external_ips":                       "Description.Service.Spec.ExternalIPs",
+	"external_name":                      "Description.Service.Spec.ExternalName",
+	"health_check_node_port":             "Description.Service.Spec.HealthCheckNodePort",
+	"ip_families":                        "Description.Service.Spec.IPFamilies",
+	"load_balancer_ingress":              "Description.Service.Status.LoadBalancer.Ingress",
+	"load_balancer_ip":                   "Description.Service.Spec.LoadBalancerIP",
+	"load_balancer_source_ranges":        "Description.Service.Spec.LoadBalancerSourceRanges",
+	"platform_integration_id":            "IntegrationID",
+	"ports":                              "Description.Service.Spec.Ports",
+	"publish_not_ready_addresses":        "Description.Service.Spec.PublishNotReadyAddresses",
+	"selector":                           "Description.Service.Spec.Selector",
+	"session_affinity_client_ip_timeout": "Description.Service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds",
+	"title":                              "Description.Service.Name",
+	"topology_keys":                      "Description.Service.Spec.TopologyKeys",
+}
+
+func ListKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesService")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPod NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesService NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPod NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesService NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPod GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesService GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPod GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesService GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPod GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesService GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesPodPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesServicePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesServiceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPod NewKubernetesPodPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesService NewKubernetesServicePaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesPod paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesService paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -4976,58 +14493,30 @@ func ListKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	return nil, nil
 }
 
-var getKubernetesPodFilters = map[string]string{
-	"active_deadline_seconds":          "Description.Pod.Spec.ActiveDeadlineSeconds",
-	"affinity":                         "Description.Pod.Spec.Affinity",
-	"automount_service_account_token":  "Description.Pod.Spec.AutomountServiceAccountToken",
-	"conditions":                       "Description.Pod.Status.Conditions",
-	"container_statuses":               "Description.Pod.Status.ContainerStatuses",
-	"containers":                       "Description.Pod.Spec.Containers",
-	"dns_config":                       "Description.Pod.Spec.DNSConfig",
-	"dns_policy":                       "Description.Pod.Spec.DNSPolicy",
-	"enable_service_links":             "Description.Pod.Spec.EnableServiceLinks",
-	"ephemeral_container_statuses":     "Description.Pod.Status.EphemeralContainerStatuses",
-	"ephemeral_containers":             "Description.Pod.Spec.EphemeralContainers",
-	"host_aliases":                     "Description.Pod.Spec.HostAliases",
-	"host_ip":                          "Description.Pod.Status.HostIP",
-	"host_ipc":                         "Description.Pod.Spec.HostIPC",
-	"host_network":                     "Description.Pod.Spec.HostNetwork",
-	"host_pid":                         "Description.Pod.Spec.HostPID",
-	"hostname":                         "Description.Pod.Spec.Hostname",
-	"image_pull_secrets":               "Description.Pod.Spec.ImagePullSecrets",
-	"init_container_statuses":          "Description.Pod.Status.InitContainerStatuses",
-	"init_containers":                  "Description.Pod.Spec.InitContainers",
-	"node_name":                        "Description.Pod.Spec.NodeName",
-	"node_selector":                    "Description.Pod.Spec.NodeSelector",
-	"nominated_node_name":              "Description.Pod.Status.NominatedNodeName",
-	"overhead":                         "Description.Pod.Spec.Overhead",
-	"phase":                            "Description.Pod.Status.Phase",
-	"pod_ip":                           "Description.Pod.Status.PodIP",
-	"pod_ips":                          "Description.Pod.Status.PodIPs",
-	"preemption_policy":                "Description.Pod.Spec.PreemptionPolicy",
-	"priority":                         "Description.Pod.Spec.Priority",
-	"priority_class_name":              "Description.Pod.Spec.PriorityClassName",
-	"qos_class":                        "Description.Pod.Status.QOSClass",
-	"readiness_gates":                  "Description.Pod.Spec.ReadinessGates",
-	"restart_policy":                   "Description.Pod.Spec.RestartPolicy",
-	"runtime_class_name":               "Description.Pod.Spec.RuntimeClassName",
-	"scheduler_name":                   "Description.Pod.Spec.SchedulerName",
-	"security_context":                 "Description.Pod.Spec.SecurityContext",
-	"service_account_name":             "Description.Pod.Spec.ServiceAccountName",
-	"set_hostname_as_fqdn":             "Description.Pod.Spec.SetHostnameAsFQDN",
-	"share_process_namespace":          "Description.Pod.Spec.ShareProcessNamespace",
-	"status_message":                   "Description.Pod.Status.Message",
-	"status_reason":                    "Description.Pod.Status.Reason",
-	"subdomain":                        "Description.Pod.Spec.Subdomain",
-	"termination_grace_period_seconds": "Description.Pod.Spec.TerminationGracePeriodSeconds",
-	"title":                            "Description.Pod.Name",
-	"tolerations":                      "Description.Pod.Spec.Tolerations",
-	"topology_spread_constraints":      "Description.Pod.Spec.TopologySpreadConstraints",
-	"volumes":                          "Description.Pod.Spec.Volumes",
+var getKubernetesServiceFilters = map[string]string{
+	"allocate_load_balancer_node_ports":  "Description.Service.Spec.AllocateLoadBalancerNodePorts",
+	"cluster_ip":                         "Description.Service.Spec.ClusterIP",
+	"cluster_ips":                        "Description.Service.Spec.ClusterIPs",
+	"external_ips":                       "Description.Service.Spec.ExternalIPs",
+	"external_name":                      "Description.Service.Spec.ExternalName",
+	"health_check_node_port":             "Description.Service.Spec.HealthCheckNodePort",
+	"ip_families":                        "Description.Service.Spec.IPFamilies",
+	"load_balancer_ingress":              "Description.Service.Status.LoadBalancer.Ingress",
+	"load_balancer_ip":                   "Description.Service.Spec.LoadBalancerIP",
+	"load_balancer_source_ranges":        "Description.Service.Spec.LoadBalancerSourceRanges",
+	"name":                               "Description.MetaObject.Name",
+	"namespace":                          "Description.MetaObject.Namespace",
+	"platform_integration_id":            "IntegrationID",
+	"ports":                              "Description.Service.Spec.Ports",
+	"publish_not_ready_addresses":        "Description.Service.Spec.PublishNotReadyAddresses",
+	"selector":                           "Description.Service.Spec.Selector",
+	"session_affinity_client_ip_timeout": "Description.Service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds",
+	"title":                              "Description.Service.Name",
+	"topology_keys":                      "Description.Service.Spec.TopologyKeys",
 }
 
-func GetKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesPod")
+func GetKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesService")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -5055,7 +14544,7 @@ func GetKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesPodPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesServicePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesServiceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -5079,74 +14568,74 @@ func GetKubernetesPod(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	return nil, nil
 }
 
-// ==========================  END: KubernetesPod =============================
+// ==========================  END: KubernetesService =============================
 
-// ==========================  START: KubernetesPodDisruptionBudget =============================
+// ==========================  START: KubernetesServiceAccount =============================
 
-type KubernetesPodDisruptionBudget struct {
-	ResourceID      string                                              `json:"resource_id"`
-	PlatformID      string                                              `json:"platform_id"`
-	Description     kubernetes.KubernetesPodDisruptionBudgetDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                                 `json:"metadata"`
-	DescribedBy     string                                              `json:"described_by"`
-	ResourceType    string                                              `json:"resource_type"`
-	IntegrationType string                                              `json:"integration_type"`
-	IntegrationID   string                                              `json:"integration_id"`
+type KubernetesServiceAccount struct {
+	ResourceID      string                                         `json:"resource_id"`
+	PlatformID      string                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesServiceAccountDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                            `json:"metadata"`
+	DescribedBy     string                                         `json:"described_by"`
+	ResourceType    string                                         `json:"resource_type"`
+	IntegrationType string                                         `json:"integration_type"`
+	IntegrationID   string                                         `json:"integration_id"`
 }
 
-type KubernetesPodDisruptionBudgetHit struct {
-	ID      string                        `json:"_id"`
-	Score   float64                       `json:"_score"`
-	Index   string                        `json:"_index"`
-	Type    string                        `json:"_type"`
-	Version int64                         `json:"_version,omitempty"`
-	Source  KubernetesPodDisruptionBudget `json:"_source"`
-	Sort    []interface{}                 `json:"sort"`
+type KubernetesServiceAccountHit struct {
+	ID      string                   `json:"_id"`
+	Score   float64                  `json:"_score"`
+	Index   string                   `json:"_index"`
+	Type    string                   `json:"_type"`
+	Version int64                    `json:"_version,omitempty"`
+	Source  KubernetesServiceAccount `json:"_source"`
+	Sort    []interface{}            `json:"sort"`
 }
 
-type KubernetesPodDisruptionBudgetHits struct {
-	Total essdk.SearchTotal                  `json:"total"`
-	Hits  []KubernetesPodDisruptionBudgetHit `json:"hits"`
+type KubernetesServiceAccountHits struct {
+	Total essdk.SearchTotal             `json:"total"`
+	Hits  []KubernetesServiceAccountHit `json:"hits"`
 }
 
-type KubernetesPodDisruptionBudgetSearchResponse struct {
-	PitID string                            `json:"pit_id"`
-	Hits  KubernetesPodDisruptionBudgetHits `json:"hits"`
+type KubernetesServiceAccountSearchResponse struct {
+	PitID string                       `json:"pit_id"`
+	Hits  KubernetesServiceAccountHits `json:"hits"`
 }
 
-type KubernetesPodDisruptionBudgetPaginator struct {
+type KubernetesServiceAccountPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesPodDisruptionBudgetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodDisruptionBudgetPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_poddisruptionbudget", filters, limit)
+func (k Client) NewKubernetesServiceAccountPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesServiceAccountPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_serviceaccount", filters, limit)
 	if err != nil {
-		return KubernetesPodDisruptionBudgetPaginator{}, err
+		return KubernetesServiceAccountPaginator{}, err
 	}
 
-	p := KubernetesPodDisruptionBudgetPaginator{
+	p := KubernetesServiceAccountPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesPodDisruptionBudgetPaginator) HasNext() bool {
+func (p KubernetesServiceAccountPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesPodDisruptionBudgetPaginator) Close(ctx context.Context) error {
+func (p KubernetesServiceAccountPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesPodDisruptionBudgetPaginator) NextPage(ctx context.Context) ([]KubernetesPodDisruptionBudget, error) {
-	var response KubernetesPodDisruptionBudgetSearchResponse
+func (p KubernetesServiceAccountPaginator) NextPage(ctx context.Context) ([]KubernetesServiceAccount, error) {
+	var response KubernetesServiceAccountSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesPodDisruptionBudget
+	var values []KubernetesServiceAccount
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -5161,57 +14650,58 @@ func (p KubernetesPodDisruptionBudgetPaginator) NextPage(ctx context.Context) ([
 	return values, nil
 }
 
-var listKubernetesPodDisruptionBudgetFilters = map[string]string{
-	"max_unavailable": "Description.PodDisruptionBudget.Spec.MaxUnavailable",
-	"min_available":   "Description.PodDisruptionBudget.Spec.MinAvailable",
-	"selector":        "Description.PodDisruptionBudget.Spec.Selector",
-	"title":           "Description.PodDisruptionBudget.Name",
+var listKubernetesServiceAccountFilters = map[string]string{
+	"automount_service_account_token": "Description.ServiceAccount.AutomountServiceAccountToken",
+	"image_pull_secrets":              "Description.ServiceAccount.ImagePullSecrets",
+	"platform_integration_id":         "IntegrationID",
+	"secrets":                         "Description.ServiceAccount.Secrets",
+	"title":                           "Description.ServiceAccount.Name",
 }
 
-func ListKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesPodDisruptionBudget")
+func ListKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesServiceAccount")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceAccount NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceAccount NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceAccount GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceAccount GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceAccount GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesPodDisruptionBudgetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodDisruptionBudgetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesServiceAccountPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesServiceAccountFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget NewKubernetesPodDisruptionBudgetPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceAccount NewKubernetesServiceAccountPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesPodDisruptionBudget paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesServiceAccount paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -5228,15 +14718,18 @@ func ListKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData,
 	return nil, nil
 }
 
-var getKubernetesPodDisruptionBudgetFilters = map[string]string{
-	"max_unavailable": "Description.PodDisruptionBudget.Spec.MaxUnavailable",
-	"min_available":   "Description.PodDisruptionBudget.Spec.MinAvailable",
-	"selector":        "Description.PodDisruptionBudget.Spec.Selector",
-	"title":           "Description.PodDisruptionBudget.Name",
+var getKubernetesServiceAccountFilters = map[string]string{
+	"automount_service_account_token": "Description.ServiceAccount.AutomountServiceAccountToken",
+	"image_pull_secrets":              "Description.ServiceAccount.ImagePullSecrets",
+	"name":                            "Description.MetaObject.Name",
+	"namespace":                       "Description.MetaObject.Namespace",
+	"platform_integration_id":         "IntegrationID",
+	"secrets":                         "Description.ServiceAccount.Secrets",
+	"title":                           "Description.ServiceAccount.Name",
 }
 
-func GetKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesPodDisruptionBudget")
+func GetKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesServiceAccount")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -5264,7 +14757,7 @@ func GetKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData,
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesPodDisruptionBudgetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodDisruptionBudgetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesServiceAccountPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesServiceAccountFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -5288,74 +14781,74 @@ func GetKubernetesPodDisruptionBudget(ctx context.Context, d *plugin.QueryData,
 	return nil, nil
 }
 
-// ==========================  END: KubernetesPodDisruptionBudget =============================
+// ==========================  END: KubernetesServiceAccount =============================
 
-// ==========================  START: KubernetesPodTemplate =============================
+// ==========================  START: KubernetesServiceMonitor =============================
 
-type KubernetesPodTemplate struct {
-	ResourceID      string                                      `json:"resource_id"`
-	PlatformID      string                                      `json:"platform_id"`
-	Description     kubernetes.KubernetesPodTemplateDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                         `json:"metadata"`
-	DescribedBy     string                                      `json:"described_by"`
-	ResourceType    string                                      `json:"resource_type"`
-	IntegrationType string                                      `json:"integration_type"`
-	IntegrationID   string                                      `json:"integration_id"`
+type KubernetesServiceMonitor struct {
+	ResourceID      string                                         `json:"resource_id"`
+	PlatformID      string                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesServiceMonitorDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                            `json:"metadata"`
+	DescribedBy     string                                         `json:"described_by"`
+	ResourceType    string                                         `json:"resource_type"`
+	IntegrationType string                                         `json:"integration_type"`
+	IntegrationID   string                                         `json:"integration_id"`
 }
 
-type KubernetesPodTemplateHit struct {
-	ID      string                `json:"_id"`
-	Score   float64               `json:"_score"`
-	Index   string                `json:"_index"`
-	Type    string                `json:"_type"`
-	Version int64                 `json:"_version,omitempty"`
-	Source  KubernetesPodTemplate `json:"_source"`
-	Sort    []interface{}         `json:"sort"`
+type KubernetesServiceMonitorHit struct {
+	ID      string                   `json:"_id"`
+	Score   float64                  `json:"_score"`
+	Index   string                   `json:"_index"`
+	Type    string                   `json:"_type"`
+	Version int64                    `json:"_version,omitempty"`
+	Source  KubernetesServiceMonitor `json:"_source"`
+	Sort    []interface{}            `json:"sort"`
 }
 
-type KubernetesPodTemplateHits struct {
-	Total essdk.SearchTotal          `json:"total"`
-	Hits  []KubernetesPodTemplateHit `json:"hits"`
+type KubernetesServiceMonitorHits struct {
+	Total essdk.SearchTotal             `json:"total"`
+	Hits  []KubernetesServiceMonitorHit `json:"hits"`
 }
 
-type KubernetesPodTemplateSearchResponse struct {
-	PitID string                    `json:"pit_id"`
-	Hits  KubernetesPodTemplateHits `json:"hits"`
+type KubernetesServiceMonitorSearchResponse struct {
+	PitID string                       `json:"pit_id"`
+	Hits  KubernetesServiceMonitorHits `json:"hits"`
 }
 
-type KubernetesPodTemplatePaginator struct {
+type KubernetesServiceMonitorPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesPodTemplatePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesPodTemplatePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_podtemplate", filters, limit)
+func (k Client) NewKubernetesServiceMonitorPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesServiceMonitorPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_servicemonitor", filters, limit)
 	if err != nil {
-		return KubernetesPodTemplatePaginator{}, err
+		return KubernetesServiceMonitorPaginator{}, err
 	}
 
-	p := KubernetesPodTemplatePaginator{
+	p := KubernetesServiceMonitorPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesPodTemplatePaginator) HasNext() bool {
+func (p KubernetesServiceMonitorPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesPodTemplatePaginator) Close(ctx context.Context) error {
+func (p KubernetesServiceMonitorPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesPodTemplatePaginator) NextPage(ctx context.Context) ([]KubernetesPodTemplate, error) {
-	var response KubernetesPodTemplateSearchResponse
+func (p KubernetesServiceMonitorPaginator) NextPage(ctx context.Context) ([]KubernetesServiceMonitor, error) {
+	var response KubernetesServiceMonitorSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesPodTemplate
+	var values []KubernetesServiceMonitor
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -5370,55 +14863,63 @@ func (p KubernetesPodTemplatePaginator) NextPage(ctx context.Context) ([]Kuberne
 	return values, nil
 }
 
-var listKubernetesPodTemplateFilters = map[string]string{
-	"template": "Description.PodTemplate.Template",
-	"title":    "Description.PodTemplate.Name",
+var listKubernetesServiceMonitorFilters = map[string]string{
+	"endpoints":               "Description.ServiceMonitor.Spec.Endpoints",
+	"job_label":               "Description.ServiceMonitor.Spec.JobLabel",
+	"namespace_selector":      "Description.ServiceMonitor.Spec.NamespaceSelector",
+	"platform_integration_id": "IntegrationID",
+	"pod_target_labels":       "Description.ServiceMonitor.Spec.PodTargetLabels",
+	"sample_limit":            "Description.ServiceMonitor.Spec.SampleLimit",
+	"selector":                "Description.ServiceMonitor.Spec.Selector",
+	"selector_query":          "Description.LabelSelectorString",
+	"target_labels":           "Description.ServiceMonitor.Spec.TargetLabels",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func ListKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesPodTemplate")
+func ListKubernetesServiceMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesServiceMonitor")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodTemplate NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceMonitor NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodTemplate NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceMonitor NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodTemplate GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceMonitor GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodTemplate GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceMonitor GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodTemplate GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceMonitor GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesPodTemplatePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesPodTemplateFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesServiceMonitorPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesServiceMonitorFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesPodTemplate NewKubernetesPodTemplatePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesServiceMonitor NewKubernetesServiceMonitorPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesPodTemplate paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesServiceMonitor paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -5435,13 +14936,23 @@ func ListKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plug
 	return nil, nil
 }
 
-var getKubernetesPodTemplateFilters = map[string]string{
-	"template": "Description.PodTemplate.Template",
-	"title":    "Description.PodTemplate.Name",
+var getKubernetesServiceMonitorFilters = map[string]string{
+	"endpoints":               "Description.ServiceMonitor.Spec.Endpoints",
+	"job_label":               "Description.ServiceMonitor.Spec.JobLabel",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"namespace_selector":      "Description.ServiceMonitor.Spec.NamespaceSelector",
+	"platform_integration_id": "IntegrationID",
+	"pod_target_labels":       "Description.ServiceMonitor.Spec.PodTargetLabels",
+	"sample_limit":            "Description.ServiceMonitor.Spec.SampleLimit",
+	"selector":                "Description.ServiceMonitor.Spec.Selector",
+	"selector_query":          "Description.LabelSelectorString",
+	"target_labels":           "Description.ServiceMonitor.Spec.TargetLabels",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func GetKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesPodTemplate")
+func GetKubernetesServiceMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesServiceMonitor")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -5469,7 +14980,7 @@ func GetKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plugi
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesPodTemplatePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesPodTemplateFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesServiceMonitorPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesServiceMonitorFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -5493,74 +15004,74 @@ func GetKubernetesPodTemplate(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-// ==========================  END: KubernetesPodTemplate =============================
+// ==========================  END: KubernetesServiceMonitor =============================
 
-// ==========================  START: KubernetesReplicaSet =============================
+// ==========================  START: KubernetesStatefulSet =============================
 
-type KubernetesReplicaSet struct {
-	ResourceID      string                                     `json:"resource_id"`
-	PlatformID      string                                     `json:"platform_id"`
-	Description     kubernetes.KubernetesReplicaSetDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                        `json:"metadata"`
-	DescribedBy     string                                     `json:"described_by"`
-	ResourceType    string                                     `json:"resource_type"`
-	IntegrationType string                                     `json:"integration_type"`
-	IntegrationID   string                                     `json:"integration_id"`
+type KubernetesStatefulSet struct {
+	ResourceID      string                                      `json:"resource_id"`
+	PlatformID      string                                      `json:"platform_id"`
+	Description     kubernetes.KubernetesStatefulSetDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                         `json:"metadata"`
+	DescribedBy     string                                      `json:"described_by"`
+	ResourceType    string                                      `json:"resource_type"`
+	IntegrationType string                                      `json:"integration_type"`
+	IntegrationID   string                                      `json:"integration_id"`
 }
 
-type KubernetesReplicaSetHit struct {
-	ID      string               `json:"_id"`
-	Score   float64              `json:"_score"`
-	Index   string               `json:"_index"`
-	Type    string               `json:"_type"`
-	Version int64                `json:"_version,omitempty"`
-	Source  KubernetesReplicaSet `json:"_source"`
-	Sort    []interface{}        `json:"sort"`
+type KubernetesStatefulSetHit struct {
+	ID      string                `json:"_id"`
+	Score   float64               `json:"_score"`
+	Index   string                `json:"_index"`
+	Type    string                `json:"_type"`
+	Version int64                 `json:"_version,omitempty"`
+	Source  KubernetesStatefulSet `json:"_source"`
+	Sort    []interface{}         `json:"sort"`
 }
 
-type KubernetesReplicaSetHits struct {
-	Total essdk.SearchTotal         `json:"total"`
-	Hits  []KubernetesReplicaSetHit `json:"hits"`
+type KubernetesStatefulSetHits struct {
+	Total essdk.SearchTotal          `json:"total"`
+	Hits  []KubernetesStatefulSetHit `json:"hits"`
 }
 
-type KubernetesReplicaSetSearchResponse struct {
-	PitID string                   `json:"pit_id"`
-	Hits  KubernetesReplicaSetHits `json:"hits"`
+type KubernetesStatefulSetSearchResponse struct {
+	PitID string                    `json:"pit_id"`
+	Hits  KubernetesStatefulSetHits `json:"hits"`
 }
 
-type KubernetesReplicaSetPaginator struct {
+type KubernetesStatefulSetPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesReplicaSetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesReplicaSetPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_replicaset", filters, limit)
+func (k Client) NewKubernetesStatefulSetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesStatefulSetPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_statefulset", filters, limit)
 	if err != nil {
-		return KubernetesReplicaSetPaginator{}, err
+		return KubernetesStatefulSetPaginator{}, err
 	}
 
-	p := KubernetesReplicaSetPaginator{
+	p := KubernetesStatefulSetPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesReplicaSetPaginator) HasNext() bool {
+func (p KubernetesStatefulSetPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesReplicaSetPaginator) Close(ctx context.Context) error {
+func (p KubernetesStatefulSetPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesReplicaSetPaginator) NextPage(ctx context.Context) ([]KubernetesReplicaSet, error) {
-	var response KubernetesReplicaSetSearchResponse
+func (p KubernetesStatefulSetPaginator) NextPage(ctx context.Context) ([]KubernetesStatefulSet, error) {
+	var response KubernetesStatefulSetSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesReplicaSet
+	var values []KubernetesStatefulSet
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -5575,65 +15086,69 @@ func (p KubernetesReplicaSetPaginator) NextPage(ctx context.Context) ([]Kubernet
 	return values, nil
 }
 
-var listKubernetesReplicaSetFilters = map[string]string{
-	"available_replicas":     "Description.ReplicaSet.Status.AvailableReplicas",
-	"conditions":             "Description.ReplicaSet.Status.Conditions",
-	"fully_labeled_replicas": "Description.ReplicaSet.Status.FullyLabeledReplicas",
-	"min_ready_seconds":      "Description.ReplicaSet.Spec.MinReadySeconds",
-	"observed_generation":    "Description.ReplicaSet.Status.ObservedGeneration",
-	"ready_replicas":         "Description.ReplicaSet.Status.ReadyReplicas",
-	"replicas":               "Description.ReplicaSet.Spec.Replicas",
-	"selector":               "Description.ReplicaSet.Spec.Selector",
-	"selector_query":         "Description.LabelSelectorString",
-	"status_replicas":        "Description.ReplicaSet.Status.Replicas",
-	"template":               "Description.ReplicaSet.Spec.Template",
-	"title":                  "Description.ReplicaSet.Name",
+var listKubernetesStatefulSetFilters = map[string]string{
+	"available_replicas":      "Description.StatefulSet.Status.AvailableReplicas",
+	"collision_count":         "Description.StatefulSet.Status.CollisionCount",
+	"conditions":              "Description.StatefulSet.Status.Conditions",
+	"current_replicas":        "Description.StatefulSet.Status.CurrentReplicas",
+	"current_revision":        "Description.StatefulSet.Status.CurrentRevision",
+	"observed_generation":     "Description.StatefulSet.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"ready_replicas":          "Description.StatefulSet.Status.ReadyReplicas",
+	"replicas":                "Description.StatefulSet.Spec.Replicas",
+	"revision_history_limit":  "Description.StatefulSet.Spec.RevisionHistoryLimit",
+	"service_name":            "Description.StatefulSet.Spec.ServiceName",
+	"template":                "Description.StatefulSet.Spec.Template",
+	"title":                   "Description.StatefulSet.Name",
+	"update_revision":         "Description.StatefulSet.Status.UpdateRevision",
+	"update_strategy":         "Description.StatefulSet.Spec.UpdateStrategy",
+	"updated_replicas":        "Description.StatefulSet.Status.UpdatedReplicas",
 }
 
-func ListKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesReplicaSet")
+func ListKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesStatefulSet")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicaSet NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStatefulSet NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicaSet NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStatefulSet NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicaSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStatefulSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicaSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStatefulSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicaSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStatefulSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesReplicaSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesReplicaSetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesStatefulSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesStatefulSetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicaSet NewKubernetesReplicaSetPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStatefulSet NewKubernetesStatefulSetPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesReplicaSet paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesStatefulSet paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -5650,23 +15165,29 @@ func ListKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-var getKubernetesReplicaSetFilters = map[string]string{
-	"available_replicas":     "Description.ReplicaSet.Status.AvailableReplicas",
-	"conditions":             "Description.ReplicaSet.Status.Conditions",
-	"fully_labeled_replicas": "Description.ReplicaSet.Status.FullyLabeledReplicas",
-	"min_ready_seconds":      "Description.ReplicaSet.Spec.MinReadySeconds",
-	"observed_generation":    "Description.ReplicaSet.Status.ObservedGeneration",
-	"ready_replicas":         "Description.ReplicaSet.Status.ReadyReplicas",
-	"replicas":               "Description.ReplicaSet.Spec.Replicas",
-	"selector":               "Description.ReplicaSet.Spec.Selector",
-	"selector_query":         "Description.LabelSelectorString",
-	"status_replicas":        "Description.ReplicaSet.Status.Replicas",
-	"template":               "Description.ReplicaSet.Spec.Template",
-	"title":                  "Description.ReplicaSet.Name",
+var getKubernetesStatefulSetFilters = map[string]string{
+	"available_replicas":      "Description.StatefulSet.Status.AvailableReplicas",
+	"collision_count":         "Description.StatefulSet.Status.CollisionCount",
+	"conditions":              "Description.StatefulSet.Status.Conditions",
+	"current_replicas":        "Description.StatefulSet.Status.CurrentReplicas",
+	"current_revision":        "Description.StatefulSet.Status.CurrentRevision",
+	"name":                    "Description.MetaObject.Name",
+	"namespace":               "Description.MetaObject.Namespace",
+	"observed_generation":     "Description.StatefulSet.Status.ObservedGeneration",
+	"platform_integration_id": "IntegrationID",
+	"ready_replicas":          "Description.StatefulSet.Status.ReadyReplicas",
+	"replicas":                "Description.StatefulSet.Spec.Replicas",
+	"revision_history_limit":  "Description.StatefulSet.Spec.RevisionHistoryLimit",
+	"service_name":            "Description.StatefulSet.Spec.ServiceName",
+	"template":                "Description.StatefulSet.Spec.Template",
+	"title":                   "Description.StatefulSet.Name",
+	"update_revision":         "Description.StatefulSet.Status.UpdateRevision",
+	"update_strategy":         "Description.StatefulSet.Spec.UpdateStrategy",
+	"updated_replicas":        "Description.StatefulSet.Status.UpdatedReplicas",
 }
 
-func GetKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesReplicaSet")
+func GetKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesStatefulSet")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -5694,7 +15215,7 @@ func GetKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugin
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesReplicaSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesReplicaSetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesStatefulSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesStatefulSetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -5718,74 +15239,74 @@ func GetKubernetesReplicaSet(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return nil, nil
 }
 
-// ==========================  END: KubernetesReplicaSet =============================
+// ==========================  END: KubernetesStatefulSet =============================
 
-// ==========================  START: KubernetesReplicationController =============================
+// ==========================  START: KubernetesStorageClass =============================
 
-type KubernetesReplicationController struct {
-	ResourceID      string                                                `json:"resource_id"`
-	PlatformID      string                                                `json:"platform_id"`
-	Description     kubernetes.KubernetesReplicationControllerDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                                   `json:"metadata"`
-	DescribedBy     string                                                `json:"described_by"`
-	ResourceType    string                                                `json:"resource_type"`
-	IntegrationType string                                                `json:"integration_type"`
-	IntegrationID   string                                                `json:"integration_id"`
+type KubernetesStorageClass struct {
+	ResourceID      string                                       `json:"resource_id"`
+	PlatformID      string                                       `json:"platform_id"`
+	Description     kubernetes.KubernetesStorageClassDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                          `json:"metadata"`
+	DescribedBy     string                                       `json:"described_by"`
+	ResourceType    string                                       `json:"resource_type"`
+	IntegrationType string                                       `json:"integration_type"`
+	IntegrationID   string                                       `json:"integration_id"`
 }
 
-type KubernetesReplicationControllerHit struct {
-	ID      string                          `json:"_id"`
-	Score   float64                         `json:"_score"`
-	Index   string                          `json:"_index"`
-	Type    string                          `json:"_type"`
-	Version int64                           `json:"_version,omitempty"`
-	Source  KubernetesReplicationController `json:"_source"`
-	Sort    []interface{}                   `json:"sort"`
+type KubernetesStorageClassHit struct {
+	ID      string                 `json:"_id"`
+	Score   float64                `json:"_score"`
+	Index   string                 `json:"_index"`
+	Type    string                 `json:"_type"`
+	Version int64                  `json:"_version,omitempty"`
+	Source  KubernetesStorageClass `json:"_source"`
+	Sort    []interface{}          `json:"sort"`
 }
 
-type KubernetesReplicationControllerHits struct {
-	Total essdk.SearchTotal                    `json:"total"`
-	Hits  []KubernetesReplicationControllerHit `json:"hits"`
+type KubernetesStorageClassHits struct {
+	Total essdk.SearchTotal           `json:"total"`
+	Hits  []KubernetesStorageClassHit `json:"hits"`
 }
 
-type KubernetesReplicationControllerSearchResponse struct {
-	PitID string                              `json:"pit_id"`
-	Hits  KubernetesReplicationControllerHits `json:"hits"`
+type KubernetesStorageClassSearchResponse struct {
+	PitID string                     `json:"pit_id"`
+	Hits  KubernetesStorageClassHits `json:"hits"`
 }
 
-type KubernetesReplicationControllerPaginator struct {
+type KubernetesStorageClassPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesReplicationControllerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesReplicationControllerPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_replicationcontroller", filters, limit)
+func (k Client) NewKubernetesStorageClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesStorageClassPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_storageclass", filters, limit)
 	if err != nil {
-		return KubernetesReplicationControllerPaginator{}, err
+		return KubernetesStorageClassPaginator{}, err
 	}
 
-	p := KubernetesReplicationControllerPaginator{
+	p := KubernetesStorageClassPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesReplicationControllerPaginator) HasNext() bool {
+func (p KubernetesStorageClassPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesReplicationControllerPaginator) Close(ctx context.Context) error {
+func (p KubernetesStorageClassPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesReplicationControllerPaginator) NextPage(ctx context.Context) ([]KubernetesReplicationController, error) {
-	var response KubernetesReplicationControllerSearchResponse
+func (p KubernetesStorageClassPaginator) NextPage(ctx context.Context) ([]KubernetesStorageClass, error) {
+	var response KubernetesStorageClassSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesReplicationController
+	var values []KubernetesStorageClass
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -5800,64 +15321,62 @@ func (p KubernetesReplicationControllerPaginator) NextPage(ctx context.Context)
 	return values, nil
 }
 
-var listKubernetesReplicationControllerFilters = map[string]string{
-	"available_replicas":     "Description.ReplicationController.Status.AvailableReplicas",
-	"conditions":             "Description.ReplicationController.Status.Conditions",
-	"fully_labeled_replicas": "Description.ReplicationController.Status.FullyLabeledReplicas",
-	"min_ready_seconds":      "Description.ReplicationController.Spec.MinReadySeconds",
-	"observed_generation":    "Description.ReplicationController.Status.ObservedGeneration",
-	"ready_replicas":         "Description.ReplicationController.Status.ReadyReplicas",
-	"replicas":               "Description.ReplicationController.Spec.Replicas",
-	"selector":               "Description.ReplicationController.Spec.Selector",
-	"status_replicas":        "Description.ReplicationController.Status.Replicas",
-	"template":               "Description.ReplicationController.Spec.Template",
-	"title":                  "Description.ReplicationController.Name",
+var listKubernetesStorageClassFilters = map[string]string{
+	"allow_volume_expansion":  "Description.StorageClass.AllowVolumeExpansion",
+	"allowed_topologies":      "Description.StorageClass.AllowedTopologies",
+	"mount_options":           "Description.StorageClass.MountOptions",
+	"parameters":              "Description.StorageClass.Parameters",
+	"platform_integration_id": "IntegrationID",
+	"provisioner":             "Description.StorageClass.Provisioner",
+	"reclaim_policy":          "Description.StorageClass.ReclaimPolicy",
+	"title":                   "Description.StorageClass.Name",
+	"volume_binding_mode":     "Description.StorageClass.VolumeBindingMode",
 }
 
-func ListKubernetesReplicationController(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesReplicationController")
+func ListKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesStorageClass")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicationController NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStorageClass NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicationController NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStorageClass NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicationController GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStorageClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicationController GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStorageClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicationController GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStorageClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesReplicationControllerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesReplicationControllerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesStorageClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesStorageClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesReplicationController NewKubernetesReplicationControllerPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesStorageClass NewKubernetesStorageClassPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesReplicationController paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesStorageClass paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -5874,22 +15393,21 @@ func ListKubernetesReplicationController(ctx context.Context, d *plugin.QueryDat
 	return nil, nil
 }
 
-var getKubernetesReplicationControllerFilters = map[string]string{
-	"available_replicas":     "Description.ReplicationController.Status.AvailableReplicas",
-	"conditions":             "Description.ReplicationController.Status.Conditions",
-	"fully_labeled_replicas": "Description.ReplicationController.Status.FullyLabeledReplicas",
-	"min_ready_seconds":      "Description.ReplicationController.Spec.MinReadySeconds",
-	"observed_generation":    "Description.ReplicationController.Status.ObservedGeneration",
-	"ready_replicas":         "Description.ReplicationController.Status.ReadyReplicas",
-	"replicas":               "Description.ReplicationController.Spec.Replicas",
-	"selector":               "Description.ReplicationController.Spec.Selector",
-	"status_replicas":        "Description.ReplicationController.Status.Replicas",
-	"template":               "Description.ReplicationController.Spec.Template",
-	"title":                  "Description.ReplicationController.Name",
+var getKubernetesStorageClassFilters = map[string]string{
+	"allow_volume_expansion":  "Description.StorageClass.AllowVolumeExpansion",
+	"allowed_topologies":      "Description.StorageClass.AllowedTopologies",
+	"mount_options":           "Description.StorageClass.MountOptions",
+	"name":                    "Description.MetaObject.Name",
+	"parameters":              "Description.StorageClass.Parameters",
+	"platform_integration_id": "IntegrationID",
+	"provisioner":             "Description.StorageClass.Provisioner",
+	"reclaim_policy":          "Description.StorageClass.ReclaimPolicy",
+	"title":                   "Description.StorageClass.Name",
+	"volume_binding_mode":     "Description.StorageClass.VolumeBindingMode",
 }
 
-func GetKubernetesReplicationController(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesReplicationController")
+func GetKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesStorageClass")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -5917,7 +15435,7 @@ func GetKubernetesReplicationController(ctx context.Context, d *plugin.QueryData
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesReplicationControllerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesReplicationControllerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesStorageClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesStorageClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -5941,74 +15459,74 @@ func GetKubernetesReplicationController(ctx context.Context, d *plugin.QueryData
 	return nil, nil
 }
 
-// ==========================  END: KubernetesReplicationController =============================
+// ==========================  END: KubernetesStorageClass =============================
 
-// ==========================  START: KubernetesResourceQuota =============================
+// ==========================  START: KubernetesValidatingAdmissionPolicy =============================
 
-type KubernetesResourceQuota struct {
-	ResourceID      string                                        `json:"resource_id"`
-	PlatformID      string                                        `json:"platform_id"`
-	Description     kubernetes.KubernetesResourceQuotaDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                           `json:"metadata"`
-	DescribedBy     string                                        `json:"described_by"`
-	ResourceType    string                                        `json:"resource_type"`
-	IntegrationType string                                        `json:"integration_type"`
-	IntegrationID   string                                        `json:"integration_id"`
+type KubernetesValidatingAdmissionPolicy struct {
+	ResourceID      string                                                    `json:"resource_id"`
+	PlatformID      string                                                    `json:"platform_id"`
+	Description     kubernetes.KubernetesValidatingAdmissionPolicyDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                       `json:"metadata"`
+	DescribedBy     string                                                    `json:"described_by"`
+	ResourceType    string                                                    `json:"resource_type"`
+	IntegrationType string                                                    `json:"integration_type"`
+	IntegrationID   string                                                    `json:"integration_id"`
 }
 
-type KubernetesResourceQuotaHit struct {
-	ID      string                  `json:"_id"`
-	Score   float64                 `json:"_score"`
-	Index   string                  `json:"_index"`
-	Type    string                  `json:"_type"`
-	Version int64                   `json:"_version,omitempty"`
-	Source  KubernetesResourceQuota `json:"_source"`
-	Sort    []interface{}           `json:"sort"`
+type KubernetesValidatingAdmissionPolicyHit struct {
+	ID      string                              `json:"_id"`
+	Score   float64                             `json:"_score"`
+	Index   string                              `json:"_index"`
+	Type    string                              `json:"_type"`
+	Version int64                               `json:"_version,omitempty"`
+	Source  KubernetesValidatingAdmissionPolicy `json:"_source"`
+	Sort    []interface{}                       `json:"sort"`
 }
 
-type KubernetesResourceQuotaHits struct {
-	Total essdk.SearchTotal            `json:"total"`
-	Hits  []KubernetesResourceQuotaHit `json:"hits"`
+type KubernetesValidatingAdmissionPolicyHits struct {
+	Total essdk.SearchTotal                        `json:"total"`
+	Hits  []KubernetesValidatingAdmissionPolicyHit `json:"hits"`
 }
 
-type KubernetesResourceQuotaSearchResponse struct {
-	PitID string                      `json:"pit_id"`
-	Hits  KubernetesResourceQuotaHits `json:"hits"`
+type KubernetesValidatingAdmissionPolicySearchResponse struct {
+	PitID string                                  `json:"pit_id"`
+	Hits  KubernetesValidatingAdmissionPolicyHits `json:"hits"`
 }
 
-type KubernetesResourceQuotaPaginator struct {
+type KubernetesValidatingAdmissionPolicyPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesResourceQuotaPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesResourceQuotaPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_ressourcequota", filters, limit)
+func (k Client) NewKubernetesValidatingAdmissionPolicyPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesValidatingAdmissionPolicyPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_validatingadmissionpolicy", filters, limit)
 	if err != nil {
-		return KubernetesResourceQuotaPaginator{}, err
+		return KubernetesValidatingAdmissionPolicyPaginator{}, err
 	}
 
-	p := KubernetesResourceQuotaPaginator{
+	p := KubernetesValidatingAdmissionPolicyPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesResourceQuotaPaginator) HasNext() bool {
+func (p KubernetesValidatingAdmissionPolicyPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesResourceQuotaPaginator) Close(ctx context.Context) error {
+func (p KubernetesValidatingAdmissionPolicyPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesResourceQuotaPaginator) NextPage(ctx context.Context) ([]KubernetesResourceQuota, error) {
-	var response KubernetesResourceQuotaSearchResponse
+func (p KubernetesValidatingAdmissionPolicyPaginator) NextPage(ctx context.Context) ([]KubernetesValidatingAdmissionPolicy, error) {
+	var response KubernetesValidatingAdmissionPolicySearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesResourceQuota
+	var values []KubernetesValidatingAdmissionPolicy
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -6023,59 +15541,67 @@ func (p KubernetesResourceQuotaPaginator) NextPage(ctx context.Context) ([]Kuber
 	return values, nil
 }
 
-var listKubernetesResourceQuotaFilters = map[string]string{
-	"spec_hard":           "Description.ResourceQuota.Spec.Hard",
-	"spec_scope_selector": "Description.ResourceQuota.Spec.ScopeSelector",
-	"spec_scopes":         "Description.ResourceQuota.Spec.Scopes",
-	"status_hard":         "Description.ResourceQuota.Status.Hard",
-	"status_used":         "Description.ResourceQuota.Status.Used",
-	"title":               "Description.ResourceQuota.Name",
-}
-
-func ListKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesResourceQuota")
+var listKubernetesValidatingAdmissionPolicyFilters = map[string]string{
+	"audit_annotations":       "Description.ValidatingAdmissionPolicy.Spec.AuditAnnotations",
+	"conditions":              "Description.ValidatingAdmissionPolicy.Status.Conditions",
+	"failure_policy":          "Description.ValidatingAdmissionPolicy.Spec.FailurePolicy",
+	"match_conditions":        "Description.ValidatingAdmissionPolicy.Spec.MatchConditions",
+	"match_constraints":       "Description.ValidatingAdmissionPolicy.Spec.MatchConstraints",
+	"namespace_selector":      "Description.ValidatingAdmissionPolicy.Spec.MatchConstraints.NamespaceSelector",
+	"object_selector":         "Description.ValidatingAdmissionPolicy.Spec.MatchConstraints.ObjectSelector",
+	"observed_generation":     "Description.ValidatingAdmissionPolicy.Status.ObservedGeneration",
+	"param_kind":              "Description.ValidatingAdmissionPolicy.Spec.ParamKind",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"type_checking":           "Description.ValidatingAdmissionPolicy.Status.TypeChecking",
+	"validations":             "Description.ValidatingAdmissionPolicy.Spec.Validations",
+	"variables":               "Description.ValidatingAdmissionPolicy.Spec.Variables",
+}
+
+func ListKubernetesValidatingAdmissionPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesValidatingAdmissionPolicy")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesResourceQuota NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesResourceQuota NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesResourceQuota GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesResourceQuota GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesResourceQuota GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesResourceQuotaPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesResourceQuotaFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesValidatingAdmissionPolicyPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesValidatingAdmissionPolicyFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesResourceQuota NewKubernetesResourceQuotaPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy NewKubernetesValidatingAdmissionPolicyPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesResourceQuota paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicy paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -6092,17 +15618,26 @@ func ListKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *pl
 	return nil, nil
 }
 
-var getKubernetesResourceQuotaFilters = map[string]string{
-	"spec_hard":           "Description.ResourceQuota.Spec.Hard",
-	"spec_scope_selector": "Description.ResourceQuota.Spec.ScopeSelector",
-	"spec_scopes":         "Description.ResourceQuota.Spec.Scopes",
-	"status_hard":         "Description.ResourceQuota.Status.Hard",
-	"status_used":         "Description.ResourceQuota.Status.Used",
-	"title":               "Description.ResourceQuota.Name",
-}
-
-func GetKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesResourceQuota")
+var getKubernetesValidatingAdmissionPolicyFilters = map[string]string{
+	"audit_annotations":       "Description.ValidatingAdmissionPolicy.Spec.AuditAnnotations",
+	"conditions":              "Description.ValidatingAdmissionPolicy.Status.Conditions",
+	"failure_policy":          "Description.ValidatingAdmissionPolicy.Spec.FailurePolicy",
+	"match_conditions":        "Description.ValidatingAdmissionPolicy.Spec.MatchConditions",
+	"match_constraints":       "Description.ValidatingAdmissionPolicy.Spec.MatchConstraints",
+	"name":                    "Description.MetaObject.Name",
+	"namespace_selector":      "Description.ValidatingAdmissionPolicy.Spec.MatchConstraints.NamespaceSelector",
+	"object_selector":         "Description.ValidatingAdmissionPolicy.Spec.MatchConstraints.ObjectSelector",
+	"observed_generation":     "Description.ValidatingAdmissionPolicy.Status.ObservedGeneration",
+	"param_kind":              "Description.ValidatingAdmissionPolicy.Spec.ParamKind",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"type_checking":           "Description.ValidatingAdmissionPolicy.Status.TypeChecking",
+	"validations":             "Description.ValidatingAdmissionPolicy.Spec.Validations",
+	"variables":               "Description.ValidatingAdmissionPolicy.Spec.Variables",
+}
+
+func GetKubernetesValidatingAdmissionPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesValidatingAdmissionPolicy")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -6130,7 +15665,7 @@ func GetKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *plu
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesResourceQuotaPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesResourceQuotaFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesValidatingAdmissionPolicyPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesValidatingAdmissionPolicyFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -6154,74 +15689,74 @@ func GetKubernetesResourceQuota(ctx context.Context, d *plugin.QueryData, _ *plu
 	return nil, nil
 }
 
-// ==========================  END: KubernetesResourceQuota =============================
+// ==========================  END: KubernetesValidatingAdmissionPolicy =============================
 
-// ==========================  START: KubernetesRole =============================
+// ==========================  START: KubernetesValidatingAdmissionPolicyBinding =============================
 
-type KubernetesRole struct {
-	ResourceID      string                               `json:"resource_id"`
-	PlatformID      string                               `json:"platform_id"`
-	Description     kubernetes.KubernetesRoleDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                  `json:"metadata"`
-	DescribedBy     string                               `json:"described_by"`
-	ResourceType    string                               `json:"resource_type"`
-	IntegrationType string                               `json:"integration_type"`
-	IntegrationID   string                               `json:"integration_id"`
+type KubernetesValidatingAdmissionPolicyBinding struct {
+	ResourceID      string                                                           `json:"resource_id"`
+	PlatformID      string                                                           `json:"platform_id"`
+	Description     kubernetes.KubernetesValidatingAdmissionPolicyBindingDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                              `json:"metadata"`
+	DescribedBy     string                                                           `json:"described_by"`
+	ResourceType    string                                                           `json:"resource_type"`
+	IntegrationType string                                                           `json:"integration_type"`
+	IntegrationID   string                                                           `json:"integration_id"`
 }
 
-type KubernetesRoleHit struct {
-	ID      string         `json:"_id"`
-	Score   float64        `json:"_score"`
-	Index   string         `json:"_index"`
-	Type    string         `json:"_type"`
-	Version int64          `json:"_version,omitempty"`
-	Source  KubernetesRole `json:"_source"`
-	Sort    []interface{}  `json:"sort"`
+type KubernetesValidatingAdmissionPolicyBindingHit struct {
+	ID      string                                     `json:"_id"`
+	Score   float64                                    `json:"_score"`
+	Index   string                                     `json:"_index"`
+	Type    string                                     `json:"_type"`
+	Version int64                                      `json:"_version,omitempty"`
+	Source  KubernetesValidatingAdmissionPolicyBinding `json:"_source"`
+	Sort    []interface{}                              `json:"sort"`
 }
 
-type KubernetesRoleHits struct {
-	Total essdk.SearchTotal   `json:"total"`
-	Hits  []KubernetesRoleHit `json:"hits"`
+type KubernetesValidatingAdmissionPolicyBindingHits struct {
+	Total essdk.SearchTotal                               `json:"total"`
+	Hits  []KubernetesValidatingAdmissionPolicyBindingHit `json:"hits"`
 }
 
-type KubernetesRoleSearchResponse struct {
-	PitID string             `json:"pit_id"`
-	Hits  KubernetesRoleHits `json:"hits"`
+type KubernetesValidatingAdmissionPolicyBindingSearchResponse struct {
+	PitID string                                         `json:"pit_id"`
+	Hits  KubernetesValidatingAdmissionPolicyBindingHits `json:"hits"`
 }
 
-type KubernetesRolePaginator struct {
+type KubernetesValidatingAdmissionPolicyBindingPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesRolePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesRolePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_role", filters, limit)
+func (k Client) NewKubernetesValidatingAdmissionPolicyBindingPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesValidatingAdmissionPolicyBindingPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_validatingadmissionpolicybinding", filters, limit)
 	if err != nil {
-		return KubernetesRolePaginator{}, err
+		return KubernetesValidatingAdmissionPolicyBindingPaginator{}, err
 	}
 
-	p := KubernetesRolePaginator{
+	p := KubernetesValidatingAdmissionPolicyBindingPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesRolePaginator) HasNext() bool {
+func (p KubernetesValidatingAdmissionPolicyBindingPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesRolePaginator) Close(ctx context.Context) error {
+func (p KubernetesValidatingAdmissionPolicyBindingPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesRolePaginator) NextPage(ctx context.Context) ([]KubernetesRole, error) {
-	var response KubernetesRoleSearchResponse
+func (p KubernetesValidatingAdmissionPolicyBindingPaginator) NextPage(ctx context.Context) ([]KubernetesValidatingAdmissionPolicyBinding, error) {
+	var response KubernetesValidatingAdmissionPolicyBindingSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesRole
+	var values []KubernetesValidatingAdmissionPolicyBinding
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -6236,55 +15771,61 @@ func (p KubernetesRolePaginator) NextPage(ctx context.Context) ([]KubernetesRole
 	return values, nil
 }
 
-var listKubernetesRoleFilters = map[string]string{
-	"rules": "Description.Role.Rules",
-	"title": "Description.Role.Name",
+var listKubernetesValidatingAdmissionPolicyBindingFilters = map[string]string{
+	"match_resources":         "Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources",
+	"namespace_selector":      "Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources.NamespaceSelector",
+	"object_selector":         "Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources.ObjectSelector",
+	"param_ref":               "Description.ValidatingAdmissionPolicyBinding.Spec.ParamRef",
+	"platform_integration_id": "IntegrationID",
+	"policy_name":             "Description.ValidatingAdmissionPolicyBinding.Spec.PolicyName",
+	"title":                   "Description.MetaObject.Name",
+	"validation_actions":      "Description.ValidatingAdmissionPolicyBinding.Spec.ValidationActions",
 }
 
-func ListKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesRole")
+func ListKubernetesValidatingAdmissionPolicyBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesValidatingAdmissionPolicyBinding")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRole NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRole NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRole GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesValidatingAdmissionPolicyBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesValidatingAdmissionPolicyBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRole NewKubernetesRolePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding NewKubernetesValidatingAdmissionPolicyBindingPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesRole paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesValidatingAdmissionPolicyBinding paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -6301,13 +15842,20 @@ func ListKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	return nil, nil
 }
 
-var getKubernetesRoleFilters = map[string]string{
-	"rules": "Description.Role.Rules",
-	"title": "Description.Role.Name",
+var getKubernetesValidatingAdmissionPolicyBindingFilters = map[string]string{
+	"match_resources":         "Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources",
+	"name":                    "Description.MetaObject.Name",
+	"namespace_selector":      "Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources.NamespaceSelector",
+	"object_selector":         "Description.ValidatingAdmissionPolicyBinding.Spec.MatchResources.ObjectSelector",
+	"param_ref":               "Description.ValidatingAdmissionPolicyBinding.Spec.ParamRef",
+	"platform_integration_id": "IntegrationID",
+	"policy_name":             "Description.ValidatingAdmissionPolicyBinding.Spec.PolicyName",
+	"title":                   "Description.MetaObject.Name",
+	"validation_actions":      "Description.ValidatingAdmissionPolicyBinding.Spec.ValidationActions",
 }
 
-func GetKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesRole")
+func GetKubernetesValidatingAdmissionPolicyBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesValidatingAdmissionPolicyBinding")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -6335,7 +15883,7 @@ func GetKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesRolePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesRoleFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesValidatingAdmissionPolicyBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesValidatingAdmissionPolicyBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -6359,74 +15907,74 @@ func GetKubernetesRole(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	return nil, nil
 }
 
-// ==========================  END: KubernetesRole =============================
+// ==========================  END: KubernetesValidatingAdmissionPolicyBinding =============================
 
-// ==========================  START: KubernetesRoleBinding =============================
+// ==========================  START: KubernetesValidatingWebhookConfiguration =============================
 
-type KubernetesRoleBinding struct {
-	ResourceID      string                                      `json:"resource_id"`
-	PlatformID      string                                      `json:"platform_id"`
-	Description     kubernetes.KubernetesRoleBindingDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                         `json:"metadata"`
-	DescribedBy     string                                      `json:"described_by"`
-	ResourceType    string                                      `json:"resource_type"`
-	IntegrationType string                                      `json:"integration_type"`
-	IntegrationID   string                                      `json:"integration_id"`
+type KubernetesValidatingWebhookConfiguration struct {
+	ResourceID      string                                                         `json:"resource_id"`
+	PlatformID      string                                                         `json:"platform_id"`
+	Description     kubernetes.KubernetesValidatingWebhookConfigurationDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                            `json:"metadata"`
+	DescribedBy     string                                                         `json:"described_by"`
+	ResourceType    string                                                         `json:"resource_type"`
+	IntegrationType string                                                         `json:"integration_type"`
+	IntegrationID   string                                                         `json:"integration_id"`
 }
 
-type KubernetesRoleBindingHit struct {
-	ID      string                `json:"_id"`
-	Score   float64               `json:"_score"`
-	Index   string                `json:"_index"`
-	Type    string                `json:"_type"`
-	Version int64                 `json:"_version,omitempty"`
-	Source  KubernetesRoleBinding `json:"_source"`
-	Sort    []interface{}         `json:"sort"`
+type KubernetesValidatingWebhookConfigurationHit struct {
+	ID      string                                   `json:"_id"`
+	Score   float64                                  `json:"_score"`
+	Index   string                                   `json:"_index"`
+	Type    string                                   `json:"_type"`
+	Version int64                                    `json:"_version,omitempty"`
+	Source  KubernetesValidatingWebhookConfiguration `json:"_source"`
+	Sort    []interface{}                            `json:"sort"`
 }
 
-type KubernetesRoleBindingHits struct {
-	Total essdk.SearchTotal          `json:"total"`
-	Hits  []KubernetesRoleBindingHit `json:"hits"`
+type KubernetesValidatingWebhookConfigurationHits struct {
+	Total essdk.SearchTotal                             `json:"total"`
+	Hits  []KubernetesValidatingWebhookConfigurationHit `json:"hits"`
 }
 
-type KubernetesRoleBindingSearchResponse struct {
-	PitID string                    `json:"pit_id"`
-	Hits  KubernetesRoleBindingHits `json:"hits"`
+type KubernetesValidatingWebhookConfigurationSearchResponse struct {
+	PitID string                                       `json:"pit_id"`
+	Hits  KubernetesValidatingWebhookConfigurationHits `json:"hits"`
 }
 
-type KubernetesRoleBindingPaginator struct {
+type KubernetesValidatingWebhookConfigurationPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesRoleBindingPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesRoleBindingPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_rolebinding", filters, limit)
+func (k Client) NewKubernetesValidatingWebhookConfigurationPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesValidatingWebhookConfigurationPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_validatingwebhookconfiguration", filters, limit)
 	if err != nil {
-		return KubernetesRoleBindingPaginator{}, err
+		return KubernetesValidatingWebhookConfigurationPaginator{}, err
 	}
 
-	p := KubernetesRoleBindingPaginator{
+	p := KubernetesValidatingWebhookConfigurationPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesRoleBindingPaginator) HasNext() bool {
+func (p KubernetesValidatingWebhookConfigurationPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesRoleBindingPaginator) Close(ctx context.Context) error {
+func (p KubernetesValidatingWebhookConfigurationPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesRoleBindingPaginator) NextPage(ctx context.Context) ([]KubernetesRoleBinding, error) {
-	var response KubernetesRoleBindingSearchResponse
+func (p KubernetesValidatingWebhookConfigurationPaginator) NextPage(ctx context.Context) ([]KubernetesValidatingWebhookConfiguration, error) {
+	var response KubernetesValidatingWebhookConfigurationSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesRoleBinding
+	var values []KubernetesValidatingWebhookConfiguration
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -6441,58 +15989,56 @@ func (p KubernetesRoleBindingPaginator) NextPage(ctx context.Context) ([]Kuberne
 	return values, nil
 }
 
-var listKubernetesRoleBindingFilters = map[string]string{
-	"role_api_group": "Description.RoleBinding.RoleRef.APIGroup",
-	"role_kind":      "Description.RoleBinding.RoleRef.Kind",
-	"role_name":      "Description.RoleBinding.RoleRef.Name",
-	"subjects":       "Description.RoleBinding.Subjects",
-	"title":          "Description.RoleBinding.Name",
+var listKubernetesValidatingWebhookConfigurationFilters = map[string]string{
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"webhooks":                "Description.ValidatingWebhookConfiguration.Webhooks",
 }
 
-func ListKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesRoleBinding")
+func ListKubernetesValidatingWebhookConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesValidatingWebhookConfiguration")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRoleBinding NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRoleBinding NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRoleBinding GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesValidatingWebhookConfigurationPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesValidatingWebhookConfigurationFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesRoleBinding NewKubernetesRoleBindingPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration NewKubernetesValidatingWebhookConfigurationPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesRoleBinding paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesValidatingWebhookConfiguration paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -6509,16 +16055,15 @@ func ListKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plug
 	return nil, nil
 }
 
-var getKubernetesRoleBindingFilters = map[string]string{
-	"role_api_group": "Description.RoleBinding.RoleRef.APIGroup",
-	"role_kind":      "Description.RoleBinding.RoleRef.Kind",
-	"role_name":      "Description.RoleBinding.RoleRef.Name",
-	"subjects":       "Description.RoleBinding.Subjects",
-	"title":          "Description.RoleBinding.Name",
+var getKubernetesValidatingWebhookConfigurationFilters = map[string]string{
+	"name":                    "Description.MetaObject.Name",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
+	"webhooks":                "Description.ValidatingWebhookConfiguration.Webhooks",
 }
 
-func GetKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesRoleBinding")
+func GetKubernetesValidatingWebhookConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesValidatingWebhookConfiguration")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -6546,7 +16091,7 @@ func GetKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugi
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesRoleBindingPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesRoleBindingFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesValidatingWebhookConfigurationPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesValidatingWebhookConfigurationFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -6570,74 +16115,74 @@ func GetKubernetesRoleBinding(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-// ==========================  END: KubernetesRoleBinding =============================
+// ==========================  END: KubernetesValidatingWebhookConfiguration =============================
 
-// ==========================  START: KubernetesSecret =============================
+// ==========================  START: KubernetesVerticalPodAutoscaler =============================
 
-type KubernetesSecret struct {
-	ResourceID      string                                 `json:"resource_id"`
-	PlatformID      string                                 `json:"platform_id"`
-	Description     kubernetes.KubernetesSecretDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                    `json:"metadata"`
-	DescribedBy     string                                 `json:"described_by"`
-	ResourceType    string                                 `json:"resource_type"`
-	IntegrationType string                                 `json:"integration_type"`
-	IntegrationID   string                                 `json:"integration_id"`
+type KubernetesVerticalPodAutoscaler struct {
+	ResourceID      string                                                `json:"resource_id"`
+	PlatformID      string                                                `json:"platform_id"`
+	Description     kubernetes.KubernetesVerticalPodAutoscalerDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                   `json:"metadata"`
+	DescribedBy     string                                                `json:"described_by"`
+	ResourceType    string                                                `json:"resource_type"`
+	IntegrationType string                                                `json:"integration_type"`
+	IntegrationID   string                                                `json:"integration_id"`
 }
 
-type KubernetesSecretHit struct {
-	ID      string           `json:"_id"`
-	Score   float64          `json:"_score"`
-	Index   string           `json:"_index"`
-	Type    string           `json:"_type"`
-	Version int64            `json:"_version,omitempty"`
-	Source  KubernetesSecret `json:"_source"`
-	Sort    []interface{}    `json:"sort"`
+type KubernetesVerticalPodAutoscalerHit struct {
+	ID      string                          `json:"_id"`
+	Score   float64                         `json:"_score"`
+	Index   string                          `json:"_index"`
+	Type    string                          `json:"_type"`
+	Version int64                           `json:"_version,omitempty"`
+	Source  KubernetesVerticalPodAutoscaler `json:"_source"`
+	Sort    []interface{}                   `json:"sort"`
 }
 
-type KubernetesSecretHits struct {
-	Total essdk.SearchTotal     `json:"total"`
-	Hits  []KubernetesSecretHit `json:"hits"`
+type KubernetesVerticalPodAutoscalerHits struct {
+	Total essdk.SearchTotal                    `json:"total"`
+	Hits  []KubernetesVerticalPodAutoscalerHit `json:"hits"`
 }
 
-type KubernetesSecretSearchResponse struct {
-	PitID string               `json:"pit_id"`
-	Hits  KubernetesSecretHits `json:"hits"`
+type KubernetesVerticalPodAutoscalerSearchResponse struct {
+	PitID string                              `json:"pit_id"`
+	Hits  KubernetesVerticalPodAutoscalerHits `json:"hits"`
 }
 
-type KubernetesSecretPaginator struct {
+type KubernetesVerticalPodAutoscalerPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesSecretPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesSecretPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_secret", filters, limit)
+func (k Client) NewKubernetesVerticalPodAutoscalerPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesVerticalPodAutoscalerPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_verticalpodautoscaler", filters, limit)
 	if err != nil {
-		return KubernetesSecretPaginator{}, err
+		return KubernetesVerticalPodAutoscalerPaginator{}, err
 	}
 
-	p := KubernetesSecretPaginator{
+	p := KubernetesVerticalPodAutoscalerPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesSecretPaginator) HasNext() bool {
+func (p KubernetesVerticalPodAutoscalerPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesSecretPaginator) Close(ctx context.Context) error {
+func (p KubernetesVerticalPodAutoscalerPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesSecretPaginator) NextPage(ctx context.Context) ([]KubernetesSecret, error) {
-	var response KubernetesSecretSearchResponse
+func (p KubernetesVerticalPodAutoscalerPaginator) NextPage(ctx context.Context) ([]KubernetesVerticalPodAutoscaler, error) {
+	var response KubernetesVerticalPodAutoscalerSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesSecret
+	var values []KubernetesVerticalPodAutoscaler
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -6652,57 +16197,65 @@ func (p KubernetesSecretPaginator) NextPage(ctx context.Context) ([]KubernetesSe
 	return values, nil
 }
 
-var listKubernetesSecretFilters = map[string]string{
-	"data_number": "Description.DataNumber",
-	"immutable":   "Description.Secret.Immutable",
-	"title":       "Description.Secret.Name",
-	"type":        "Description.Secret.Type",
+var listKubernetesVerticalPodAutoscalerFilters = map[string]string{
+	"conditions":                "Description.VerticalPodAutoscaler.Status.Conditions",
+	"container_recommendations": "Description.ContainerRecommendations",
+	"platform_integration_id":   "IntegrationID",
+	"recommendation":            "Description.VerticalPodAutoscaler.Status.Recommendation",
+	"recommenders":              "Description.VerticalPodAutoscaler.Spec.Recommenders",
+	"resource_policy":           "Description.VerticalPodAutoscaler.Spec.ResourcePolicy",
+	"target_kind":               "Description.VerticalPodAutoscaler.Spec.TargetRef.Kind",
+	"target_name":               "Description.VerticalPodAutoscaler.Spec.TargetRef.Name",
+	"target_ref":                "Description.VerticalPodAutoscaler.Spec.TargetRef",
+	"title":                     "Description.MetaObject.Name",
+	"update_mode":               "Description.VerticalPodAutoscaler.Spec.UpdatePolicy.UpdateMode",
+	"update_policy":             "Description.VerticalPodAutoscaler.Spec.UpdatePolicy",
 }
 
-func ListKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesSecret")
+func ListKubernetesVerticalPodAutoscaler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesVerticalPodAutoscaler")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesSecret NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesSecret NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesSecret GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesSecret GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesSecret GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesSecretPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesSecretFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesVerticalPodAutoscalerPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesVerticalPodAutoscalerFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesSecret NewKubernetesSecretPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler NewKubernetesVerticalPodAutoscalerPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesSecret paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesVerticalPodAutoscaler paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -6719,15 +16272,25 @@ func ListKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	return nil, nil
 }
 
-var getKubernetesSecretFilters = map[string]string{
-	"data_number": "Description.DataNumber",
-	"immutable":   "Description.Secret.Immutable",
-	"title":       "Description.Secret.Name",
-	"type":        "Description.Secret.Type",
-}
-
-func GetKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesSecret")
+var getKubernetesVerticalPodAutoscalerFilters = map[string]string{
+	"conditions":                "Description.VerticalPodAutoscaler.Status.Conditions",
+	"container_recommendations": "Description.ContainerRecommendations",
+	"name":                      "Description.MetaObject.Name",
+	"namespace":                 "Description.MetaObject.Namespace",
+	"platform_integration_id":   "IntegrationID",
+	"recommendation":            "Description.VerticalPodAutoscaler.Status.Recommendation",
+	"recommenders":              "Description.VerticalPodAutoscaler.Spec.Recommenders",
+	"resource_policy":           "Description.VerticalPodAutoscaler.Spec.ResourcePolicy",
+	"target_kind":               "Description.VerticalPodAutoscaler.Spec.TargetRef.Kind",
+	"target_name":               "Description.VerticalPodAutoscaler.Spec.TargetRef.Name",
+	"target_ref":                "Description.VerticalPodAutoscaler.Spec.TargetRef",
+	"title":                     "Description.MetaObject.Name",
+	"update_mode":               "Description.VerticalPodAutoscaler.Spec.UpdatePolicy.UpdateMode",
+	"update_policy":             "Description.VerticalPodAutoscaler.Spec.UpdatePolicy",
+}
+
+func GetKubernetesVerticalPodAutoscaler(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesVerticalPodAutoscaler")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -6755,7 +16318,7 @@ func GetKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesSecretPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesSecretFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesVerticalPodAutoscalerPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesVerticalPodAutoscalerFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -6779,74 +16342,74 @@ func GetKubernetesSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	return nil, nil
 }
 
-// ==========================  END: KubernetesSecret =============================
-
-// ==========================  START: KubernetesService =============================
+// ==========================  END: KubernetesVerticalPodAutoscaler =============================
 
-type KubernetesService struct {
-	ResourceID      string                                  `json:"resource_id"`
-	PlatformID      string                                  `json:"platform_id"`
-	Description     kubernetes.KubernetesServiceDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                     `json:"metadata"`
-	DescribedBy     string                                  `json:"described_by"`
-	ResourceType    string                                  `json:"resource_type"`
-	IntegrationType string                                  `json:"integration_type"`
-	IntegrationID   string                                  `json:"integration_id"`
-}
+// ==========================  START: KubernetesVolumeAttachment =============================
 
-type KubernetesServiceHit struct {
-	ID      string            `json:"_id"`
-	Score   float64           `json:"_score"`
-	Index   string            `json:"_index"`
-	Type    string            `json:"_type"`
-	Version int64             `json:"_version,omitempty"`
-	Source  KubernetesService `json:"_source"`
-	Sort    []interface{}     `json:"sort"`
+type KubernetesVolumeAttachment struct {
+	ResourceID      string                                           `json:"resource_id"`
+	PlatformID      string                                           `json:"platform_id"`
+	Description     kubernetes.KubernetesVolumeAttachmentDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                              `json:"metadata"`
+	DescribedBy     string                                           `json:"described_by"`
+	ResourceType    string                                           `json:"resource_type"`
+	IntegrationType string                                           `json:"integration_type"`
+	IntegrationID   string                                           `json:"integration_id"`
 }
 
-type KubernetesServiceHits struct {
-	Total essdk.SearchTotal      `json:"total"`
-	Hits  []KubernetesServiceHit `json:"hits"`
+type KubernetesVolumeAttachmentHit struct {
+	ID      string                     `json:"_id"`
+	Score   float64                    `json:"_score"`
+	Index   string                     `json:"_index"`
+	Type    string                     `json:"_type"`
+	Version int64                      `json:"_version,omitempty"`
+	Source  KubernetesVolumeAttachment `json:"_source"`
+	Sort    []interface{}              `json:"sort"`
 }
 
-type KubernetesServiceSearchResponse struct {
-	PitID string                `json:"pit_id"`
-	Hits  KubernetesServiceHits `json:"hits"`
+type KubernetesVolumeAttachmentHits struct {
+	Total essdk.SearchTotal               `json:"total"`
+	Hits  []KubernetesVolumeAttachmentHit `json:"hits"`
 }
 
-type KubernetesServicePaginator struct {
+type KubernetesVolumeAttachmentSearchResponse struct {
+	PitID string                         `json:"pit_id"`
+	Hits  KubernetesVolumeAttachmentHits `json:"hits"`
+}
+
+type KubernetesVolumeAttachmentPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesServicePaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesServicePaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_service", filters, limit)
+func (k Client) NewKubernetesVolumeAttachmentPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesVolumeAttachmentPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_volumeattachment", filters, limit)
 	if err != nil {
-		return KubernetesServicePaginator{}, err
+		return KubernetesVolumeAttachmentPaginator{}, err
 	}
 
-	p := KubernetesServicePaginator{
+	p := KubernetesVolumeAttachmentPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesServicePaginator) HasNext() bool {
+func (p KubernetesVolumeAttachmentPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesServicePaginator) Close(ctx context.Context) error {
+func (p KubernetesVolumeAttachmentPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesServicePaginator) NextPage(ctx context.Context) ([]KubernetesService, error) {
-	var response KubernetesServiceSearchResponse
+func (p KubernetesVolumeAttachmentPaginator) NextPage(ctx context.Context) ([]KubernetesVolumeAttachment, error) {
+	var response KubernetesVolumeAttachmentSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesService
+	var values []KubernetesVolumeAttachment
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -6861,69 +16424,63 @@ func (p KubernetesServicePaginator) NextPage(ctx context.Context) ([]KubernetesS
 	return values, nil
 }
 
-var listKubernetesServiceFilters = map[string]string{
-	"allocate_load_balancer_node_ports":  "Description.Service.Spec.AllocateLoadBalancerNodePorts",
-	"cluster_ip":                         "Description.Service.Spec.ClusterIP",
-	"cluster_ips":                        "Description.Service.Spec.ClusterIPs",
-	"external_ips":                       "Description.Service.Spec.ExternalIPs",
-	"external_name":                      "Description.Service.Spec.ExternalName",
-	"health_check_node_port":             "Description.Service.Spec.HealthCheckNodePort",
-	"ip_families":                        "Description.Service.Spec.IPFamilies",
-	"load_balancer_ingress":              "Description.Service.Status.LoadBalancer.Ingress",
-	"load_balancer_ip":                   "Description.Service.Spec.LoadBalancerIP",
-	"load_balancer_source_ranges":        "Description.Service.Spec.LoadBalancerSourceRanges",
-	"ports":                              "Description.Service.Spec.Ports",
-	"publish_not_ready_addresses":        "Description.Service.Spec.PublishNotReadyAddresses",
-	"selector":                           "Description.Service.Spec.Selector",
-	"session_affinity_client_ip_timeout": "Description.Service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds",
-	"title":                              "Description.Service.Name",
-	"topology_keys":                      "Description.Service.Spec.TopologyKeys",
+var listKubernetesVolumeAttachmentFilters = map[string]string{
+	"attach_error":            "Description.VolumeAttachment.Status.AttachError",
+	"attached":                "Description.VolumeAttachment.Status.Attached",
+	"attacher":                "Description.VolumeAttachment.Spec.Attacher",
+	"attachment_metadata":     "Description.VolumeAttachment.Status.AttachmentMetadata",
+	"detach_error":            "Description.VolumeAttachment.Status.DetachError",
+	"inline_volume_spec":      "Description.VolumeAttachment.Spec.Source.InlineVolumeSpec",
+	"node_name":               "Description.VolumeAttachment.Spec.NodeName",
+	"persistent_volume_name":  "Description.VolumeAttachment.Spec.Source.PersistentVolumeName",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func ListKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesService")
+func ListKubernetesVolumeAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesVolumeAttachment")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesService NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesService NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesService GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesService GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesService GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesServicePaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesServiceFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesVolumeAttachmentPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesVolumeAttachmentFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesService NewKubernetesServicePaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment NewKubernetesVolumeAttachmentPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesService paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesVolumeAttachment paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -6940,27 +16497,22 @@ func ListKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
-var getKubernetesServiceFilters = map[string]string{
-	"allocate_load_balancer_node_ports":  "Description.Service.Spec.AllocateLoadBalancerNodePorts",
-	"cluster_ip":                         "Description.Service.Spec.ClusterIP",
-	"cluster_ips":                        "Description.Service.Spec.ClusterIPs",
-	"external_ips":                       "Description.Service.Spec.ExternalIPs",
-	"external_name":                      "Description.Service.Spec.ExternalName",
-	"health_check_node_port":             "Description.Service.Spec.HealthCheckNodePort",
-	"ip_families":                        "Description.Service.Spec.IPFamilies",
-	"load_balancer_ingress":              "Description.Service.Status.LoadBalancer.Ingress",
-	"load_balancer_ip":                   "Description.Service.Spec.LoadBalancerIP",
-	"load_balancer_source_ranges":        "Description.Service.Spec.LoadBalancerSourceRanges",
-	"ports":                              "Description.Service.Spec.Ports",
-	"publish_not_ready_addresses":        "Description.Service.Spec.PublishNotReadyAddresses",
-	"selector":                           "Description.Service.Spec.Selector",
-	"session_affinity_client_ip_timeout": "Description.Service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds",
-	"title":                              "Description.Service.Name",
-	"topology_keys":                      "Description.Service.Spec.TopologyKeys",
+var getKubernetesVolumeAttachmentFilters = map[string]string{
+	"attach_error":            "Description.VolumeAttachment.Status.AttachError",
+	"attached":                "Description.VolumeAttachment.Status.Attached",
+	"attacher":                "Description.VolumeAttachment.Spec.Attacher",
+	"attachment_metadata":     "Description.VolumeAttachment.Status.AttachmentMetadata",
+	"detach_error":            "Description.VolumeAttachment.Status.DetachError",
+	"inline_volume_spec":      "Description.VolumeAttachment.Spec.Source.InlineVolumeSpec",
+	"name":                    "Description.MetaObject.Name",
+	"node_name":               "Description.VolumeAttachment.Spec.NodeName",
+	"persistent_volume_name":  "Description.VolumeAttachment.Spec.Source.PersistentVolumeName",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func GetKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesService")
+func GetKubernetesVolumeAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesVolumeAttachment")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -6988,7 +16540,7 @@ func GetKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesServicePaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesServiceFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesVolumeAttachmentPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesVolumeAttachmentFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -7012,14 +16564,14 @@ func GetKubernetesService(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	return nil, nil
 }
 
-// ==========================  END: KubernetesService =============================
+// ==========================  END: KubernetesVolumeAttachment =============================
 
-// ==========================  START: KubernetesServiceAccount =============================
+// ==========================  START: KubernetesVolumeSnapshot =============================
 
-type KubernetesServiceAccount struct {
+type KubernetesVolumeSnapshot struct {
 	ResourceID      string                                         `json:"resource_id"`
 	PlatformID      string                                         `json:"platform_id"`
-	Description     kubernetes.KubernetesServiceAccountDescription `json:"Description"`
+	Description     kubernetes.KubernetesVolumeSnapshotDescription `json:"Description"`
 	Metadata        kubernetes.Metadata                            `json:"metadata"`
 	DescribedBy     string                                         `json:"described_by"`
 	ResourceType    string                                         `json:"resource_type"`
@@ -7027,59 +16579,59 @@ type KubernetesServiceAccount struct {
 	IntegrationID   string                                         `json:"integration_id"`
 }
 
-type KubernetesServiceAccountHit struct {
+type KubernetesVolumeSnapshotHit struct {
 	ID      string                   `json:"_id"`
 	Score   float64                  `json:"_score"`
 	Index   string                   `json:"_index"`
 	Type    string                   `json:"_type"`
 	Version int64                    `json:"_version,omitempty"`
-	Source  KubernetesServiceAccount `json:"_source"`
+	Source  KubernetesVolumeSnapshot `json:"_source"`
 	Sort    []interface{}            `json:"sort"`
 }
 
-type KubernetesServiceAccountHits struct {
+type KubernetesVolumeSnapshotHits struct {
 	Total essdk.SearchTotal             `json:"total"`
-	Hits  []KubernetesServiceAccountHit `json:"hits"`
+	Hits  []KubernetesVolumeSnapshotHit `json:"hits"`
 }
 
-type KubernetesServiceAccountSearchResponse struct {
+type KubernetesVolumeSnapshotSearchResponse struct {
 	PitID string                       `json:"pit_id"`
-	Hits  KubernetesServiceAccountHits `json:"hits"`
+	Hits  KubernetesVolumeSnapshotHits `json:"hits"`
 }
 
-type KubernetesServiceAccountPaginator struct {
+type KubernetesVolumeSnapshotPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesServiceAccountPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesServiceAccountPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_serviceaccount", filters, limit)
+func (k Client) NewKubernetesVolumeSnapshotPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesVolumeSnapshotPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_volumesnapshot", filters, limit)
 	if err != nil {
-		return KubernetesServiceAccountPaginator{}, err
+		return KubernetesVolumeSnapshotPaginator{}, err
 	}
 
-	p := KubernetesServiceAccountPaginator{
+	p := KubernetesVolumeSnapshotPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesServiceAccountPaginator) HasNext() bool {
+func (p KubernetesVolumeSnapshotPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesServiceAccountPaginator) Close(ctx context.Context) error {
+func (p KubernetesVolumeSnapshotPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesServiceAccountPaginator) NextPage(ctx context.Context) ([]KubernetesServiceAccount, error) {
-	var response KubernetesServiceAccountSearchResponse
+func (p KubernetesVolumeSnapshotPaginator) NextPage(ctx context.Context) ([]KubernetesVolumeSnapshot, error) {
+	var response KubernetesVolumeSnapshotSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesServiceAccount
+	var values []KubernetesVolumeSnapshot
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -7094,57 +16646,63 @@ func (p KubernetesServiceAccountPaginator) NextPage(ctx context.Context) ([]Kube
 	return values, nil
 }
 
-var listKubernetesServiceAccountFilters = map[string]string{
-	"automount_service_account_token": "Description.ServiceAccount.AutomountServiceAccountToken",
-	"image_pull_secrets":              "Description.ServiceAccount.ImagePullSecrets",
-	"secrets":                         "Description.ServiceAccount.Secrets",
-	"title":                           "Description.ServiceAccount.Name",
+var listKubernetesVolumeSnapshotFilters = map[string]string{
+	"bound_content_name":         "Description.VolumeSnapshot.Status.BoundVolumeSnapshotContentName",
+	"creation_time":              "Description.VolumeSnapshot.Status.CreationTime",
+	"error":                      "Description.VolumeSnapshot.Status.Error",
+	"platform_integration_id":    "IntegrationID",
+	"ready_to_use":               "Description.VolumeSnapshot.Status.ReadyToUse",
+	"restore_size":               "Description.VolumeSnapshot.Status.RestoreSize",
+	"source_content_name":        "Description.VolumeSnapshot.Spec.Source.VolumeSnapshotContentName",
+	"source_pvc_name":            "Description.VolumeSnapshot.Spec.Source.PersistentVolumeClaimName",
+	"title":                      "Description.MetaObject.Name",
+	"volume_snapshot_class_name": "Description.VolumeSnapshot.Spec.VolumeSnapshotClassName",
 }
 
-func ListKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesServiceAccount")
+func ListKubernetesVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesVolumeSnapshot")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesServiceAccount NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesServiceAccount NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesServiceAccount GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesServiceAccount GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesServiceAccount GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesServiceAccountPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesServiceAccountFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesVolumeSnapshotPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesVolumeSnapshotFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesServiceAccount NewKubernetesServiceAccountPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot NewKubernetesVolumeSnapshotPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesServiceAccount paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshot paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -7161,15 +16719,23 @@ func ListKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *p
 	return nil, nil
 }
 
-var getKubernetesServiceAccountFilters = map[string]string{
-	"automount_service_account_token": "Description.ServiceAccount.AutomountServiceAccountToken",
-	"image_pull_secrets":              "Description.ServiceAccount.ImagePullSecrets",
-	"secrets":                         "Description.ServiceAccount.Secrets",
-	"title":                           "Description.ServiceAccount.Name",
+var getKubernetesVolumeSnapshotFilters = map[string]string{
+	"bound_content_name":         "Description.VolumeSnapshot.Status.BoundVolumeSnapshotContentName",
+	"creation_time":              "Description.VolumeSnapshot.Status.CreationTime",
+	"error":                      "Description.VolumeSnapshot.Status.Error",
+	"name":                       "Description.MetaObject.Name",
+	"namespace":                  "Description.MetaObject.Namespace",
+	"platform_integration_id":    "IntegrationID",
+	"ready_to_use":               "Description.VolumeSnapshot.Status.ReadyToUse",
+	"restore_size":               "Description.VolumeSnapshot.Status.RestoreSize",
+	"source_content_name":        "Description.VolumeSnapshot.Spec.Source.VolumeSnapshotContentName",
+	"source_pvc_name":            "Description.VolumeSnapshot.Spec.Source.PersistentVolumeClaimName",
+	"title":                      "Description.MetaObject.Name",
+	"volume_snapshot_class_name": "Description.VolumeSnapshot.Spec.VolumeSnapshotClassName",
 }
 
-func GetKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesServiceAccount")
+func GetKubernetesVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesVolumeSnapshot")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -7197,7 +16763,7 @@ func GetKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *pl
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesServiceAccountPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesServiceAccountFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesVolumeSnapshotPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesVolumeSnapshotFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -7221,74 +16787,74 @@ func GetKubernetesServiceAccount(ctx context.Context, d *plugin.QueryData, _ *pl
 	return nil, nil
 }
 
-// ==========================  END: KubernetesServiceAccount =============================
+// ==========================  END: KubernetesVolumeSnapshot =============================
 
-// ==========================  START: KubernetesStatefulSet =============================
+// ==========================  START: KubernetesVolumeSnapshotClass =============================
 
-type KubernetesStatefulSet struct {
-	ResourceID      string                                      `json:"resource_id"`
-	PlatformID      string                                      `json:"platform_id"`
-	Description     kubernetes.KubernetesStatefulSetDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                         `json:"metadata"`
-	DescribedBy     string                                      `json:"described_by"`
-	ResourceType    string                                      `json:"resource_type"`
-	IntegrationType string                                      `json:"integration_type"`
-	IntegrationID   string                                      `json:"integration_id"`
+type KubernetesVolumeSnapshotClass struct {
+	ResourceID      string                                              `json:"resource_id"`
+	PlatformID      string                                              `json:"platform_id"`
+	Description     kubernetes.KubernetesVolumeSnapshotClassDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                 `json:"metadata"`
+	DescribedBy     string                                              `json:"described_by"`
+	ResourceType    string                                              `json:"resource_type"`
+	IntegrationType string                                              `json:"integration_type"`
+	IntegrationID   string                                              `json:"integration_id"`
 }
 
-type KubernetesStatefulSetHit struct {
-	ID      string                `json:"_id"`
-	Score   float64               `json:"_score"`
-	Index   string                `json:"_index"`
-	Type    string                `json:"_type"`
-	Version int64                 `json:"_version,omitempty"`
-	Source  KubernetesStatefulSet `json:"_source"`
-	Sort    []interface{}         `json:"sort"`
+type KubernetesVolumeSnapshotClassHit struct {
+	ID      string                        `json:"_id"`
+	Score   float64                       `json:"_score"`
+	Index   string                        `json:"_index"`
+	Type    string                        `json:"_type"`
+	Version int64                         `json:"_version,omitempty"`
+	Source  KubernetesVolumeSnapshotClass `json:"_source"`
+	Sort    []interface{}                 `json:"sort"`
 }
 
-type KubernetesStatefulSetHits struct {
-	Total essdk.SearchTotal          `json:"total"`
-	Hits  []KubernetesStatefulSetHit `json:"hits"`
+type KubernetesVolumeSnapshotClassHits struct {
+	Total essdk.SearchTotal                  `json:"total"`
+	Hits  []KubernetesVolumeSnapshotClassHit `json:"hits"`
 }
 
-type KubernetesStatefulSetSearchResponse struct {
-	PitID string                    `json:"pit_id"`
-	Hits  KubernetesStatefulSetHits `json:"hits"`
+type KubernetesVolumeSnapshotClassSearchResponse struct {
+	PitID string                            `json:"pit_id"`
+	Hits  KubernetesVolumeSnapshotClassHits `json:"hits"`
 }
 
-type KubernetesStatefulSetPaginator struct {
+type KubernetesVolumeSnapshotClassPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesStatefulSetPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesStatefulSetPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_statefulset", filters, limit)
+func (k Client) NewKubernetesVolumeSnapshotClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesVolumeSnapshotClassPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_volumesnapshotclass", filters, limit)
 	if err != nil {
-		return KubernetesStatefulSetPaginator{}, err
+		return KubernetesVolumeSnapshotClassPaginator{}, err
 	}
 
-	p := KubernetesStatefulSetPaginator{
+	p := KubernetesVolumeSnapshotClassPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesStatefulSetPaginator) HasNext() bool {
+func (p KubernetesVolumeSnapshotClassPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesStatefulSetPaginator) Close(ctx context.Context) error {
+func (p KubernetesVolumeSnapshotClassPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesStatefulSetPaginator) NextPage(ctx context.Context) ([]KubernetesStatefulSet, error) {
-	var response KubernetesStatefulSetSearchResponse
+func (p KubernetesVolumeSnapshotClassPaginator) NextPage(ctx context.Context) ([]KubernetesVolumeSnapshotClass, error) {
+	var response KubernetesVolumeSnapshotClassSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesStatefulSet
+	var values []KubernetesVolumeSnapshotClass
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -7303,68 +16869,58 @@ func (p KubernetesStatefulSetPaginator) NextPage(ctx context.Context) ([]Kuberne
 	return values, nil
 }
 
-var listKubernetesStatefulSetFilters = map[string]string{
-	"available_replicas":     "Description.StatefulSet.Status.AvailableReplicas",
-	"collision_count":        "Description.StatefulSet.Status.CollisionCount",
-	"conditions":             "Description.StatefulSet.Status.Conditions",
-	"current_replicas":       "Description.StatefulSet.Status.CurrentReplicas",
-	"current_revision":       "Description.StatefulSet.Status.CurrentRevision",
-	"observed_generation":    "Description.StatefulSet.Status.ObservedGeneration",
-	"ready_replicas":         "Description.StatefulSet.Status.ReadyReplicas",
-	"replicas":               "Description.StatefulSet.Spec.Replicas",
-	"revision_history_limit": "Description.StatefulSet.Spec.RevisionHistoryLimit",
-	"service_name":           "Description.StatefulSet.Spec.ServiceName",
-	"template":               "Description.StatefulSet.Spec.Template",
-	"title":                  "Description.StatefulSet.Name",
-	"update_revision":        "Description.StatefulSet.Status.UpdateRevision",
-	"update_strategy":        "Description.StatefulSet.Spec.UpdateStrategy",
-	"updated_replicas":       "Description.StatefulSet.Status.UpdatedReplicas",
+var listKubernetesVolumeSnapshotClassFilters = map[string]string{
+	"deletion_policy":         "Description.VolumeSnapshotClass.DeletionPolicy",
+	"driver":                  "Description.VolumeSnapshotClass.Driver",
+	"parameters":              "Description.VolumeSnapshotClass.Parameters",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func ListKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesStatefulSet")
+func ListKubernetesVolumeSnapshotClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesVolumeSnapshotClass")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStatefulSet NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStatefulSet NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStatefulSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStatefulSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStatefulSet GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesStatefulSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesStatefulSetFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesVolumeSnapshotClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesVolumeSnapshotClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStatefulSet NewKubernetesStatefulSetPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass NewKubernetesVolumeSnapshotClassPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesStatefulSet paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotClass paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -7381,26 +16937,17 @@ func ListKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plug
 	return nil, nil
 }
 
-var getKubernetesStatefulSetFilters = map[string]string{
-	"available_replicas":     "Description.StatefulSet.Status.AvailableReplicas",
-	"collision_count":        "Description.StatefulSet.Status.CollisionCount",
-	"conditions":             "Description.StatefulSet.Status.Conditions",
-	"current_replicas":       "Description.StatefulSet.Status.CurrentReplicas",
-	"current_revision":       "Description.StatefulSet.Status.CurrentRevision",
-	"observed_generation":    "Description.StatefulSet.Status.ObservedGeneration",
-	"ready_replicas":         "Description.StatefulSet.Status.ReadyReplicas",
-	"replicas":               "Description.StatefulSet.Spec.Replicas",
-	"revision_history_limit": "Description.StatefulSet.Spec.RevisionHistoryLimit",
-	"service_name":           "Description.StatefulSet.Spec.ServiceName",
-	"template":               "Description.StatefulSet.Spec.Template",
-	"title":                  "Description.StatefulSet.Name",
-	"update_revision":        "Description.StatefulSet.Status.UpdateRevision",
-	"update_strategy":        "Description.StatefulSet.Spec.UpdateStrategy",
-	"updated_replicas":       "Description.StatefulSet.Status.UpdatedReplicas",
+var getKubernetesVolumeSnapshotClassFilters = map[string]string{
+	"deletion_policy":         "Description.VolumeSnapshotClass.DeletionPolicy",
+	"driver":                  "Description.VolumeSnapshotClass.Driver",
+	"name":                    "Description.MetaObject.Name",
+	"parameters":              "Description.VolumeSnapshotClass.Parameters",
+	"platform_integration_id": "IntegrationID",
+	"title":                   "Description.MetaObject.Name",
 }
 
-func GetKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesStatefulSet")
+func GetKubernetesVolumeSnapshotClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesVolumeSnapshotClass")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -7428,7 +16975,7 @@ func GetKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plugi
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesStatefulSetPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesStatefulSetFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesVolumeSnapshotClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesVolumeSnapshotClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -7452,74 +16999,74 @@ func GetKubernetesStatefulSet(ctx context.Context, d *plugin.QueryData, _ *plugi
 	return nil, nil
 }
 
-// ==========================  END: KubernetesStatefulSet =============================
+// ==========================  END: KubernetesVolumeSnapshotClass =============================
 
-// ==========================  START: KubernetesStorageClass =============================
+// ==========================  START: KubernetesVolumeSnapshotContent =============================
 
-type KubernetesStorageClass struct {
-	ResourceID      string                                       `json:"resource_id"`
-	PlatformID      string                                       `json:"platform_id"`
-	Description     kubernetes.KubernetesStorageClassDescription `json:"Description"`
-	Metadata        kubernetes.Metadata                          `json:"metadata"`
-	DescribedBy     string                                       `json:"described_by"`
-	ResourceType    string                                       `json:"resource_type"`
-	IntegrationType string                                       `json:"integration_type"`
-	IntegrationID   string                                       `json:"integration_id"`
+type KubernetesVolumeSnapshotContent struct {
+	ResourceID      string                                                `json:"resource_id"`
+	PlatformID      string                                                `json:"platform_id"`
+	Description     kubernetes.KubernetesVolumeSnapshotContentDescription `json:"Description"`
+	Metadata        kubernetes.Metadata                                   `json:"metadata"`
+	DescribedBy     string                                                `json:"described_by"`
+	ResourceType    string                                                `json:"resource_type"`
+	IntegrationType string                                                `json:"integration_type"`
+	IntegrationID   string                                                `json:"integration_id"`
 }
 
-type KubernetesStorageClassHit struct {
-	ID      string                 `json:"_id"`
-	Score   float64                `json:"_score"`
-	Index   string                 `json:"_index"`
-	Type    string                 `json:"_type"`
-	Version int64                  `json:"_version,omitempty"`
-	Source  KubernetesStorageClass `json:"_source"`
-	Sort    []interface{}          `json:"sort"`
+type KubernetesVolumeSnapshotContentHit struct {
+	ID      string                          `json:"_id"`
+	Score   float64                         `json:"_score"`
+	Index   string                          `json:"_index"`
+	Type    string                          `json:"_type"`
+	Version int64                           `json:"_version,omitempty"`
+	Source  KubernetesVolumeSnapshotContent `json:"_source"`
+	Sort    []interface{}                   `json:"sort"`
 }
 
-type KubernetesStorageClassHits struct {
-	Total essdk.SearchTotal           `json:"total"`
-	Hits  []KubernetesStorageClassHit `json:"hits"`
+type KubernetesVolumeSnapshotContentHits struct {
+	Total essdk.SearchTotal                    `json:"total"`
+	Hits  []KubernetesVolumeSnapshotContentHit `json:"hits"`
 }
 
-type KubernetesStorageClassSearchResponse struct {
-	PitID string                     `json:"pit_id"`
-	Hits  KubernetesStorageClassHits `json:"hits"`
+type KubernetesVolumeSnapshotContentSearchResponse struct {
+	PitID string                              `json:"pit_id"`
+	Hits  KubernetesVolumeSnapshotContentHits `json:"hits"`
 }
 
-type KubernetesStorageClassPaginator struct {
+type KubernetesVolumeSnapshotContentPaginator struct {
 	paginator *essdk.BaseESPaginator
 }
 
-func (k Client) NewKubernetesStorageClassPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesStorageClassPaginator, error) {
-	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_storageclass", filters, limit)
+func (k Client) NewKubernetesVolumeSnapshotContentPaginator(filters []essdk.BoolFilter, limit *int64) (KubernetesVolumeSnapshotContentPaginator, error) {
+	paginator, err := essdk.NewPaginator(k.ES(), "kubernetes_volumesnapshotcontent", filters, limit)
 	if err != nil {
-		return KubernetesStorageClassPaginator{}, err
+		return KubernetesVolumeSnapshotContentPaginator{}, err
 	}
 
-	p := KubernetesStorageClassPaginator{
+	p := KubernetesVolumeSnapshotContentPaginator{
 		paginator: paginator,
 	}
 
 	return p, nil
 }
 
-func (p KubernetesStorageClassPaginator) HasNext() bool {
+func (p KubernetesVolumeSnapshotContentPaginator) HasNext() bool {
 	return !p.paginator.Done()
 }
 
-func (p KubernetesStorageClassPaginator) Close(ctx context.Context) error {
+func (p KubernetesVolumeSnapshotContentPaginator) Close(ctx context.Context) error {
 	return p.paginator.Deallocate(ctx)
 }
 
-func (p KubernetesStorageClassPaginator) NextPage(ctx context.Context) ([]KubernetesStorageClass, error) {
-	var response KubernetesStorageClassSearchResponse
+func (p KubernetesVolumeSnapshotContentPaginator) NextPage(ctx context.Context) ([]KubernetesVolumeSnapshotContent, error) {
+	var response KubernetesVolumeSnapshotContentSearchResponse
 	err := p.paginator.Search(ctx, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	var values []KubernetesStorageClass
+	var values []KubernetesVolumeSnapshotContent
 	for _, hit := range response.Hits.Hits {
 		values = append(values, hit.Source)
 	}
@@ -7534,61 +17081,66 @@ func (p KubernetesStorageClassPaginator) NextPage(ctx context.Context) ([]Kubern
 	return values, nil
 }
 
-var listKubernetesStorageClassFilters = map[string]string{
-	"allow_volume_expansion": "Description.StorageClass.AllowVolumeExpansion",
-	"allowed_topologies":     "Description.StorageClass.AllowedTopologies",
-	"mount_options":          "Description.StorageClass.MountOptions",
-	"parameters":             "Description.StorageClass.Parameters",
-	"provisioner":            "Description.StorageClass.Provisioner",
-	"reclaim_policy":         "Description.StorageClass.ReclaimPolicy",
-	"title":                  "Description.StorageClass.Name",
-	"volume_binding_mode":    "Description.StorageClass.VolumeBindingMode",
-}
-
-func ListKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("ListKubernetesStorageClass")
+var listKubernetesVolumeSnapshotContentFilters = map[string]string{
+	"deletion_policy":            "Description.VolumeSnapshotContent.Spec.DeletionPolicy",
+	"driver":                     "Description.VolumeSnapshotContent.Spec.Driver",
+	"error":                      "Description.VolumeSnapshotContent.Status.Error",
+	"platform_integration_id":    "IntegrationID",
+	"ready_to_use":               "Description.VolumeSnapshotContent.Status.ReadyToUse",
+	"restore_size":               "Description.VolumeSnapshotContent.Status.RestoreSize",
+	"snapshot_handle":            "Description.VolumeSnapshotContent.Status.SnapshotHandle",
+	"title":                      "Description.MetaObject.Name",
+	"volume_handle":              "Description.VolumeSnapshotContent.Spec.Source.VolumeHandle",
+	"volume_snapshot_class_name": "Description.VolumeSnapshotContent.Spec.VolumeSnapshotClassName",
+	"volume_snapshot_name":       "Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef.Name",
+	"volume_snapshot_namespace":  "Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef.Namespace",
+	"volume_snapshot_ref":        "Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef",
+}
+
+func ListKubernetesVolumeSnapshotContent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("ListKubernetesVolumeSnapshotContent")
 	runtime.GC()
 
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
 	ke, err := essdk.NewClientCached(cfg, d.ConnectionCache, ctx)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStorageClass NewClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent NewClientCached", "error", err)
 		return nil, err
 	}
 	k := Client{Client: ke}
 
 	sc, err := steampipesdk.NewSelfClientCached(ctx, d.ConnectionCache)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStorageClass NewSelfClientCached", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent NewSelfClientCached", "error", err)
 		return nil, err
 	}
 	integrationId, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyIntegrationID)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStorageClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent GetConfigTableValueOrNil for OpenGovernanceConfigKeyIntegrationID", "error", err)
 		return nil, err
 	}
 	encodedResourceCollectionFilters, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyResourceCollectionFilters)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStorageClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent GetConfigTableValueOrNil for OpenGovernanceConfigKeyResourceCollectionFilters", "error", err)
 		return nil, err
 	}
 	clientType, err := sc.GetConfigTableValueOrNil(ctx, steampipesdk.OpenGovernanceConfigKeyClientType)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStorageClass GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent GetConfigTableValueOrNil for OpenGovernanceConfigKeyClientType", "error", err)
 		return nil, err
 	}
 
-	paginator, err := k.NewKubernetesStorageClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesStorageClassFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
+	paginator, err := k.NewKubernetesVolumeSnapshotContentPaginator(essdk.BuildFilter(ctx, d.QueryContext, listKubernetesVolumeSnapshotContentFilters, integrationId, encodedResourceCollectionFilters, clientType), d.QueryContext.Limit)
 	if err != nil {
-		plugin.Logger(ctx).Error("ListKubernetesStorageClass NewKubernetesStorageClassPaginator", "error", err)
+		plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent NewKubernetesVolumeSnapshotContentPaginator", "error", err)
 		return nil, err
 	}
 
 	for paginator.HasNext() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("ListKubernetesStorageClass paginator.NextPage", "error", err)
+			plugin.Logger(ctx).Error("ListKubernetesVolumeSnapshotContent paginator.NextPage", "error", err)
 			return nil, err
 		}
 
@@ -7605,19 +17157,25 @@ func ListKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plu
 	return nil, nil
 }
 
-var getKubernetesStorageClassFilters = map[string]string{
-	"allow_volume_expansion": "Description.StorageClass.AllowVolumeExpansion",
-	"allowed_topologies":     "Description.StorageClass.AllowedTopologies",
-	"mount_options":          "Description.StorageClass.MountOptions",
-	"parameters":             "Description.StorageClass.Parameters",
-	"provisioner":            "Description.StorageClass.Provisioner",
-	"reclaim_policy":         "Description.StorageClass.ReclaimPolicy",
-	"title":                  "Description.StorageClass.Name",
-	"volume_binding_mode":    "Description.StorageClass.VolumeBindingMode",
-}
-
-func GetKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	plugin.Logger(ctx).Trace("GetKubernetesStorageClass")
+var getKubernetesVolumeSnapshotContentFilters = map[string]string{
+	"deletion_policy":            "Description.VolumeSnapshotContent.Spec.DeletionPolicy",
+	"driver":                     "Description.VolumeSnapshotContent.Spec.Driver",
+	"error":                      "Description.VolumeSnapshotContent.Status.Error",
+	"name":                       "Description.MetaObject.Name",
+	"platform_integration_id":    "IntegrationID",
+	"ready_to_use":               "Description.VolumeSnapshotContent.Status.ReadyToUse",
+	"restore_size":               "Description.VolumeSnapshotContent.Status.RestoreSize",
+	"snapshot_handle":            "Description.VolumeSnapshotContent.Status.SnapshotHandle",
+	"title":                      "Description.MetaObject.Name",
+	"volume_handle":              "Description.VolumeSnapshotContent.Spec.Source.VolumeHandle",
+	"volume_snapshot_class_name": "Description.VolumeSnapshotContent.Spec.VolumeSnapshotClassName",
+	"volume_snapshot_name":       "Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef.Name",
+	"volume_snapshot_namespace":  "Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef.Namespace",
+	"volume_snapshot_ref":        "Description.VolumeSnapshotContent.Spec.VolumeSnapshotRef",
+}
+
+func GetKubernetesVolumeSnapshotContent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("GetKubernetesVolumeSnapshotContent")
 	runtime.GC()
 	// create service
 	cfg := essdk.GetConfig(d.Connection)
@@ -7645,7 +17203,7 @@ func GetKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plug
 	}
 
 	limit := int64(1)
-	paginator, err := k.NewKubernetesStorageClassPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesStorageClassFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
+	paginator, err := k.NewKubernetesVolumeSnapshotContentPaginator(essdk.BuildFilter(ctx, d.QueryContext, getKubernetesVolumeSnapshotContentFilters, integrationId, encodedResourceCollectionFilters, clientType), &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -7669,4 +17227,4 @@ func GetKubernetesStorageClass(ctx context.Context, d *plugin.QueryData, _ *plug
 	return nil, nil
 }
 
-// ==========================  END: KubernetesStorageClass =============================
+// ==========================  END: KubernetesVolumeSnapshotContent =============================
@@ -1,5 +0,0 @@
-package models
-
-type IntegrationCredentials struct {
-	KubeConfig string `json:"kubeconfig"`
-}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PartialDescribeError is returned by describers that skipped part of a resource type but still described the rest.
+// Errors maps what was skipped (e.g. a custom resource definition name) to the reason.
+type PartialDescribeError struct {
+	Errors map[string]string
+}
+
+func (e *PartialDescribeError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, 0, len(keys))
+	for _, key := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %s", key, e.Errors[key]))
+	}
+	return fmt.Sprintf("%d skipped: %s", len(keys), strings.Join(messages, "; "))
+}
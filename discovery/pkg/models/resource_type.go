@@ -1,14 +1,15 @@
 package models
 
 import (
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
 	"github.com/opengovern/og-util/pkg/describe/enums"
 	"github.com/opengovern/og-util/pkg/integration"
 	"golang.org/x/net/context"
 )
 
 // any types are used to load your provider configuration.
-type ResourceDescriber func(context.Context, IntegrationCredentials, enums.DescribeTriggerType, map[string]string, *StreamSender) ([]Resource, error)
-type SingleResourceDescriber func(context.Context, IntegrationCredentials, enums.DescribeTriggerType, map[string]string, string, *StreamSender) (*Resource, error)
+type ResourceDescriber func(context.Context, constants.IntegrationCredentials, enums.DescribeTriggerType, map[string]string, *StreamSender) ([]Resource, error)
+type SingleResourceDescriber func(context.Context, constants.IntegrationCredentials, enums.DescribeTriggerType, map[string]string, string, *StreamSender) (*Resource, error)
 
 type ResourceType struct {
 	IntegrationType integration.Type
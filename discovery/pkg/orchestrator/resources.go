@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/opengovern/og-describer-kubernetes/discovery/describers"
 	model "github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
 	"github.com/opengovern/og-describer-kubernetes/global/maps"
 	"github.com/opengovern/og-util/pkg/describe/enums"
 	"go.uber.org/zap"
@@ -48,7 +49,7 @@ func GetResources(
 	logger *zap.Logger,
 	resourceType string,
 	triggerType enums.DescribeTriggerType,
-	cfg model.IntegrationCredentials,
+	cfg constants.IntegrationCredentials,
 	additionalParameters map[string]string,
 	stream *model.StreamSender,
 ) error {
@@ -59,7 +60,7 @@ func GetResources(
 	return nil
 }
 
-func describe(ctx context.Context, logger *zap.Logger, accountCfg model.IntegrationCredentials, resourceType string, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, stream *model.StreamSender) ([]model.Resource, error) {
+func describe(ctx context.Context, logger *zap.Logger, accountCfg constants.IntegrationCredentials, resourceType string, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, stream *model.StreamSender) ([]model.Resource, error) {
 	resourceTypeObject, ok := maps.ResourceTypes[resourceType]
 	if !ok {
 		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
@@ -74,7 +75,7 @@ func GetSingleResource(
 	logger *zap.Logger,
 	resourceType string,
 	triggerType enums.DescribeTriggerType,
-	cfg model.IntegrationCredentials,
+	cfg constants.IntegrationCredentials,
 	additionalParameters map[string]string,
 	resourceId string,
 	stream *model.StreamSender,
@@ -86,11 +87,14 @@ func GetSingleResource(
 	return nil
 }
 
-func describeSingle(ctx context.Context, logger *zap.Logger, accountCfg model.IntegrationCredentials, resourceType string, resourceID string, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, stream *model.StreamSender) (*model.Resource, error) {
+func describeSingle(ctx context.Context, logger *zap.Logger, accountCfg constants.IntegrationCredentials, resourceType string, resourceID string, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, stream *model.StreamSender) (*model.Resource, error) {
 	resourceTypeObject, ok := maps.ResourceTypes[resourceType]
 	if !ok {
 		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
+	if resourceTypeObject.GetDescriber == nil {
+		return nil, fmt.Errorf("resource type %s does not support describing a single resource", resourceType)
+	}
 	ctx = describers.WithLogger(ctx, logger)
 
 	return resourceTypeObject.GetDescriber(ctx, accountCfg, triggerType, additionalParameters, resourceID, stream)
@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	model "github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
 	"github.com/opengovern/og-describer-kubernetes/discovery/provider"
@@ -151,11 +152,13 @@ func Describe(
 		additionalParameters,
 		clientStream,
 	)
-	if err != nil {
+	// a partial describe error still delivers the resources that were described, it is reported in the job result
+	var partialErr *model.PartialDescribeError
+	if err != nil && !errors.As(err, &partialErr) {
 		return nil, err
 	}
 
 	rs.Finish()
 
-	return rs.GetResourceIDs(), nil
+	return rs.GetResourceIDs(), err
 }
@@ -3,8 +3,10 @@ package task
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/opengovern/og-describer-kubernetes/discovery/envs"
+	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
 	"github.com/opengovern/og-describer-kubernetes/discovery/pkg/orchestrator"
 	authApi "github.com/opengovern/og-util/pkg/api"
 	"github.com/opengovern/og-util/pkg/describe"
@@ -70,9 +72,10 @@ type IntegrationResult struct {
 }
 
 type ResourceTypeResult struct {
-	ResourceType  string `json:"resource_type"`
-	Error         string `json:"error"`
-	ResourceCount int    `json:"resource_count"`
+	ResourceType  string            `json:"resource_type"`
+	Error         string            `json:"error"`
+	ResourceCount int               `json:"resource_count"`
+	PartialErrors map[string]string `json:"partial_errors,omitempty"`
 }
 
 type ResourceType struct {
@@ -187,9 +190,14 @@ func (tr *TaskRunner) describeIntegrationResourceTypes(ctx context.Context, i In
 		resources, err := orchestrator.Describe(ctx, tr.logger, job, params, config, tr.request.EsDeliverEndpoint,
 			tr.request.IngestionPipelineEndpoint, tr.describeToken, tr.request.UseOpenSearch)
 		errMsg := ""
+		var partialErrors map[string]string
 		if err != nil {
 			tr.logger.Error("Error describing job", zap.Error(err))
 			errMsg = err.Error()
+			var partialErr *models.PartialDescribeError
+			if errors.As(err, &partialErr) {
+				partialErrors = partialErr.Errors
+			}
 		}
 		taskResult.ProgressedIntegrations[i.IntegrationID].ResourceTypeResults = append(
 			taskResult.ProgressedIntegrations[i.IntegrationID].ResourceTypeResults,
@@ -197,6 +205,7 @@ func (tr *TaskRunner) describeIntegrationResourceTypes(ctx context.Context, i In
 				ResourceType:  rt.Name,
 				Error:         errMsg,
 				ResourceCount: len(resources),
+				PartialErrors: partialErrors,
 			})
 
 		taskResult.ProgressedIntegrations[i.IntegrationID].FinishedResourceTypesCount = len(taskResult.ProgressedIntegrations[i.IntegrationID].ResourceTypeResults)
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	CaptureSpecParameter     = "capture_spec"
+	CaptureStatusParameter   = "capture_status"
+	CaptureMetadataParameter = "capture_metadata"
+	CapturePruneParameter    = "capture_prune"
+	CaptureMaxBytesParameter = "capture_max_bytes"
+
+	// DefaultCaptureMaxBytes is the default size limit of the spec and status captured for a single object
+	DefaultCaptureMaxBytes = 256 * 1024
+)
+
+// defaultPrunedFields are always removed from captured objects, they are large and only meaningful to the API server and kubectl
+var defaultPrunedFields = [][]string{
+	{"metadata", "managedFields"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+}
+
+// CaptureConfig selects which parts of the objects listed by the generic Kubernetes/Resource describer are stored.
+// Prune holds the field paths removed from every object before it is captured, and MaxBytes is the size limit
+// of the captured spec and status of a single object, larger ones are dropped and the object is flagged as truncated.
+// A MaxBytes of 0 disables the limit.
+type CaptureConfig struct {
+	Spec     bool
+	Status   bool
+	Metadata bool
+	Prune    [][]string
+	MaxBytes int
+}
+
+// NewCaptureConfig builds a CaptureConfig from the capture_* parameters, nothing but the object identity is captured by default.
+// capture_prune is a comma separated list of JSON pointers (e.g. "/spec/template/metadata/annotations,/status/conditions").
+func NewCaptureConfig(parameters map[string]string) (CaptureConfig, error) {
+	config := CaptureConfig{
+		Prune:    defaultPrunedFields,
+		MaxBytes: DefaultCaptureMaxBytes,
+	}
+
+	for param, value := range map[string]*bool{
+		CaptureSpecParameter:     &config.Spec,
+		CaptureStatusParameter:   &config.Status,
+		CaptureMetadataParameter: &config.Metadata,
+	} {
+		if v, ok := parameters[param]; ok && v != "" {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return CaptureConfig{}, fmt.Errorf("invalid %s: %w", param, err)
+			}
+			*value = enabled
+		}
+	}
+
+	if v, ok := parameters[CaptureMaxBytesParameter]; ok && v != "" {
+		maxBytes, err := strconv.Atoi(v)
+		if err != nil || maxBytes < 0 {
+			return CaptureConfig{}, fmt.Errorf("invalid %s %q, expected a non-negative number of bytes", CaptureMaxBytesParameter, v)
+		}
+		config.MaxBytes = maxBytes
+	}
+
+	prune, err := parseFieldPaths(parameters[CapturePruneParameter])
+	if err != nil {
+		return CaptureConfig{}, fmt.Errorf("invalid %s: %w", CapturePruneParameter, err)
+	}
+	config.Prune = append(config.Prune[:len(config.Prune):len(config.Prune)], prune...)
+
+	return config, nil
+}
+
+// parseFieldPaths parses comma separated JSON pointers, "~1" and "~0" escape "/" and "~" in field names
+func parseFieldPaths(value string) ([][]string, error) {
+	var paths [][]string
+	for _, pointer := range strings.Split(value, ",") {
+		pointer = strings.TrimSpace(pointer)
+		if pointer == "" {
+			continue
+		}
+		if !strings.HasPrefix(pointer, "/") || pointer == "/" {
+			return nil, fmt.Errorf("field path %q must be a JSON pointer, e.g. /status/conditions", pointer)
+		}
+		var path []string
+		for _, field := range strings.Split(pointer[1:], "/") {
+			if field == "" {
+				return nil, fmt.Errorf("field path %q has an empty field", pointer)
+			}
+			path = append(path, strings.ReplaceAll(strings.ReplaceAll(field, "~1", "/"), "~0", "~"))
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -3,20 +3,21 @@ package provider
 import (
 	"encoding/json"
 	model "github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
 	"github.com/opengovern/og-util/pkg/describe"
 )
 
 // AccountCredentialsFromMap TODO: converts a map to a configs.IntegrationCredentials.
-func AccountCredentialsFromMap(m map[string]any) (model.IntegrationCredentials, error) {
+func AccountCredentialsFromMap(m map[string]any) (constants.IntegrationCredentials, error) {
 	mj, err := json.Marshal(m)
 	if err != nil {
-		return model.IntegrationCredentials{}, err
+		return constants.IntegrationCredentials{}, err
 	}
 
-	var c model.IntegrationCredentials
+	var c constants.IntegrationCredentials
 	err = json.Unmarshal(mj, &c)
 	if err != nil {
-		return model.IntegrationCredentials{}, err
+		return constants.IntegrationCredentials{}, err
 	}
 
 	return c, nil
@@ -34,13 +35,25 @@ func AdjustResource(job describe.DescribeJob, resource *model.Resource) error {
 	return nil
 }
 
-// GetAdditionalParameters TODO: pass additional parameters needed in describer wrappers in /provider/describer_wrapper.go
+// GetAdditionalParameters pass additional parameters needed in describer wrappers in /provider/describer_wrapper.go
+// Namespace scoping and the kubeconfig context can be set either as integration labels or annotations, annotations take precedence.
 func GetAdditionalParameters(job describe.DescribeJob) (map[string]string, error) {
 	additionalParameters := make(map[string]string)
 
-	//if _, ok := job.IntegrationLabels["param"]; ok {
-	//	additionalParameters["param"] = job.IntegrationLabels["param"]
-	//}
+	for _, param := range []string{
+		NamespacesIncludeParameter, NamespacesExcludeParameter, KubeContextParameter,
+		CaptureSpecParameter, CaptureStatusParameter, CaptureMetadataParameter, CapturePruneParameter, CaptureMaxBytesParameter,
+	} {
+		if v, ok := job.IntegrationLabels[param]; ok {
+			additionalParameters[param] = v
+		}
+		if v, ok := job.IntegrationAnnotations[param]; ok {
+			additionalParameters[param] = v
+		}
+	}
+	if job.ProviderID != "" {
+		additionalParameters[ProviderIDParameter] = job.ProviderID
+	}
 
 	return additionalParameters, nil
 }
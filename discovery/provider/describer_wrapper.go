@@ -1,68 +1,238 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
 	helmclient "github.com/mittwald/go-helm-client"
 	model "github.com/opengovern/og-describer-kubernetes/discovery/pkg/models"
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
+	"github.com/opengovern/og-describer-kubernetes/global/kubeauth"
 	"github.com/opengovern/og-util/pkg/describe/enums"
 	"golang.org/x/net/context"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+// KubeContextParameter selects the kubeconfig context of integrations discovered from a multi-context kubeconfig,
+// it is set as the context_name integration label by the discovery
+const KubeContextParameter = "context_name"
+
+// ProviderIDParameter holds the provider ID of the integration, the API server URL of the cluster it was discovered for.
+// It is set from the describe job, never from labels or annotations.
+const ProviderIDParameter = "provider_id"
+
 type Client struct {
 	KubernetesClient *kubernetes.Clientset
 	CrdsClient       *apiextensionsclientset.Clientset
 	DynamicClient    *dynamic.DynamicClient
 	HelmClient       helmclient.Client
-	KubeConfig       string
+	RestConfig       *rest.Config
+	// KubeConfig is empty for bearer token credentials, their cluster is named by kubeauth.TokenContextName
+	KubeConfig  string
+	ContextName string
+	Namespaces  NamespaceFilter
+	Capture     CaptureConfig
 }
 
 func DescribeByIntegration(describe func(context.Context, Client, string, *model.StreamSender) ([]model.Resource, error)) model.ResourceDescriber {
-	return func(ctx context.Context, cfg model.IntegrationCredentials, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, stream *model.StreamSender) ([]model.Resource, error) {
+	return func(ctx context.Context, cfg constants.IntegrationCredentials, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, stream *model.StreamSender) ([]model.Resource, error) {
 		var values []model.Resource
 
-		config, err := clientcmd.RESTConfigFromKubeConfig([]byte(cfg.KubeConfig))
+		client, err := newClient(cfg, additionalParameters)
 		if err != nil {
 			return nil, err
 		}
 
-		kubernetesClient, err := kubernetes.NewForConfig(config)
+		err = client.Namespaces.Resolve(ctx, client.listNamespaceNames)
 		if err != nil {
 			return nil, err
 		}
 
-		helmClient, err := helmclient.NewClientFromRestConf(&helmclient.RestConfClientOptions{
-			Options:    &helmclient.Options{},
-			RestConfig: config,
-		})
+		values, err = describe(ctx, client, "", stream)
 		if err != nil {
+			// the described resources are kept if only part of the resource type failed
+			var partialErr *model.PartialDescribeError
+			if errors.As(err, &partialErr) {
+				return values, err
+			}
 			return nil, err
 		}
 
-		crdClient, err := apiextensionsclientset.NewForConfig(config)
+		return values, nil
+	}
+}
+
+func DescribeSingleByIntegration(describe func(context.Context, Client, string, string, *model.StreamSender) (*model.Resource, error)) model.SingleResourceDescriber {
+	return func(ctx context.Context, cfg constants.IntegrationCredentials, triggerType enums.DescribeTriggerType, additionalParameters map[string]string, resourceID string, stream *model.StreamSender) (*model.Resource, error) {
+		client, err := newClient(cfg, additionalParameters)
 		if err != nil {
 			return nil, err
 		}
 
-		dynmicClient, err := dynamic.NewForConfig(config)
+		value, err := describe(ctx, client, "", resourceID, stream)
 		if err != nil {
 			return nil, err
 		}
 
-		client := Client{
-			KubernetesClient: kubernetesClient,
-			CrdsClient:       crdClient,
-			DynamicClient:    dynmicClient,
-			HelmClient:       helmClient,
-			KubeConfig:       cfg.KubeConfig,
+		return value, nil
+	}
+}
+
+func newClient(cfg constants.IntegrationCredentials, additionalParameters map[string]string) (Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return Client{}, err
+	}
+
+	namespaces, err := NewNamespaceFilter(additionalParameters)
+	if err != nil {
+		return Client{}, err
+	}
+
+	namespaces.Scope, err = cfg.GetNamespaces()
+	if err != nil {
+		return Client{}, err
+	}
+
+	capture, err := NewCaptureConfig(additionalParameters)
+	if err != nil {
+		return Client{}, err
+	}
+
+	var kubeConfig, contextName string
+	var config *rest.Config
+	switch cfg.GetCredentialType() {
+	case constants.CredentialTypeKubeconfig:
+		kubeConfig = cfg.KubeConfig
+		contextName = cfg.Context
+		if contextName == "" {
+			contextName, err = integrationContext(kubeConfig, additionalParameters[KubeContextParameter], additionalParameters[ProviderIDParameter])
+			if err != nil {
+				return Client{}, err
+			}
 		}
-		values, err = describe(ctx, client, "", stream)
+		if contextName != "" {
+			kubeConfig, err = kubeConfigForContext(kubeConfig, contextName)
+			if err != nil {
+				return Client{}, err
+			}
+		}
+		config, err = clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfig))
 		if err != nil {
-			return nil, err
+			return Client{}, err
+		}
+	case constants.CredentialTypeInCluster:
+		kubeConfig, err = inClusterKubeConfig()
+		if err != nil {
+			return Client{}, err
+		}
+		contextName = kubeauth.InClusterContextName
+		config, err = clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfig))
+		if err != nil {
+			return Client{}, err
+		}
+	case constants.CredentialTypeToken:
+		config, err = kubeauth.TokenRestConfig(cfg)
+		if err != nil {
+			return Client{}, err
 		}
+		contextName = kubeauth.TokenContextName(config.Host)
+	default:
+		return Client{}, fmt.Errorf("unsupported credential type %s", cfg.CredentialType)
+	}
 
-		return values, nil
+	kubernetesClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return Client{}, err
+	}
+
+	helmClient, err := helmclient.NewClientFromRestConf(&helmclient.RestConfClientOptions{
+		Options:    &helmclient.Options{},
+		RestConfig: config,
+	})
+	if err != nil {
+		return Client{}, err
+	}
+
+	crdClient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		return Client{}, err
+	}
+
+	dynmicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return Client{}, err
+	}
+
+	return Client{
+		KubernetesClient: kubernetesClient,
+		CrdsClient:       crdClient,
+		DynamicClient:    dynmicClient,
+		HelmClient:       helmClient,
+		RestConfig:       config,
+		KubeConfig:       kubeConfig,
+		ContextName:      contextName,
+		Namespaces:       namespaces,
+		Capture:          capture,
+	}, nil
+}
+
+// listNamespaceNames returns the names of every namespace of the cluster
+func (c Client) listNamespaceNames(ctx context.Context) ([]string, error) {
+	namespaces, err := c.KubernetesClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(namespaces.Items))
+	for _, namespace := range namespaces.Items {
+		names = append(names, namespace.Name)
+	}
+	return names, nil
+}
+
+// kubeConfigForContext returns the kubeconfig with its current-context set to contextName,
+// so every client built from it, including the describers reading the kubeconfig themselves, uses that context
+func kubeConfigForContext(kubeConfig string, contextName string) (string, error) {
+	config, err := clientcmd.Load([]byte(kubeConfig))
+	if err != nil {
+		return "", err
+	}
+	if _, ok := config.Contexts[contextName]; !ok {
+		return "", fmt.Errorf("context %s not found in kubeconfig", contextName)
+	}
+	config.CurrentContext = contextName
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// integrationContext picks with kubeauth.IntegrationContext the context of a shared kubeconfig the integration was
+// discovered from, so a missing or edited context label never falls back to a context of another cluster
+func integrationContext(kubeConfig string, labelContext string, providerID string) (string, error) {
+	config, err := clientcmd.Load([]byte(kubeConfig))
+	if err != nil {
+		return "", err
+	}
+	return kubeauth.IntegrationContext(config, labelContext, providerID)
+}
+
+// inClusterKubeConfig returns the kubeconfig of the service account the describer runs as, serialized for the
+// describers reading the kubeconfig themselves
+func inClusterKubeConfig() (string, error) {
+	kubeConfig, err := kubeauth.InClusterKubeconfig()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := clientcmd.Write(*kubeConfig)
+	if err != nil {
+		return "", err
 	}
+	return string(data), nil
 }
@@ -0,0 +1,62 @@
+package provider
+
+import "testing"
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com:6443
+- name: dev
+  cluster:
+    server: https://dev.example.com:6443/
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+- name: dev
+  context:
+    cluster: dev
+    user: admin
+- name: broken
+  context:
+    cluster: missing
+    user: admin
+users:
+- name: admin
+  user:
+    token: test
+`
+
+func TestIntegrationContext(t *testing.T) {
+	tests := []struct {
+		name         string
+		labelContext string
+		providerID   string
+		want         string
+		wantErr      bool
+	}{
+		{name: "context of the integration cluster", labelContext: "prod", providerID: "https://prod.example.com:6443", want: "prod"},
+		{name: "trailing slashes are ignored", labelContext: "dev", providerID: "https://dev.example.com:6443", want: "dev"},
+		{name: "context of another cluster", labelContext: "dev", providerID: "https://prod.example.com:6443", wantErr: true},
+		{name: "unknown context", labelContext: "staging", providerID: "https://prod.example.com:6443", wantErr: true},
+		{name: "context without cluster", labelContext: "broken", providerID: "https://prod.example.com:6443", wantErr: true},
+		{name: "no label", providerID: "https://dev.example.com:6443", want: "dev"},
+		{name: "no label nor context of the cluster", providerID: "https://staging.example.com:6443", wantErr: true},
+		{name: "no provider id", labelContext: "dev", providerID: "", want: "dev"},
+		{name: "no label nor provider id", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := integrationContext(testKubeConfig, tt.labelContext, tt.providerID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("integrationContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("integrationContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package helpers
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// convertStringPtr converts a pointer to a string based enum (e.g. *admissionregistrationv1.FailurePolicyType) to a *string
+func convertStringPtr[T ~string](value *T) *string {
+	if value == nil {
+		return nil
+	}
+	s := string(*value)
+	return &s
+}
+
+// convertStrings converts a slice of string based enums to a slice of strings
+func convertStrings[T ~string](values []T) []string {
+	if values == nil {
+		return nil
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = string(value)
+	}
+	return result
+}
+
+// --- MutatingWebhookConfiguration ---
+type MutatingWebhookConfiguration struct {
+	TypeMeta
+	ObjectMeta
+	Webhooks []MutatingWebhook
+}
+
+// ConvertMutatingWebhookConfiguration creates a helper MutatingWebhookConfiguration from an admissionregistrationv1 MutatingWebhookConfiguration
+func ConvertMutatingWebhookConfiguration(mwc *admissionregistrationv1.MutatingWebhookConfiguration) MutatingWebhookConfiguration {
+	webhooks := make([]MutatingWebhook, len(mwc.Webhooks))
+	for i, webhook := range mwc.Webhooks {
+		webhooks[i] = ConvertMutatingWebhook(webhook)
+	}
+	return MutatingWebhookConfiguration{
+		TypeMeta:   ConvertTypeMeta(mwc.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&mwc.ObjectMeta),
+		Webhooks:   webhooks,
+	}
+}
+
+// --- MutatingWebhook ---
+type MutatingWebhook struct {
+	Name                    string
+	ClientConfig            AdmissionWebhookClientConfig
+	Rules                   []RuleWithOperations
+	FailurePolicy           *string
+	MatchPolicy             *string
+	NamespaceSelector       *LabelSelector
+	ObjectSelector          *LabelSelector
+	SideEffects             *string
+	TimeoutSeconds          *int32
+	AdmissionReviewVersions []string
+	ReinvocationPolicy      *string
+	MatchConditions         []MatchCondition
+}
+
+func ConvertMutatingWebhook(webhook admissionregistrationv1.MutatingWebhook) MutatingWebhook {
+	return MutatingWebhook{
+		Name:                    webhook.Name,
+		ClientConfig:            ConvertAdmissionWebhookClientConfig(webhook.ClientConfig),
+		Rules:                   ConvertRulesWithOperations(webhook.Rules),
+		FailurePolicy:           convertStringPtr(webhook.FailurePolicy),
+		MatchPolicy:             convertStringPtr(webhook.MatchPolicy),
+		NamespaceSelector:       ConvertLabelSelector(webhook.NamespaceSelector),
+		ObjectSelector:          ConvertLabelSelector(webhook.ObjectSelector),
+		SideEffects:             convertStringPtr(webhook.SideEffects),
+		TimeoutSeconds:          webhook.TimeoutSeconds,
+		AdmissionReviewVersions: webhook.AdmissionReviewVersions,
+		ReinvocationPolicy:      convertStringPtr(webhook.ReinvocationPolicy),
+		MatchConditions:         ConvertMatchConditions(webhook.MatchConditions),
+	}
+}
+
+// --- ValidatingWebhookConfiguration ---
+type ValidatingWebhookConfiguration struct {
+	TypeMeta
+	ObjectMeta
+	Webhooks []ValidatingWebhook
+}
+
+// ConvertValidatingWebhookConfiguration creates a helper ValidatingWebhookConfiguration from an admissionregistrationv1 ValidatingWebhookConfiguration
+func ConvertValidatingWebhookConfiguration(vwc *admissionregistrationv1.ValidatingWebhookConfiguration) ValidatingWebhookConfiguration {
+	webhooks := make([]ValidatingWebhook, len(vwc.Webhooks))
+	for i, webhook := range vwc.Webhooks {
+		webhooks[i] = ConvertValidatingWebhook(webhook)
+	}
+	return ValidatingWebhookConfiguration{
+		TypeMeta:   ConvertTypeMeta(vwc.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&vwc.ObjectMeta),
+		Webhooks:   webhooks,
+	}
+}
+
+// --- ValidatingWebhook ---
+type ValidatingWebhook struct {
+	Name                    string
+	ClientConfig            AdmissionWebhookClientConfig
+	Rules                   []RuleWithOperations
+	FailurePolicy           *string
+	MatchPolicy             *string
+	NamespaceSelector       *LabelSelector
+	ObjectSelector          *LabelSelector
+	SideEffects             *string
+	TimeoutSeconds          *int32
+	AdmissionReviewVersions []string
+	MatchConditions         []MatchCondition
+}
+
+func ConvertValidatingWebhook(webhook admissionregistrationv1.ValidatingWebhook) ValidatingWebhook {
+	return ValidatingWebhook{
+		Name:                    webhook.Name,
+		ClientConfig:            ConvertAdmissionWebhookClientConfig(webhook.ClientConfig),
+		Rules:                   ConvertRulesWithOperations(webhook.Rules),
+		FailurePolicy:           convertStringPtr(webhook.FailurePolicy),
+		MatchPolicy:             convertStringPtr(webhook.MatchPolicy),
+		NamespaceSelector:       ConvertLabelSelector(webhook.NamespaceSelector),
+		ObjectSelector:          ConvertLabelSelector(webhook.ObjectSelector),
+		SideEffects:             convertStringPtr(webhook.SideEffects),
+		TimeoutSeconds:          webhook.TimeoutSeconds,
+		AdmissionReviewVersions: webhook.AdmissionReviewVersions,
+		MatchConditions:         ConvertMatchConditions(webhook.MatchConditions),
+	}
+}
+
+// --- AdmissionWebhookClientConfig ---
+// The CA bundle itself is not kept, only whether one is set. ServiceReference is shared with the CRD conversion webhooks.
+type AdmissionWebhookClientConfig struct {
+	URL         *string
+	Service     *ServiceReference
+	HasCABundle bool
+}
+
+func ConvertAdmissionWebhookClientConfig(clientConfig admissionregistrationv1.WebhookClientConfig) AdmissionWebhookClientConfig {
+	var service *ServiceReference
+	if clientConfig.Service != nil {
+		service = &ServiceReference{
+			Namespace: clientConfig.Service.Namespace,
+			Name:      clientConfig.Service.Name,
+			Path:      clientConfig.Service.Path,
+			Port:      clientConfig.Service.Port,
+		}
+	}
+	return AdmissionWebhookClientConfig{
+		URL:         clientConfig.URL,
+		Service:     service,
+		HasCABundle: len(clientConfig.CABundle) > 0,
+	}
+}
+
+// --- RuleWithOperations ---
+type RuleWithOperations struct {
+	Operations  []string
+	APIGroups   []string
+	APIVersions []string
+	Resources   []string
+	Scope       *string
+}
+
+func ConvertRuleWithOperations(rule admissionregistrationv1.RuleWithOperations) RuleWithOperations {
+	return RuleWithOperations{
+		Operations:  convertStrings(rule.Operations),
+		APIGroups:   rule.APIGroups,
+		APIVersions: rule.APIVersions,
+		Resources:   rule.Resources,
+		Scope:       convertStringPtr(rule.Scope),
+	}
+}
+
+func ConvertRulesWithOperations(rules []admissionregistrationv1.RuleWithOperations) []RuleWithOperations {
+	if rules == nil {
+		return nil
+	}
+	result := make([]RuleWithOperations, len(rules))
+	for i, rule := range rules {
+		result[i] = ConvertRuleWithOperations(rule)
+	}
+	return result
+}
+
+// --- MatchCondition ---
+type MatchCondition struct {
+	Name       string
+	Expression string
+}
+
+func ConvertMatchConditions(matchConditions []admissionregistrationv1.MatchCondition) []MatchCondition {
+	if matchConditions == nil {
+		return nil
+	}
+	result := make([]MatchCondition, len(matchConditions))
+	for i, matchCondition := range matchConditions {
+		result[i] = MatchCondition{
+			Name:       matchCondition.Name,
+			Expression: matchCondition.Expression,
+		}
+	}
+	return result
+}
+
+// --- ValidatingAdmissionPolicy ---
+type ValidatingAdmissionPolicy struct {
+	TypeMeta
+	ObjectMeta
+	Spec   ValidatingAdmissionPolicySpec
+	Status ValidatingAdmissionPolicyStatus
+}
+
+// ConvertValidatingAdmissionPolicy creates a helper ValidatingAdmissionPolicy from an admissionregistrationv1 ValidatingAdmissionPolicy
+func ConvertValidatingAdmissionPolicy(vap *admissionregistrationv1.ValidatingAdmissionPolicy) ValidatingAdmissionPolicy {
+	return ValidatingAdmissionPolicy{
+		TypeMeta:   ConvertTypeMeta(vap.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&vap.ObjectMeta),
+		Spec:       ConvertValidatingAdmissionPolicySpec(vap.Spec),
+		Status:     ConvertValidatingAdmissionPolicyStatus(vap.Status),
+	}
+}
+
+// --- ValidatingAdmissionPolicySpec ---
+type ValidatingAdmissionPolicySpec struct {
+	ParamKind        *ParamKind
+	MatchConstraints *MatchResources
+	Validations      []Validation
+	FailurePolicy    *string
+	AuditAnnotations []AuditAnnotation
+	MatchConditions  []MatchCondition
+	Variables        []Variable
+}
+
+func ConvertValidatingAdmissionPolicySpec(spec admissionregistrationv1.ValidatingAdmissionPolicySpec) ValidatingAdmissionPolicySpec {
+	var paramKind *ParamKind
+	if spec.ParamKind != nil {
+		paramKind = &ParamKind{
+			APIVersion: spec.ParamKind.APIVersion,
+			Kind:       spec.ParamKind.Kind,
+		}
+	}
+	validations := make([]Validation, len(spec.Validations))
+	for i, validation := range spec.Validations {
+		validations[i] = Validation{
+			Expression:        validation.Expression,
+			Message:           validation.Message,
+			Reason:            convertStringPtr(validation.Reason),
+			MessageExpression: validation.MessageExpression,
+		}
+	}
+	auditAnnotations := make([]AuditAnnotation, len(spec.AuditAnnotations))
+	for i, auditAnnotation := range spec.AuditAnnotations {
+		auditAnnotations[i] = AuditAnnotation{
+			Key:             auditAnnotation.Key,
+			ValueExpression: auditAnnotation.ValueExpression,
+		}
+	}
+	variables := make([]Variable, len(spec.Variables))
+	for i, variable := range spec.Variables {
+		variables[i] = Variable{
+			Name:       variable.Name,
+			Expression: variable.Expression,
+		}
+	}
+	return ValidatingAdmissionPolicySpec{
+		ParamKind:        paramKind,
+		MatchConstraints: ConvertMatchResources(spec.MatchConstraints),
+		Validations:      validations,
+		FailurePolicy:    convertStringPtr(spec.FailurePolicy),
+		AuditAnnotations: auditAnnotations,
+		MatchConditions:  ConvertMatchConditions(spec.MatchConditions),
+		Variables:        variables,
+	}
+}
+
+// --- ParamKind ---
+type ParamKind struct {
+	APIVersion string
+	Kind       string
+}
+
+// --- Validation ---
+type Validation struct {
+	Expression        string
+	Message           string
+	Reason            *string
+	MessageExpression string
+}
+
+// --- AuditAnnotation ---
+type AuditAnnotation struct {
+	Key             string
+	ValueExpression string
+}
+
+// --- Variable ---
+type Variable struct {
+	Name       string
+	Expression string
+}
+
+// --- MatchResources ---
+type MatchResources struct {
+	NamespaceSelector    *LabelSelector
+	ObjectSelector       *LabelSelector
+	ResourceRules        []NamedRuleWithOperations
+	ExcludeResourceRules []NamedRuleWithOperations
+	MatchPolicy          *string
+}
+
+func ConvertMatchResources(matchResources *admissionregistrationv1.MatchResources) *MatchResources {
+	if matchResources == nil {
+		return nil
+	}
+	return &MatchResources{
+		NamespaceSelector:    ConvertLabelSelector(matchResources.NamespaceSelector),
+		ObjectSelector:       ConvertLabelSelector(matchResources.ObjectSelector),
+		ResourceRules:        ConvertNamedRulesWithOperations(matchResources.ResourceRules),
+		ExcludeResourceRules: ConvertNamedRulesWithOperations(matchResources.ExcludeResourceRules),
+		MatchPolicy:          convertStringPtr(matchResources.MatchPolicy),
+	}
+}
+
+// --- NamedRuleWithOperations ---
+type NamedRuleWithOperations struct {
+	ResourceNames []string
+	RuleWithOperations
+}
+
+func ConvertNamedRulesWithOperations(rules []admissionregistrationv1.NamedRuleWithOperations) []NamedRuleWithOperations {
+	if rules == nil {
+		return nil
+	}
+	result := make([]NamedRuleWithOperations, len(rules))
+	for i, rule := range rules {
+		result[i] = NamedRuleWithOperations{
+			ResourceNames:      rule.ResourceNames,
+			RuleWithOperations: ConvertRuleWithOperations(rule.RuleWithOperations),
+		}
+	}
+	return result
+}
+
+// --- ValidatingAdmissionPolicyStatus ---
+type ValidatingAdmissionPolicyStatus struct {
+	ObservedGeneration int64
+	TypeChecking       []ExpressionWarning // Warnings of the type checking of the expressions
+	Conditions         []metav1.Condition  // Use metav1.Condition directly
+}
+
+type ExpressionWarning struct {
+	FieldRef string
+	Warning  string
+}
+
+func ConvertValidatingAdmissionPolicyStatus(status admissionregistrationv1.ValidatingAdmissionPolicyStatus) ValidatingAdmissionPolicyStatus {
+	var warnings []ExpressionWarning
+	if status.TypeChecking != nil {
+		for _, warning := range status.TypeChecking.ExpressionWarnings {
+			warnings = append(warnings, ExpressionWarning{
+				FieldRef: warning.FieldRef,
+				Warning:  warning.Warning,
+			})
+		}
+	}
+	return ValidatingAdmissionPolicyStatus{
+		ObservedGeneration: status.ObservedGeneration,
+		TypeChecking:       warnings,
+		Conditions:         status.Conditions,
+	}
+}
+
+// --- ValidatingAdmissionPolicyBinding ---
+type ValidatingAdmissionPolicyBinding struct {
+	TypeMeta
+	ObjectMeta
+	Spec ValidatingAdmissionPolicyBindingSpec
+}
+
+// ConvertValidatingAdmissionPolicyBinding creates a helper ValidatingAdmissionPolicyBinding from an admissionregistrationv1 ValidatingAdmissionPolicyBinding
+func ConvertValidatingAdmissionPolicyBinding(vapb *admissionregistrationv1.ValidatingAdmissionPolicyBinding) ValidatingAdmissionPolicyBinding {
+	var paramRef *ParamRef
+	if vapb.Spec.ParamRef != nil {
+		paramRef = &ParamRef{
+			Name:                    vapb.Spec.ParamRef.Name,
+			Namespace:               vapb.Spec.ParamRef.Namespace,
+			Selector:                ConvertLabelSelector(vapb.Spec.ParamRef.Selector),
+			ParameterNotFoundAction: convertStringPtr(vapb.Spec.ParamRef.ParameterNotFoundAction),
+		}
+	}
+	return ValidatingAdmissionPolicyBinding{
+		TypeMeta:   ConvertTypeMeta(vapb.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&vapb.ObjectMeta),
+		Spec: ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        vapb.Spec.PolicyName,
+			ParamRef:          paramRef,
+			MatchResources:    ConvertMatchResources(vapb.Spec.MatchResources),
+			ValidationActions: convertStrings(vapb.Spec.ValidationActions),
+		},
+	}
+}
+
+// --- ValidatingAdmissionPolicyBindingSpec ---
+type ValidatingAdmissionPolicyBindingSpec struct {
+	PolicyName        string
+	ParamRef          *ParamRef
+	MatchResources    *MatchResources
+	ValidationActions []string
+}
+
+// --- ParamRef ---
+type ParamRef struct {
+	Name                    string
+	Namespace               string
+	Selector                *LabelSelector
+	ParameterNotFoundAction *string
+}
@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// --- APIService ---
+type APIService struct {
+	TypeMeta
+	ObjectMeta
+	Spec   APIServiceSpec
+	Status APIServiceStatus
+}
+
+// APIServiceSpec doesn't keep the CA bundle, HasCABundle only records whether one is set
+type APIServiceSpec struct {
+	// Service is the service serving the API, it is nil for the APIs served by the kube-apiserver itself
+	Service               *ServiceReference
+	Group                 string
+	Version               string
+	InsecureSkipTLSVerify bool
+	HasCABundle           bool
+	GroupPriorityMinimum  int32
+	VersionPriority       int32
+}
+
+type APIServiceStatus struct {
+	Conditions []APIServiceCondition
+}
+
+type APIServiceCondition struct {
+	Type               string
+	Status             string
+	LastTransitionTime time.Time
+	Reason             string
+	Message            string
+}
+
+// ConvertAPIService creates a helper APIService from an unstructured apiregistration.k8s.io APIService
+func ConvertAPIService(item *unstructured.Unstructured) (APIService, error) {
+	var apiService APIService
+	if err := convertUnstructured(item, &apiService); err != nil {
+		return APIService{}, err
+	}
+	apiService.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	caBundle, _, _ := unstructured.NestedString(item.Object, "spec", "caBundle")
+	apiService.Spec.HasCABundle = caBundle != ""
+	return apiService, nil
+}
+
+// AvailableCondition returns the Available condition of the APIService, nil if it isn't reported yet
+func (s APIService) AvailableCondition() *APIServiceCondition {
+	for i := range s.Status.Conditions {
+		if s.Status.Conditions[i].Type == "Available" {
+			return &s.Status.Conditions[i]
+		}
+	}
+	return nil
+}
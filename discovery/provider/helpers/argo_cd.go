@@ -0,0 +1,190 @@
+package helpers
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ArgoApplicationSource is a repository, and the path or chart in it, the manifests of an application are rendered from.
+// The Helm values, plugin environment and other source parameters aren't kept, they may contain secrets.
+type ArgoApplicationSource struct {
+	RepoURL        string
+	Path           string
+	TargetRevision string
+	Chart          string
+	Ref            string
+}
+
+// ArgoApplicationDestination is the cluster, by API server URL or by name, and the namespace an application is deployed to
+type ArgoApplicationDestination struct {
+	Server    string
+	Name      string
+	Namespace string
+}
+
+// --- ArgoApplication ---
+type ArgoApplication struct {
+	TypeMeta
+	ObjectMeta
+	Spec   ArgoApplicationSpec
+	Status ArgoApplicationStatus
+}
+
+type ArgoApplicationSpec struct {
+	Project string
+	// Source is nil for multi-source applications, whose sources are listed in Sources
+	Source      *ArgoApplicationSource
+	Sources     []ArgoApplicationSource
+	Destination ArgoApplicationDestination
+	SyncPolicy  *ArgoSyncPolicy
+}
+
+type ArgoSyncPolicy struct {
+	// Automated is nil if the application is only synced manually
+	Automated   *ArgoSyncPolicyAutomated
+	SyncOptions []string
+}
+
+type ArgoSyncPolicyAutomated struct {
+	Prune      bool
+	SelfHeal   bool
+	AllowEmpty bool
+}
+
+type ArgoApplicationStatus struct {
+	Sync           ArgoSyncStatus
+	Health         ArgoHealthStatus
+	OperationState *ArgoOperationState
+	// Resources are the resources managed by the application, with their own sync and health status
+	Resources    []ArgoResourceStatus
+	Conditions   []ArgoApplicationCondition
+	Summary      ArgoApplicationSummary
+	SourceType   string
+	SourceTypes  []string
+	ReconciledAt *time.Time
+}
+
+type ArgoSyncStatus struct {
+	// Status is Synced, OutOfSync or Unknown
+	Status    string
+	Revision  string
+	Revisions []string
+}
+
+type ArgoHealthStatus struct {
+	// Status is Healthy, Progressing, Degraded, Suspended, Missing or Unknown
+	Status  string
+	Message string
+}
+
+// ArgoOperationState is the state of the last sync operation of an application
+type ArgoOperationState struct {
+	// Phase is Running, Terminating, Failed, Error or Succeeded
+	Phase      string
+	Message    string
+	RetryCount int64
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	SyncResult *ArgoSyncOperationResult
+}
+
+type ArgoSyncOperationResult struct {
+	Revision  string
+	Revisions []string
+}
+
+type ArgoResourceStatus struct {
+	Group           string
+	Version         string
+	Kind            string
+	Namespace       string
+	Name            string
+	Status          string
+	Health          *ArgoHealthStatus
+	Hook            bool
+	RequiresPruning bool
+}
+
+type ArgoApplicationCondition struct {
+	// Type is e.g. ComparisonError, SyncError or OrphanedResourceWarning
+	Type               string
+	Message            string
+	LastTransitionTime *time.Time
+}
+
+type ArgoApplicationSummary struct {
+	ExternalURLs []string
+	Images       []string
+}
+
+// ConvertArgoApplication creates a helper ArgoApplication from an unstructured argoproj.io Application
+func ConvertArgoApplication(item *unstructured.Unstructured) (ArgoApplication, error) {
+	var application ArgoApplication
+	if err := convertUnstructured(item, &application); err != nil {
+		return ArgoApplication{}, err
+	}
+	application.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return application, nil
+}
+
+// --- ArgoAppProject ---
+type ArgoAppProject struct {
+	TypeMeta
+	ObjectMeta
+	Spec ArgoAppProjectSpec
+}
+
+// ArgoAppProjectSpec restricts the sources, destinations and kinds of the applications of a project,
+// the JWT tokens of its roles aren't kept
+type ArgoAppProjectSpec struct {
+	Description                string
+	SourceRepos                []string
+	SourceNamespaces           []string
+	Destinations               []ArgoApplicationDestination
+	ClusterResourceWhitelist   []ArgoGroupKind
+	ClusterResourceBlacklist   []ArgoGroupKind
+	NamespaceResourceWhitelist []ArgoGroupKind
+	NamespaceResourceBlacklist []ArgoGroupKind
+	Roles                      []ArgoProjectRole
+	SyncWindows                []ArgoSyncWindow
+	OrphanedResources          *ArgoOrphanedResourcesMonitorSettings
+}
+
+type ArgoGroupKind struct {
+	Group string
+	Kind  string
+}
+
+type ArgoProjectRole struct {
+	Name        string
+	Description string
+	Policies    []string
+	Groups      []string
+}
+
+type ArgoSyncWindow struct {
+	// Kind is allow or deny
+	Kind         string
+	Schedule     string
+	Duration     string
+	Applications []string
+	Namespaces   []string
+	Clusters     []string
+	ManualSync   bool
+	TimeZone     string
+}
+
+type ArgoOrphanedResourcesMonitorSettings struct {
+	Warn *bool
+}
+
+// ConvertArgoAppProject creates a helper ArgoAppProject from an unstructured argoproj.io AppProject
+func ConvertArgoAppProject(item *unstructured.Unstructured) (ArgoAppProject, error) {
+	var appProject ArgoAppProject
+	if err := convertUnstructured(item, &appProject); err != nil {
+		return ArgoAppProject{}, err
+	}
+	appProject.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return appProject, nil
+}
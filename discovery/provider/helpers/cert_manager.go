@@ -0,0 +1,185 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// CertManagerObjectReference references the Issuer or ClusterIssuer signing a certificate
+type CertManagerObjectReference struct {
+	Name  string
+	Kind  string
+	Group string
+}
+
+// --- Certificate ---
+type Certificate struct {
+	TypeMeta
+	ObjectMeta
+	Spec   CertificateSpec
+	Status CertificateStatus
+}
+
+type CertificateSpec struct {
+	// SecretName is the secret the signed certificate and its private key are stored in
+	SecretName     string
+	CommonName     string
+	DNSNames       []string
+	IPAddresses    []string
+	URIs           []string
+	EmailAddresses []string
+	Duration       string
+	RenewBefore    string
+	IssuerRef      CertManagerObjectReference
+	IsCA           bool
+	Usages         []string
+	PrivateKey     *CertificatePrivateKey
+}
+
+type CertificatePrivateKey struct {
+	RotationPolicy string
+	Encoding       string
+	Algorithm      string
+	Size           int
+}
+
+type CertificateStatus struct {
+	Conditions               []metav1.Condition
+	NotBefore                *time.Time
+	NotAfter                 *time.Time
+	RenewalTime              *time.Time
+	Revision                 *int
+	LastFailureTime          *time.Time
+	FailedIssuanceAttempts   *int
+	NextPrivateKeySecretName *string
+}
+
+// ConvertCertificate creates a helper Certificate from an unstructured cert-manager.io Certificate
+func ConvertCertificate(item *unstructured.Unstructured) (Certificate, error) {
+	var certificate Certificate
+	if err := convertUnstructured(item, &certificate); err != nil {
+		return Certificate{}, err
+	}
+	certificate.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return certificate, nil
+}
+
+// --- CertificateRequest ---
+
+// CertificateRequest doesn't keep the PEM encoded request, the issued certificate nor the CA,
+// only the certificate details parsed from status.certificate
+type CertificateRequest struct {
+	TypeMeta
+	ObjectMeta
+	Spec   CertificateRequestSpec
+	Status CertificateRequestStatus
+}
+
+type CertificateRequestSpec struct {
+	Duration  string
+	IssuerRef CertManagerObjectReference
+	IsCA      bool
+	Usages    []string
+	// Username, UID, Groups and Extra identify the requestor, they are set by the cert-manager webhook
+	Username string
+	UID      string
+	Groups   []string
+	Extra    map[string][]string
+}
+
+type CertificateRequestStatus struct {
+	Conditions  []metav1.Condition
+	FailureTime *time.Time
+	// IssuedCertificate is nil until the certificate is issued, or if it can't be parsed
+	IssuedCertificate *IssuedCertificate
+}
+
+// ConvertCertificateRequest creates a helper CertificateRequest from an unstructured cert-manager.io CertificateRequest
+func ConvertCertificateRequest(item *unstructured.Unstructured) (CertificateRequest, error) {
+	var certificateRequest CertificateRequest
+	if err := convertUnstructured(item, &certificateRequest); err != nil {
+		return CertificateRequest{}, err
+	}
+	certificateRequest.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	if encoded, _, _ := unstructured.NestedString(item.Object, "status", "certificate"); encoded != "" {
+		if data, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+			certificateRequest.Status.IssuedCertificate = ConvertIssuedCertificate(data)
+		}
+	}
+	return certificateRequest, nil
+}
+
+// --- Issuer and ClusterIssuer ---
+type Issuer struct {
+	TypeMeta
+	ObjectMeta
+	Spec   IssuerSpec
+	Status IssuerStatus
+}
+
+// ClusterIssuer has the same spec and status as an Issuer, it can be referenced by certificates of every namespace
+type ClusterIssuer struct {
+	TypeMeta
+	ObjectMeta
+	Spec   IssuerSpec
+	Status IssuerStatus
+}
+
+// IssuerSpec has exactly one of its issuer configurations set, they are kept as they are defined
+type IssuerSpec struct {
+	ACME       map[string]interface{}
+	CA         map[string]interface{}
+	Vault      map[string]interface{}
+	SelfSigned map[string]interface{}
+	Venafi     map[string]interface{}
+}
+
+type IssuerStatus struct {
+	Conditions []metav1.Condition
+	ACME       *ACMEIssuerStatus
+}
+
+type ACMEIssuerStatus struct {
+	URI                 string
+	LastRegisteredEmail string
+}
+
+// Type returns the kind of issuer configured: ACME, CA, Vault, SelfSigned or Venafi
+func (spec IssuerSpec) Type() string {
+	switch {
+	case spec.ACME != nil:
+		return "ACME"
+	case spec.CA != nil:
+		return "CA"
+	case spec.Vault != nil:
+		return "Vault"
+	case spec.SelfSigned != nil:
+		return "SelfSigned"
+	case spec.Venafi != nil:
+		return "Venafi"
+	}
+	return ""
+}
+
+// ConvertIssuer creates a helper Issuer from an unstructured cert-manager.io Issuer
+func ConvertIssuer(item *unstructured.Unstructured) (Issuer, error) {
+	var issuer Issuer
+	if err := convertUnstructured(item, &issuer); err != nil {
+		return Issuer{}, err
+	}
+	issuer.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return issuer, nil
+}
+
+// ConvertClusterIssuer creates a helper ClusterIssuer from an unstructured cert-manager.io ClusterIssuer
+func ConvertClusterIssuer(item *unstructured.Unstructured) (ClusterIssuer, error) {
+	var clusterIssuer ClusterIssuer
+	if err := convertUnstructured(item, &clusterIssuer); err != nil {
+		return ClusterIssuer{}, err
+	}
+	clusterIssuer.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return clusterIssuer, nil
+}
@@ -0,0 +1,139 @@
+package helpers
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+// --- CertificateSigningRequest (certificatesv1) ---
+
+// CertificateSigningRequest doesn't keep the PEM encoded request nor the issued certificate,
+// only the certificate details parsed from status.certificate
+type CertificateSigningRequest struct {
+	TypeMeta
+	ObjectMeta
+	Spec   CertificateSigningRequestSpec
+	Status CertificateSigningRequestStatus
+}
+
+type CertificateSigningRequestSpec struct {
+	SignerName        string
+	ExpirationSeconds *int32
+	Usages            []string // certificatesv1.KeyUsage
+	// Username, UID, Groups and Extra identify the requestor, they are set by the API server
+	Username string
+	UID      string
+	Groups   []string
+	Extra    map[string][]string
+}
+
+type CertificateSigningRequestStatus struct {
+	Conditions []CertificateSigningRequestCondition
+	// Certificate is nil until the certificate is issued, or if it can't be parsed
+	Certificate *IssuedCertificate
+}
+
+type CertificateSigningRequestCondition struct {
+	Type               string
+	Status             string
+	Reason             string
+	Message            string
+	LastUpdateTime     time.Time
+	LastTransitionTime time.Time
+}
+
+// IssuedCertificate holds the details of the first certificate of the chain issued for a CertificateSigningRequest
+type IssuedCertificate struct {
+	Subject      string
+	Issuer       string
+	SerialNumber string
+	NotBefore    time.Time
+	NotAfter     time.Time
+	DNSNames     []string
+	IPAddresses  []string
+	IsCA         bool
+}
+
+// ConvertIssuedCertificate parses the first certificate of a PEM encoded chain, it returns nil if there is none
+func ConvertIssuedCertificate(data []byte) *IssuedCertificate {
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil
+		}
+		ipAddresses := make([]string, len(certificate.IPAddresses))
+		for i, ip := range certificate.IPAddresses {
+			ipAddresses[i] = ip.String()
+		}
+		return &IssuedCertificate{
+			Subject:      certificate.Subject.String(),
+			Issuer:       certificate.Issuer.String(),
+			SerialNumber: certificate.SerialNumber.String(),
+			NotBefore:    certificate.NotBefore,
+			NotAfter:     certificate.NotAfter,
+			DNSNames:     certificate.DNSNames,
+			IPAddresses:  ipAddresses,
+			IsCA:         certificate.IsCA,
+		}
+	}
+	return nil
+}
+
+// ConvertCertificateSigningRequest creates a helper CertificateSigningRequest from a certificatesv1 CertificateSigningRequest
+func ConvertCertificateSigningRequest(csr *certificatesv1.CertificateSigningRequest) CertificateSigningRequest {
+	extra := make(map[string][]string, len(csr.Spec.Extra))
+	for key, values := range csr.Spec.Extra {
+		extra[key] = values
+	}
+	conditions := make([]CertificateSigningRequestCondition, len(csr.Status.Conditions))
+	for i, condition := range csr.Status.Conditions {
+		conditions[i] = CertificateSigningRequestCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastUpdateTime:     ConvertTime(condition.LastUpdateTime),
+			LastTransitionTime: ConvertTime(condition.LastTransitionTime),
+		}
+	}
+	return CertificateSigningRequest{
+		TypeMeta:   ConvertTypeMeta(csr.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&csr.ObjectMeta),
+		Spec: CertificateSigningRequestSpec{
+			SignerName:        csr.Spec.SignerName,
+			ExpirationSeconds: csr.Spec.ExpirationSeconds,
+			Usages:            convertStrings(csr.Spec.Usages),
+			Username:          csr.Spec.Username,
+			UID:               csr.Spec.UID,
+			Groups:            csr.Spec.Groups,
+			Extra:             extra,
+		},
+		Status: CertificateSigningRequestStatus{
+			Conditions:  conditions,
+			Certificate: ConvertIssuedCertificate(csr.Status.Certificate),
+		},
+	}
+}
+
+// ApprovalStatus returns Approved, Denied or Failed after the matching condition is set, Pending otherwise
+func (csr CertificateSigningRequest) ApprovalStatus() string {
+	status := "Pending"
+	for _, condition := range csr.Status.Conditions {
+		if condition.Status != "True" {
+			continue
+		}
+		switch condition.Type {
+		case string(certificatesv1.CertificateDenied), string(certificatesv1.CertificateFailed):
+			return condition.Type
+		case string(certificatesv1.CertificateApproved):
+			status = condition.Type
+		}
+	}
+	return status
+}
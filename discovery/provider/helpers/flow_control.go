@@ -0,0 +1,245 @@
+package helpers
+
+import (
+	"time"
+
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+)
+
+// FlowControlCondition is a condition of a FlowSchema or a PriorityLevelConfiguration
+type FlowControlCondition struct {
+	Type               string
+	Status             string
+	LastTransitionTime time.Time
+	Reason             string
+	Message            string
+}
+
+// --- FlowSchema (flowcontrolv1) ---
+type FlowSchema struct {
+	TypeMeta
+	ObjectMeta
+	Spec   FlowSchemaSpec
+	Status FlowSchemaStatus
+}
+
+type FlowSchemaSpec struct {
+	// PriorityLevelConfiguration is the name of the priority level the matching requests are assigned to
+	PriorityLevelConfiguration string
+	MatchingPrecedence         int32
+	DistinguisherMethod        *string // flowcontrolv1.FlowDistinguisherMethodType
+	Rules                      []PolicyRulesWithSubjects
+}
+
+type PolicyRulesWithSubjects struct {
+	Subjects         []FlowSchemaSubject
+	ResourceRules    []ResourcePolicyRule
+	NonResourceRules []NonResourcePolicyRule
+}
+
+// FlowSchemaSubject is a user, group or service account matched by a FlowSchema, Name and Namespace are
+// the ones of the subject of the given Kind
+type FlowSchemaSubject struct {
+	Kind      string
+	Name      string
+	Namespace string
+}
+
+type ResourcePolicyRule struct {
+	Verbs        []string
+	APIGroups    []string
+	Resources    []string
+	ClusterScope bool
+	Namespaces   []string
+}
+
+type NonResourcePolicyRule struct {
+	Verbs           []string
+	NonResourceURLs []string
+}
+
+type FlowSchemaStatus struct {
+	Conditions []FlowControlCondition
+}
+
+func ConvertFlowSchemaSubject(subject flowcontrolv1.Subject) FlowSchemaSubject {
+	converted := FlowSchemaSubject{Kind: string(subject.Kind)}
+	switch {
+	case subject.User != nil:
+		converted.Name = subject.User.Name
+	case subject.Group != nil:
+		converted.Name = subject.Group.Name
+	case subject.ServiceAccount != nil:
+		converted.Name = subject.ServiceAccount.Name
+		converted.Namespace = subject.ServiceAccount.Namespace
+	}
+	return converted
+}
+
+func ConvertPolicyRulesWithSubjects(rules flowcontrolv1.PolicyRulesWithSubjects) PolicyRulesWithSubjects {
+	subjects := make([]FlowSchemaSubject, len(rules.Subjects))
+	for i, subject := range rules.Subjects {
+		subjects[i] = ConvertFlowSchemaSubject(subject)
+	}
+	resourceRules := make([]ResourcePolicyRule, len(rules.ResourceRules))
+	for i, rule := range rules.ResourceRules {
+		resourceRules[i] = ResourcePolicyRule{
+			Verbs:        rule.Verbs,
+			APIGroups:    rule.APIGroups,
+			Resources:    rule.Resources,
+			ClusterScope: rule.ClusterScope,
+			Namespaces:   rule.Namespaces,
+		}
+	}
+	nonResourceRules := make([]NonResourcePolicyRule, len(rules.NonResourceRules))
+	for i, rule := range rules.NonResourceRules {
+		nonResourceRules[i] = NonResourcePolicyRule{
+			Verbs:           rule.Verbs,
+			NonResourceURLs: rule.NonResourceURLs,
+		}
+	}
+	return PolicyRulesWithSubjects{
+		Subjects:         subjects,
+		ResourceRules:    resourceRules,
+		NonResourceRules: nonResourceRules,
+	}
+}
+
+// ConvertFlowSchema creates a helper FlowSchema from a flowcontrolv1 FlowSchema
+func ConvertFlowSchema(fs *flowcontrolv1.FlowSchema) FlowSchema {
+	var distinguisherMethod *string
+	if fs.Spec.DistinguisherMethod != nil {
+		method := string(fs.Spec.DistinguisherMethod.Type)
+		distinguisherMethod = &method
+	}
+	rules := make([]PolicyRulesWithSubjects, len(fs.Spec.Rules))
+	for i, rule := range fs.Spec.Rules {
+		rules[i] = ConvertPolicyRulesWithSubjects(rule)
+	}
+	conditions := make([]FlowControlCondition, len(fs.Status.Conditions))
+	for i, condition := range fs.Status.Conditions {
+		conditions[i] = FlowControlCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: ConvertTime(condition.LastTransitionTime),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		}
+	}
+	return FlowSchema{
+		TypeMeta:   ConvertTypeMeta(fs.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&fs.ObjectMeta),
+		Spec: FlowSchemaSpec{
+			PriorityLevelConfiguration: fs.Spec.PriorityLevelConfiguration.Name,
+			MatchingPrecedence:         fs.Spec.MatchingPrecedence,
+			DistinguisherMethod:        distinguisherMethod,
+			Rules:                      rules,
+		},
+		Status: FlowSchemaStatus{Conditions: conditions},
+	}
+}
+
+// --- PriorityLevelConfiguration (flowcontrolv1) ---
+type PriorityLevelConfiguration struct {
+	TypeMeta
+	ObjectMeta
+	Spec   PriorityLevelConfigurationSpec
+	Status PriorityLevelConfigurationStatus
+}
+
+// PriorityLevelConfigurationSpec has Limited set for a Limited priority level and Exempt for an Exempt one
+type PriorityLevelConfigurationSpec struct {
+	Type    string // flowcontrolv1.PriorityLevelEnablement
+	Limited *LimitedPriorityLevelConfiguration
+	Exempt  *ExemptPriorityLevelConfiguration
+}
+
+type LimitedPriorityLevelConfiguration struct {
+	NominalConcurrencyShares *int32
+	LimitResponse            LimitResponse
+	LendablePercent          *int32
+	BorrowingLimitPercent    *int32
+}
+
+// LimitResponse tells whether requests over the concurrency limit are queued or rejected (HTTP 429)
+type LimitResponse struct {
+	Type    string // flowcontrolv1.LimitResponseType
+	Queuing *QueuingConfiguration
+}
+
+type QueuingConfiguration struct {
+	Queues           int32
+	HandSize         int32
+	QueueLengthLimit int32
+}
+
+type ExemptPriorityLevelConfiguration struct {
+	NominalConcurrencyShares *int32
+	LendablePercent          *int32
+}
+
+type PriorityLevelConfigurationStatus struct {
+	Conditions []FlowControlCondition
+}
+
+// ConvertPriorityLevelConfiguration creates a helper PriorityLevelConfiguration from a flowcontrolv1 PriorityLevelConfiguration
+func ConvertPriorityLevelConfiguration(plc *flowcontrolv1.PriorityLevelConfiguration) PriorityLevelConfiguration {
+	var limited *LimitedPriorityLevelConfiguration
+	if plc.Spec.Limited != nil {
+		var queuing *QueuingConfiguration
+		if plc.Spec.Limited.LimitResponse.Queuing != nil {
+			queuing = &QueuingConfiguration{
+				Queues:           plc.Spec.Limited.LimitResponse.Queuing.Queues,
+				HandSize:         plc.Spec.Limited.LimitResponse.Queuing.HandSize,
+				QueueLengthLimit: plc.Spec.Limited.LimitResponse.Queuing.QueueLengthLimit,
+			}
+		}
+		limited = &LimitedPriorityLevelConfiguration{
+			NominalConcurrencyShares: plc.Spec.Limited.NominalConcurrencyShares,
+			LimitResponse: LimitResponse{
+				Type:    string(plc.Spec.Limited.LimitResponse.Type),
+				Queuing: queuing,
+			},
+			LendablePercent:       plc.Spec.Limited.LendablePercent,
+			BorrowingLimitPercent: plc.Spec.Limited.BorrowingLimitPercent,
+		}
+	}
+	var exempt *ExemptPriorityLevelConfiguration
+	if plc.Spec.Exempt != nil {
+		exempt = &ExemptPriorityLevelConfiguration{
+			NominalConcurrencyShares: plc.Spec.Exempt.NominalConcurrencyShares,
+			LendablePercent:          plc.Spec.Exempt.LendablePercent,
+		}
+	}
+	conditions := make([]FlowControlCondition, len(plc.Status.Conditions))
+	for i, condition := range plc.Status.Conditions {
+		conditions[i] = FlowControlCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: ConvertTime(condition.LastTransitionTime),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		}
+	}
+	return PriorityLevelConfiguration{
+		TypeMeta:   ConvertTypeMeta(plc.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&plc.ObjectMeta),
+		Spec: PriorityLevelConfigurationSpec{
+			Type:    string(plc.Spec.Type),
+			Limited: limited,
+			Exempt:  exempt,
+		},
+		Status: PriorityLevelConfigurationStatus{Conditions: conditions},
+	}
+}
+
+// NominalConcurrencyShares returns the concurrency shares of the priority level, whether it is Limited or Exempt
+func (plc PriorityLevelConfiguration) NominalConcurrencyShares() *int32 {
+	switch {
+	case plc.Spec.Limited != nil:
+		return plc.Spec.Limited.NominalConcurrencyShares
+	case plc.Spec.Exempt != nil:
+		return plc.Spec.Exempt.NominalConcurrencyShares
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package helpers
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// FluxCrossNamespaceObjectReference references the source (e.g. a GitRepository) an object is reconciled from
+type FluxCrossNamespaceObjectReference struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+}
+
+// FluxNamespacedObjectReference references an object of the same kind, e.g. a dependency, the namespace of the referencing object if empty
+type FluxNamespacedObjectReference struct {
+	Name      string
+	Namespace string
+}
+
+// FluxLocalObjectReference references an object, e.g. a secret, in the namespace of the referencing object
+type FluxLocalObjectReference struct {
+	Name string
+}
+
+// FluxArtifact is the artifact produced by a source, the revision is e.g. "main@sha1:<commit>" for a GitRepository
+type FluxArtifact struct {
+	Path           string
+	URL            string
+	Revision       string
+	Digest         string
+	LastUpdateTime *time.Time
+	Size           *int64
+}
+
+// --- FluxKustomization ---
+type FluxKustomization struct {
+	TypeMeta
+	ObjectMeta
+	Spec   FluxKustomizationSpec
+	Status FluxKustomizationStatus
+}
+
+// FluxKustomizationSpec doesn't keep the post-build substitutions, they may contain secrets
+type FluxKustomizationSpec struct {
+	SourceRef          FluxCrossNamespaceObjectReference
+	Path               string
+	Interval           string
+	RetryInterval      string
+	Timeout            string
+	Suspend            bool
+	Prune              bool
+	Force              bool
+	Wait               bool
+	TargetNamespace    string
+	ServiceAccountName string
+	DependsOn          []FluxNamespacedObjectReference
+}
+
+type FluxKustomizationStatus struct {
+	ObservedGeneration    int64
+	LastAppliedRevision   string
+	LastAttemptedRevision string
+	Conditions            []metav1.Condition
+}
+
+// ConvertFluxKustomization creates a helper FluxKustomization from an unstructured kustomize.toolkit.fluxcd.io Kustomization
+func ConvertFluxKustomization(item *unstructured.Unstructured) (FluxKustomization, error) {
+	var kustomization FluxKustomization
+	if err := convertUnstructured(item, &kustomization); err != nil {
+		return FluxKustomization{}, err
+	}
+	kustomization.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return kustomization, nil
+}
+
+// --- FluxHelmRelease ---
+type FluxHelmRelease struct {
+	TypeMeta
+	ObjectMeta
+	Spec   FluxHelmReleaseSpec
+	Status FluxHelmReleaseStatus
+}
+
+// FluxHelmReleaseSpec has either Chart set, the chart is then fetched from a HelmRepository, GitRepository or Bucket,
+// or ChartRef set to an OCIRepository or HelmChart. The Helm values aren't kept, they may contain secrets.
+type FluxHelmReleaseSpec struct {
+	Chart              *FluxHelmChartTemplate
+	ChartRef           *FluxCrossNamespaceObjectReference
+	ReleaseName        string
+	TargetNamespace    string
+	StorageNamespace   string
+	Interval           string
+	Timeout            string
+	Suspend            bool
+	ServiceAccountName string
+	DependsOn          []FluxNamespacedObjectReference
+}
+
+type FluxHelmChartTemplate struct {
+	Spec FluxHelmChartTemplateSpec
+}
+
+type FluxHelmChartTemplateSpec struct {
+	Chart     string
+	Version   string
+	SourceRef FluxCrossNamespaceObjectReference
+	Interval  string
+}
+
+type FluxHelmReleaseStatus struct {
+	ObservedGeneration int64
+	// LastAppliedRevision is the version of the chart last released, set from the release history by the helm.toolkit.fluxcd.io/v2 controller
+	LastAppliedRevision   string
+	LastAttemptedRevision string
+	// History holds the latest releases first
+	History         []FluxHelmReleaseSnapshot
+	Failures        int64
+	InstallFailures int64
+	UpgradeFailures int64
+	Conditions      []metav1.Condition
+}
+
+type FluxHelmReleaseSnapshot struct {
+	Name          string
+	Namespace     string
+	Version       int
+	Status        string
+	ChartName     string
+	ChartVersion  string
+	AppVersion    string
+	FirstDeployed *time.Time
+	LastDeployed  *time.Time
+}
+
+// ConvertFluxHelmRelease creates a helper FluxHelmRelease from an unstructured helm.toolkit.fluxcd.io HelmRelease
+func ConvertFluxHelmRelease(item *unstructured.Unstructured) (FluxHelmRelease, error) {
+	var helmRelease FluxHelmRelease
+	if err := convertUnstructured(item, &helmRelease); err != nil {
+		return FluxHelmRelease{}, err
+	}
+	helmRelease.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	if helmRelease.Status.LastAppliedRevision == "" && len(helmRelease.Status.History) > 0 {
+		helmRelease.Status.LastAppliedRevision = helmRelease.Status.History[0].ChartVersion
+	}
+	return helmRelease, nil
+}
+
+// --- FluxGitRepository ---
+type FluxGitRepository struct {
+	TypeMeta
+	ObjectMeta
+	Spec   FluxGitRepositorySpec
+	Status FluxSourceStatus
+}
+
+type FluxGitRepositorySpec struct {
+	URL string
+	// Reference is the branch, tag, semver range, ref name or commit checked out, the default branch if nil
+	Reference *FluxGitRepositoryRef
+	SecretRef *FluxLocalObjectReference
+	Provider  string
+	Interval  string
+	Timeout   string
+	Suspend   bool
+}
+
+type FluxGitRepositoryRef struct {
+	Branch string
+	Tag    string
+	SemVer string
+	Name   string
+	Commit string
+}
+
+// FluxSourceStatus is the status shared by the Flux sources
+type FluxSourceStatus struct {
+	ObservedGeneration int64
+	// URL is the address the artifact (or, for OCI Helm repositories, the index) is served from by the source controller
+	URL        string
+	Artifact   *FluxArtifact
+	Conditions []metav1.Condition
+}
+
+// ConvertFluxGitRepository creates a helper FluxGitRepository from an unstructured source.toolkit.fluxcd.io GitRepository
+func ConvertFluxGitRepository(item *unstructured.Unstructured) (FluxGitRepository, error) {
+	var gitRepository FluxGitRepository
+	if err := convertUnstructured(item, &gitRepository); err != nil {
+		return FluxGitRepository{}, err
+	}
+	gitRepository.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return gitRepository, nil
+}
+
+// --- FluxHelmRepository ---
+type FluxHelmRepository struct {
+	TypeMeta
+	ObjectMeta
+	Spec   FluxHelmRepositorySpec
+	Status FluxSourceStatus
+}
+
+type FluxHelmRepositorySpec struct {
+	URL string
+	// Type is default for HTTP/S repositories or oci
+	Type            string
+	SecretRef       *FluxLocalObjectReference
+	Provider        string
+	PassCredentials bool
+	Insecure        bool
+	Interval        string
+	Timeout         string
+	Suspend         bool
+}
+
+// ConvertFluxHelmRepository creates a helper FluxHelmRepository from an unstructured source.toolkit.fluxcd.io HelmRepository
+func ConvertFluxHelmRepository(item *unstructured.Unstructured) (FluxHelmRepository, error) {
+	var helmRepository FluxHelmRepository
+	if err := convertUnstructured(item, &helmRepository); err != nil {
+		return FluxHelmRepository{}, err
+	}
+	helmRepository.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return helmRepository, nil
+}
+
+// --- FluxOCIRepository ---
+type FluxOCIRepository struct {
+	TypeMeta
+	ObjectMeta
+	Spec   FluxOCIRepositorySpec
+	Status FluxSourceStatus
+}
+
+type FluxOCIRepositorySpec struct {
+	URL string
+	// Reference is the digest, semver range or tag pulled, the latest tag if nil
+	Reference *FluxOCIRepositoryRef
+	SecretRef *FluxLocalObjectReference
+	Provider  string
+	Insecure  bool
+	Interval  string
+	Timeout   string
+	Suspend   bool
+}
+
+type FluxOCIRepositoryRef struct {
+	Digest string
+	SemVer string
+	Tag    string
+}
+
+// ConvertFluxOCIRepository creates a helper FluxOCIRepository from an unstructured source.toolkit.fluxcd.io OCIRepository
+func ConvertFluxOCIRepository(item *unstructured.Unstructured) (FluxOCIRepository, error) {
+	var ociRepository FluxOCIRepository
+	if err := convertUnstructured(item, &ociRepository); err != nil {
+		return FluxOCIRepository{}, err
+	}
+	ociRepository.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return ociRepository, nil
+}
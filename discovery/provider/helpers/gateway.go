@@ -0,0 +1,353 @@
+package helpers
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// --- IngressClass ---
+type IngressClass struct {
+	TypeMeta
+	ObjectMeta
+	Spec IngressClassSpec
+}
+
+type IngressClassSpec struct {
+	Controller string
+	Parameters *IngressClassParametersReference
+}
+
+type IngressClassParametersReference struct {
+	APIGroup  *string
+	Kind      string
+	Name      string
+	Scope     *string
+	Namespace *string
+}
+
+// ConvertIngressClass creates a helper IngressClass from a networkingv1 IngressClass
+func ConvertIngressClass(ic *networkingv1.IngressClass) IngressClass {
+	var parameters *IngressClassParametersReference
+	if ic.Spec.Parameters != nil {
+		parameters = &IngressClassParametersReference{
+			APIGroup:  ic.Spec.Parameters.APIGroup,
+			Kind:      ic.Spec.Parameters.Kind,
+			Name:      ic.Spec.Parameters.Name,
+			Scope:     ic.Spec.Parameters.Scope,
+			Namespace: ic.Spec.Parameters.Namespace,
+		}
+	}
+	return IngressClass{
+		TypeMeta:   ConvertTypeMeta(ic.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&ic.ObjectMeta),
+		Spec: IngressClassSpec{
+			Controller: ic.Spec.Controller,
+			Parameters: parameters,
+		},
+	}
+}
+
+// --- GatewayClass ---
+type GatewayClass struct {
+	TypeMeta
+	ObjectMeta
+	Spec   GatewayClassSpec
+	Status GatewayClassStatus
+}
+
+type GatewayClassSpec struct {
+	ControllerName string
+	ParametersRef  *GatewayParametersReference
+	Description    *string
+}
+
+type GatewayParametersReference struct {
+	Group     string
+	Kind      string
+	Name      string
+	Namespace *string
+}
+
+type GatewayClassStatus struct {
+	Conditions []metav1.Condition
+}
+
+// ConvertGatewayClass creates a helper GatewayClass from an unstructured gateway.networking.k8s.io GatewayClass
+func ConvertGatewayClass(item *unstructured.Unstructured) (GatewayClass, error) {
+	var gatewayClass GatewayClass
+	if err := convertUnstructured(item, &gatewayClass); err != nil {
+		return GatewayClass{}, err
+	}
+	gatewayClass.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return gatewayClass, nil
+}
+
+// --- Gateway ---
+type Gateway struct {
+	TypeMeta
+	ObjectMeta
+	Spec   GatewaySpec
+	Status GatewayStatus
+}
+
+type GatewaySpec struct {
+	GatewayClassName string
+	Listeners        []Listener
+	Addresses        []GatewayAddress
+}
+
+type Listener struct {
+	Name          string
+	Hostname      *string
+	Port          int32
+	Protocol      string
+	TLS           *GatewayTLSConfig
+	AllowedRoutes *AllowedRoutes
+}
+
+type GatewayTLSConfig struct {
+	Mode            *string
+	CertificateRefs []GatewayObjectReference
+}
+
+// GatewayObjectReference references an object of the cluster, e.g. the secret of a TLS certificate
+type GatewayObjectReference struct {
+	Group     *string
+	Kind      *string
+	Name      string
+	Namespace *string
+}
+
+type AllowedRoutes struct {
+	Namespaces *RouteNamespaces
+	Kinds      []RouteGroupKind
+}
+
+type RouteNamespaces struct {
+	From     *string
+	Selector *LabelSelector
+}
+
+type RouteGroupKind struct {
+	Group *string
+	Kind  string
+}
+
+type GatewayAddress struct {
+	Type  *string
+	Value string
+}
+
+type GatewayStatus struct {
+	Addresses  []GatewayAddress
+	Conditions []metav1.Condition
+	Listeners  []ListenerStatus
+}
+
+type ListenerStatus struct {
+	Name           string
+	SupportedKinds []RouteGroupKind
+	AttachedRoutes int32
+	Conditions     []metav1.Condition
+}
+
+// ConvertGateway creates a helper Gateway from an unstructured gateway.networking.k8s.io Gateway
+func ConvertGateway(item *unstructured.Unstructured) (Gateway, error) {
+	var gateway Gateway
+	if err := convertUnstructured(item, &gateway); err != nil {
+		return Gateway{}, err
+	}
+	gateway.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return gateway, nil
+}
+
+// --- Routes ---
+
+// ParentReference references the Gateway (or other parent) a route attaches to
+type ParentReference struct {
+	Group       *string
+	Kind        *string
+	Namespace   *string
+	Name        string
+	SectionName *string
+	Port        *int32
+}
+
+// BackendRef references the backend (usually a Service) requests matching a route rule are forwarded to
+type BackendRef struct {
+	Group     *string
+	Kind      *string
+	Name      string
+	Namespace *string
+	Port      *int32
+	Weight    *int32
+}
+
+type RouteStatus struct {
+	Parents []RouteParentStatus
+}
+
+type RouteParentStatus struct {
+	ParentRef      ParentReference
+	ControllerName string
+	Conditions     []metav1.Condition
+}
+
+// --- HTTPRoute ---
+type HTTPRoute struct {
+	TypeMeta
+	ObjectMeta
+	Spec   HTTPRouteSpec
+	Status RouteStatus
+}
+
+type HTTPRouteSpec struct {
+	ParentRefs []ParentReference
+	Hostnames  []string
+	Rules      []HTTPRouteRule
+}
+
+type HTTPRouteRule struct {
+	Name        *string
+	Matches     []HTTPRouteMatch
+	Filters     []map[string]interface{}
+	BackendRefs []HTTPBackendRef
+}
+
+type HTTPRouteMatch struct {
+	Path        *HTTPPathMatch
+	Headers     []HTTPHeaderMatch
+	QueryParams []HTTPHeaderMatch
+	Method      *string
+}
+
+type HTTPPathMatch struct {
+	Type  *string
+	Value *string
+}
+
+// HTTPHeaderMatch matches a header or a query parameter
+type HTTPHeaderMatch struct {
+	Type  *string
+	Name  string
+	Value string
+}
+
+type HTTPBackendRef struct {
+	BackendRef
+	Filters []map[string]interface{}
+}
+
+// ConvertHTTPRoute creates a helper HTTPRoute from an unstructured gateway.networking.k8s.io HTTPRoute
+func ConvertHTTPRoute(item *unstructured.Unstructured) (HTTPRoute, error) {
+	var httpRoute HTTPRoute
+	if err := convertUnstructured(item, &httpRoute); err != nil {
+		return HTTPRoute{}, err
+	}
+	httpRoute.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return httpRoute, nil
+}
+
+// BackendRefs returns the backends of every rule of the route
+func (r HTTPRoute) BackendRefs() []BackendRef {
+	var backendRefs []BackendRef
+	for _, rule := range r.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, backendRef.BackendRef)
+		}
+	}
+	return backendRefs
+}
+
+// --- GRPCRoute ---
+type GRPCRoute struct {
+	TypeMeta
+	ObjectMeta
+	Spec   GRPCRouteSpec
+	Status RouteStatus
+}
+
+type GRPCRouteSpec struct {
+	ParentRefs []ParentReference
+	Hostnames  []string
+	Rules      []GRPCRouteRule
+}
+
+type GRPCRouteRule struct {
+	Name        *string
+	Matches     []GRPCRouteMatch
+	Filters     []map[string]interface{}
+	BackendRefs []GRPCBackendRef
+}
+
+type GRPCRouteMatch struct {
+	Method  *GRPCMethodMatch
+	Headers []HTTPHeaderMatch
+}
+
+type GRPCMethodMatch struct {
+	Type    *string
+	Service *string
+	Method  *string
+}
+
+type GRPCBackendRef struct {
+	BackendRef
+	Filters []map[string]interface{}
+}
+
+// ConvertGRPCRoute creates a helper GRPCRoute from an unstructured gateway.networking.k8s.io GRPCRoute
+func ConvertGRPCRoute(item *unstructured.Unstructured) (GRPCRoute, error) {
+	var grpcRoute GRPCRoute
+	if err := convertUnstructured(item, &grpcRoute); err != nil {
+		return GRPCRoute{}, err
+	}
+	grpcRoute.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return grpcRoute, nil
+}
+
+// BackendRefs returns the backends of every rule of the route
+func (r GRPCRoute) BackendRefs() []BackendRef {
+	var backendRefs []BackendRef
+	for _, rule := range r.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, backendRef.BackendRef)
+		}
+	}
+	return backendRefs
+}
+
+// --- ReferenceGrant ---
+type ReferenceGrant struct {
+	TypeMeta
+	ObjectMeta
+	Spec ReferenceGrantSpec
+}
+
+type ReferenceGrantSpec struct {
+	From []ReferenceGrantFrom
+	To   []ReferenceGrantTo
+}
+
+type ReferenceGrantFrom struct {
+	Group     string
+	Kind      string
+	Namespace string
+}
+
+type ReferenceGrantTo struct {
+	Group string
+	Kind  string
+	Name  *string
+}
+
+// ConvertReferenceGrant creates a helper ReferenceGrant from an unstructured gateway.networking.k8s.io ReferenceGrant
+func ConvertReferenceGrant(item *unstructured.Unstructured) (ReferenceGrant, error) {
+	var referenceGrant ReferenceGrant
+	if err := convertUnstructured(item, &referenceGrant); err != nil {
+		return ReferenceGrant{}, err
+	}
+	referenceGrant.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return referenceGrant, nil
+}
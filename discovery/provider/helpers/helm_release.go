@@ -0,0 +1,111 @@
+package helpers
+
+import (
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/release"
+)
+
+const RedactedValue = "REDACTED"
+
+// sensitiveValueKeys are the (lowercase) key fragments whose values are never stored as-is
+var sensitiveValueKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"apikey",
+	"api_key",
+	"api-key",
+	"privatekey",
+	"private_key",
+	"private-key",
+	"accesskey",
+	"access_key",
+	"access-key",
+	"credential",
+	"connectionstring",
+	"connection_string",
+	"tls.key",
+}
+
+// --- HelmRelease ---
+type HelmRelease struct {
+	Name          string
+	Namespace     string
+	Revision      int
+	Status        string
+	Description   string
+	FirstDeployed time.Time
+	LastDeployed  time.Time
+	ChartName     string
+	ChartVersion  string
+	AppVersion    string
+	Values        map[string]interface{}
+	Labels        map[string]string
+}
+
+// ConvertHelmRelease creates a helper HelmRelease from a helm release, the values are redacted
+func ConvertHelmRelease(r *release.Release) HelmRelease {
+	helmRelease := HelmRelease{
+		Name:      r.Name,
+		Namespace: r.Namespace,
+		Revision:  r.Version,
+		Values:    RedactHelmValues(r.Config),
+		Labels:    r.Labels,
+	}
+	if r.Info != nil {
+		helmRelease.Status = r.Info.Status.String()
+		helmRelease.Description = r.Info.Description
+		helmRelease.FirstDeployed = r.Info.FirstDeployed.Time
+		helmRelease.LastDeployed = r.Info.LastDeployed.Time
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		helmRelease.ChartName = r.Chart.Metadata.Name
+		helmRelease.ChartVersion = r.Chart.Metadata.Version
+		helmRelease.AppVersion = r.Chart.Metadata.AppVersion
+	}
+	return helmRelease
+}
+
+// RedactHelmValues returns a copy of the values with every value under a sensitive key replaced by RedactedValue
+func RedactHelmValues(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if isSensitiveValueKey(k) && v != nil {
+			redacted[k] = RedactedValue
+			continue
+		}
+		redacted[k] = redactHelmValue(v)
+	}
+	return redacted
+}
+
+func redactHelmValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return RedactHelmValues(v)
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = redactHelmValue(item)
+		}
+		return items
+	default:
+		return v
+	}
+}
+
+func isSensitiveValueKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveValueKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
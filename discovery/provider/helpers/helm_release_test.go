@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedactHelmValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		want   map[string]interface{}
+	}{
+		{
+			name:   "nil values",
+			values: nil,
+			want:   nil,
+		},
+		{
+			name:   "non sensitive keys are kept",
+			values: map[string]interface{}{"replicaCount": 3, "image": map[string]interface{}{"tag": "1.2.3"}},
+			want:   map[string]interface{}{"replicaCount": 3, "image": map[string]interface{}{"tag": "1.2.3"}},
+		},
+		{
+			name:   "sensitive keys are matched case-insensitively as fragments",
+			values: map[string]interface{}{"adminPassword": "hunter2", "GITHUB_TOKEN": "ghp_x", "aws_access_key_id": "AKIA", "tls.key": "-----BEGIN"},
+			want:   map[string]interface{}{"adminPassword": RedactedValue, "GITHUB_TOKEN": RedactedValue, "aws_access_key_id": RedactedValue, "tls.key": RedactedValue},
+		},
+		{
+			name:   "whole subtrees under a sensitive key are redacted",
+			values: map[string]interface{}{"existingSecret": map[string]interface{}{"name": "db", "key": "password"}},
+			want:   map[string]interface{}{"existingSecret": RedactedValue},
+		},
+		{
+			name: "nested maps and lists are redacted",
+			values: map[string]interface{}{
+				"postgresql": map[string]interface{}{"auth": map[string]interface{}{"username": "app", "password": "s3cr3t"}},
+				"env":        []interface{}{map[string]interface{}{"name": "API_KEY", "apiKey": "abc"}, "plain"},
+			},
+			want: map[string]interface{}{
+				"postgresql": map[string]interface{}{"auth": map[string]interface{}{"username": "app", "password": RedactedValue}},
+				"env":        []interface{}{map[string]interface{}{"name": "API_KEY", "apiKey": RedactedValue}, "plain"},
+			},
+		},
+		{
+			name:   "nil sensitive values are kept as nil",
+			values: map[string]interface{}{"password": nil},
+			want:   map[string]interface{}{"password": nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RedactHelmValues(tt.values)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RedactHelmValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactHelmValuesDoesNotModifyInput(t *testing.T) {
+	values := map[string]interface{}{"auth": map[string]interface{}{"password": "s3cr3t"}}
+	RedactHelmValues(values)
+	if got := values["auth"].(map[string]interface{})["password"]; got != "s3cr3t" {
+		t.Errorf("input values were modified, password = %v", got)
+	}
+}
@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// --- Lease (coordinationv1) ---
+type Lease struct {
+	TypeMeta
+	ObjectMeta
+	Spec LeaseSpec
+}
+
+type LeaseSpec struct {
+	HolderIdentity       *string
+	LeaseDurationSeconds *int32
+	AcquireTime          *time.Time
+	RenewTime            *time.Time
+	LeaseTransitions     *int32
+	Strategy             *string // coordinationv1.CoordinatedLeaseStrategy
+	PreferredHolder      *string
+}
+
+func ConvertMicroTimePtr(timestamp *metav1.MicroTime) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+	return &timestamp.Time
+}
+
+// ConvertLease creates a helper Lease from a coordinationv1 Lease
+func ConvertLease(lease *coordinationv1.Lease) Lease {
+	return Lease{
+		TypeMeta:   ConvertTypeMeta(lease.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&lease.ObjectMeta),
+		Spec: LeaseSpec{
+			HolderIdentity:       lease.Spec.HolderIdentity,
+			LeaseDurationSeconds: lease.Spec.LeaseDurationSeconds,
+			AcquireTime:          ConvertMicroTimePtr(lease.Spec.AcquireTime),
+			RenewTime:            ConvertMicroTimePtr(lease.Spec.RenewTime),
+			LeaseTransitions:     lease.Spec.LeaseTransitions,
+			Strategy:             convertStringPtr(lease.Spec.Strategy),
+			PreferredHolder:      lease.Spec.PreferredHolder,
+		},
+	}
+}
+
+// ExpiresAt returns the time the lease expires if it isn't renewed, nil if it was never renewed or has no duration
+func (l Lease) ExpiresAt() *time.Time {
+	if l.Spec.RenewTime == nil || l.Spec.LeaseDurationSeconds == nil {
+		return nil
+	}
+	expiresAt := l.Spec.RenewTime.Add(time.Duration(*l.Spec.LeaseDurationSeconds) * time.Second)
+	return &expiresAt
+}
+
+// IsStale returns true if the lease wasn't renewed within its duration at the given time,
+// a lease without holder, renew time or duration is stale as well
+func (l Lease) IsStale(now time.Time) bool {
+	if l.Spec.HolderIdentity == nil || *l.Spec.HolderIdentity == "" {
+		return true
+	}
+	expiresAt := l.ExpiresAt()
+	return expiresAt == nil || now.After(*expiresAt)
+}
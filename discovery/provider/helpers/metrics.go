@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ResourceUsage is the CPU and memory usage reported by the metrics API, normalized to millicores and bytes
+type ResourceUsage struct {
+	// Usage is the raw usage reported by the metrics API, e.g. {"cpu": "250m", "memory": "128Mi"}
+	Usage         map[string]string
+	CPUMillicores int64
+	MemoryBytes   int64
+}
+
+func ConvertResourceUsage(usage map[string]string) (ResourceUsage, error) {
+	converted := ResourceUsage{Usage: usage}
+	if cpu, ok := usage[string(corev1.ResourceCPU)]; ok {
+		quantity, err := resource.ParseQuantity(cpu)
+		if err != nil {
+			return ResourceUsage{}, fmt.Errorf("invalid cpu usage %q: %w", cpu, err)
+		}
+		converted.CPUMillicores = quantity.MilliValue()
+	}
+	if memory, ok := usage[string(corev1.ResourceMemory)]; ok {
+		quantity, err := resource.ParseQuantity(memory)
+		if err != nil {
+			return ResourceUsage{}, fmt.Errorf("invalid memory usage %q: %w", memory, err)
+		}
+		converted.MemoryBytes = quantity.Value()
+	}
+	return converted, nil
+}
+
+// --- NodeMetric ---
+type NodeMetric struct {
+	TypeMeta
+	ObjectMeta
+	// Timestamp is the end of the window the usage was collected over
+	Timestamp time.Time
+	Window    string
+	ResourceUsage
+}
+
+// ConvertNodeMetric creates a helper NodeMetric from an unstructured metrics.k8s.io NodeMetrics
+func ConvertNodeMetric(item *unstructured.Unstructured) (NodeMetric, error) {
+	var nodeMetrics struct {
+		Timestamp time.Time
+		Window    string
+		Usage     map[string]string
+	}
+	if err := convertUnstructured(item, &nodeMetrics); err != nil {
+		return NodeMetric{}, err
+	}
+	usage, err := ConvertResourceUsage(nodeMetrics.Usage)
+	if err != nil {
+		return NodeMetric{}, err
+	}
+	return NodeMetric{
+		TypeMeta:      TypeMeta{Kind: item.GetKind(), APIVersion: item.GetAPIVersion()},
+		ObjectMeta:    ConvertUnstructuredObjectMeta(item),
+		Timestamp:     nodeMetrics.Timestamp,
+		Window:        nodeMetrics.Window,
+		ResourceUsage: usage,
+	}, nil
+}
+
+// --- PodMetric ---
+
+// PodMetric holds the usage of each container of a pod, and their sum in CPUMillicores and MemoryBytes
+type PodMetric struct {
+	TypeMeta
+	ObjectMeta
+	// Timestamp is the end of the window the usage was collected over
+	Timestamp  time.Time
+	Window     string
+	Containers []ContainerMetric
+	ResourceUsage
+}
+
+type ContainerMetric struct {
+	Name string
+	ResourceUsage
+}
+
+// ConvertPodMetric creates a helper PodMetric from an unstructured metrics.k8s.io PodMetrics
+func ConvertPodMetric(item *unstructured.Unstructured) (PodMetric, error) {
+	var podMetrics struct {
+		Timestamp  time.Time
+		Window     string
+		Containers []struct {
+			Name  string
+			Usage map[string]string
+		}
+	}
+	if err := convertUnstructured(item, &podMetrics); err != nil {
+		return PodMetric{}, err
+	}
+	podMetric := PodMetric{
+		TypeMeta:   TypeMeta{Kind: item.GetKind(), APIVersion: item.GetAPIVersion()},
+		ObjectMeta: ConvertUnstructuredObjectMeta(item),
+		Timestamp:  podMetrics.Timestamp,
+		Window:     podMetrics.Window,
+		Containers: make([]ContainerMetric, len(podMetrics.Containers)),
+	}
+	for i, container := range podMetrics.Containers {
+		usage, err := ConvertResourceUsage(container.Usage)
+		if err != nil {
+			return PodMetric{}, fmt.Errorf("container %s: %w", container.Name, err)
+		}
+		podMetric.Containers[i] = ContainerMetric{Name: container.Name, ResourceUsage: usage}
+		podMetric.CPUMillicores += usage.CPUMillicores
+		podMetric.MemoryBytes += usage.MemoryBytes
+	}
+	return podMetric, nil
+}
@@ -207,6 +207,27 @@ func ConvertLabelSelector(ls *metav1.LabelSelector) *LabelSelector {
 	}
 }
 
+// String returns the query string representation of the selector (e.g. "app=web,tier in (frontend)"),
+// an empty string if the selector is invalid
+func (ls *LabelSelector) String() string {
+	if ls == nil {
+		return ""
+	}
+	selector := &metav1.LabelSelector{MatchLabels: ls.MatchLabels}
+	for _, requirement := range ls.MatchExpressions {
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      requirement.Key,
+			Operator: metav1.LabelSelectorOperator(requirement.Operator),
+			Values:   requirement.Values,
+		})
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return ""
+	}
+	return labelSelector.String()
+}
+
 // --- ObjectReference (Single Correct Definition) ---
 type ObjectReference struct {
 	Kind            string
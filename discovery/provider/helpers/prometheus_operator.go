@@ -0,0 +1,187 @@
+package helpers
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// NamespaceSelector selects the namespaces a monitor discovers its targets in, the namespace of the monitor if empty
+type NamespaceSelector struct {
+	Any        bool
+	MatchNames []string
+}
+
+// --- ServiceMonitor ---
+type ServiceMonitor struct {
+	TypeMeta
+	ObjectMeta
+	Spec ServiceMonitorSpec
+}
+
+type ServiceMonitorSpec struct {
+	JobLabel          string
+	TargetLabels      []string
+	PodTargetLabels   []string
+	Endpoints         []ServiceMonitorEndpoint
+	Selector          LabelSelector
+	NamespaceSelector NamespaceSelector
+	SampleLimit       *int64
+}
+
+// ServiceMonitorEndpoint is a scraped port of the selected services, either a named service port or a target port of their pods
+type ServiceMonitorEndpoint struct {
+	Port          string
+	TargetPort    *intstr.IntOrString
+	Path          string
+	Scheme        string
+	Interval      string
+	ScrapeTimeout string
+	HonorLabels   bool
+}
+
+// ConvertServiceMonitor creates a helper ServiceMonitor from an unstructured monitoring.coreos.com ServiceMonitor
+func ConvertServiceMonitor(item *unstructured.Unstructured) (ServiceMonitor, error) {
+	var serviceMonitor ServiceMonitor
+	if err := convertUnstructured(item, &serviceMonitor); err != nil {
+		return ServiceMonitor{}, err
+	}
+	serviceMonitor.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return serviceMonitor, nil
+}
+
+// --- PodMonitor ---
+type PodMonitor struct {
+	TypeMeta
+	ObjectMeta
+	Spec PodMonitorSpec
+}
+
+type PodMonitorSpec struct {
+	JobLabel            string
+	PodTargetLabels     []string
+	PodMetricsEndpoints []PodMetricsEndpoint
+	Selector            LabelSelector
+	NamespaceSelector   NamespaceSelector
+	SampleLimit         *int64
+}
+
+// PodMetricsEndpoint is a scraped port of the selected pods, a named container port or a port number
+type PodMetricsEndpoint struct {
+	Port          *string
+	PortNumber    *int32
+	TargetPort    *intstr.IntOrString
+	Path          string
+	Scheme        string
+	Interval      string
+	ScrapeTimeout string
+	HonorLabels   bool
+}
+
+// ConvertPodMonitor creates a helper PodMonitor from an unstructured monitoring.coreos.com PodMonitor
+func ConvertPodMonitor(item *unstructured.Unstructured) (PodMonitor, error) {
+	var podMonitor PodMonitor
+	if err := convertUnstructured(item, &podMonitor); err != nil {
+		return PodMonitor{}, err
+	}
+	podMonitor.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return podMonitor, nil
+}
+
+// --- PrometheusRule ---
+type PrometheusRule struct {
+	TypeMeta
+	ObjectMeta
+	Spec PrometheusRuleSpec
+}
+
+type PrometheusRuleSpec struct {
+	Groups []RuleGroup
+}
+
+type RuleGroup struct {
+	Name     string
+	Interval *string
+	Rules    []Rule
+}
+
+// Rule is either a recording rule (Record is set) or an alerting rule (Alert is set)
+type Rule struct {
+	Record      string
+	Alert       string
+	Expr        intstr.IntOrString
+	For         *string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// ConvertPrometheusRule creates a helper PrometheusRule from an unstructured monitoring.coreos.com PrometheusRule
+func ConvertPrometheusRule(item *unstructured.Unstructured) (PrometheusRule, error) {
+	var prometheusRule PrometheusRule
+	if err := convertUnstructured(item, &prometheusRule); err != nil {
+		return PrometheusRule{}, err
+	}
+	prometheusRule.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return prometheusRule, nil
+}
+
+// AlertNames returns the names of the alerting rules of every group
+func (r PrometheusRule) AlertNames() []string {
+	var alerts []string
+	for _, group := range r.Spec.Groups {
+		for _, rule := range group.Rules {
+			if rule.Alert != "" {
+				alerts = append(alerts, rule.Alert)
+			}
+		}
+	}
+	return alerts
+}
+
+// --- Prometheus ---
+type Prometheus struct {
+	TypeMeta
+	ObjectMeta
+	Spec   PrometheusSpec
+	Status PrometheusStatus
+}
+
+// PrometheusSpec keeps the selectors of the monitors and rules loaded by the Prometheus instance, nil selectors select nothing
+// and nil namespace selectors select the namespace of the Prometheus
+type PrometheusSpec struct {
+	Version                         string
+	Replicas                        *int32
+	Shards                          *int32
+	ServiceAccountName              string
+	ServiceMonitorSelector          *LabelSelector
+	ServiceMonitorNamespaceSelector *LabelSelector
+	PodMonitorSelector              *LabelSelector
+	PodMonitorNamespaceSelector     *LabelSelector
+	ProbeSelector                   *LabelSelector
+	ProbeNamespaceSelector          *LabelSelector
+	RuleSelector                    *LabelSelector
+	RuleNamespaceSelector           *LabelSelector
+	ExternalLabels                  map[string]string
+	Retention                       string
+	RetentionSize                   string
+	Alerting                        map[string]interface{}
+}
+
+type PrometheusStatus struct {
+	Paused              bool
+	Replicas            int32
+	UpdatedReplicas     int32
+	AvailableReplicas   int32
+	UnavailableReplicas int32
+	Conditions          []metav1.Condition
+}
+
+// ConvertPrometheus creates a helper Prometheus from an unstructured monitoring.coreos.com Prometheus
+func ConvertPrometheus(item *unstructured.Unstructured) (Prometheus, error) {
+	var prometheus Prometheus
+	if err := convertUnstructured(item, &prometheus); err != nil {
+		return Prometheus{}, err
+	}
+	prometheus.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return prometheus, nil
+}
@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	nodev1 "k8s.io/api/node/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// --- PriorityClass (schedulingv1) ---
+type PriorityClass struct {
+	TypeMeta
+	ObjectMeta
+	Value            int32
+	GlobalDefault    bool
+	Description      string
+	PreemptionPolicy *string // corev1.PreemptionPolicy
+}
+
+// ConvertPriorityClass creates a helper PriorityClass from a schedulingv1 PriorityClass
+func ConvertPriorityClass(pc *schedulingv1.PriorityClass) PriorityClass {
+	return PriorityClass{
+		TypeMeta:         ConvertTypeMeta(pc.TypeMeta),
+		ObjectMeta:       ConvertObjectMeta(&pc.ObjectMeta),
+		Value:            pc.Value,
+		GlobalDefault:    pc.GlobalDefault,
+		Description:      pc.Description,
+		PreemptionPolicy: convertStringPtr(pc.PreemptionPolicy),
+	}
+}
+
+// --- RuntimeClass (nodev1) ---
+type RuntimeClass struct {
+	TypeMeta
+	ObjectMeta
+	Handler    string
+	Overhead   *Overhead
+	Scheduling *RuntimeClassScheduling
+}
+
+// Overhead is the resources consumed by the runtime of a pod in addition to its containers
+type Overhead struct {
+	PodFixed map[string]resource.Quantity
+}
+
+// RuntimeClassScheduling constrains the pods of a RuntimeClass to the nodes supporting it
+type RuntimeClassScheduling struct {
+	NodeSelector map[string]string
+	Tolerations  []Toleration
+}
+
+// ConvertRuntimeClass creates a helper RuntimeClass from a nodev1 RuntimeClass
+func ConvertRuntimeClass(rc *nodev1.RuntimeClass) RuntimeClass {
+	var overhead *Overhead
+	if rc.Overhead != nil {
+		overhead = &Overhead{
+			PodFixed: ConvertResourceList(rc.Overhead.PodFixed),
+		}
+	}
+	var scheduling *RuntimeClassScheduling
+	if rc.Scheduling != nil {
+		scheduling = &RuntimeClassScheduling{
+			NodeSelector: rc.Scheduling.NodeSelector,
+			Tolerations:  ConvertTolerations(rc.Scheduling.Tolerations),
+		}
+	}
+	return RuntimeClass{
+		TypeMeta:   ConvertTypeMeta(rc.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&rc.ObjectMeta),
+		Handler:    rc.Handler,
+		Overhead:   overhead,
+		Scheduling: scheduling,
+	}
+}
@@ -0,0 +1,272 @@
+package helpers
+
+import (
+	"time"
+
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// --- CSIDriver (storagev1) ---
+type CSIDriver struct {
+	TypeMeta
+	ObjectMeta
+	Spec CSIDriverSpec
+}
+
+type CSIDriverSpec struct {
+	AttachRequired       *bool
+	PodInfoOnMount       *bool
+	VolumeLifecycleModes []string // storagev1.VolumeLifecycleMode
+	StorageCapacity      *bool
+	FSGroupPolicy        *string // storagev1.FSGroupPolicy
+	TokenRequests        []TokenRequest
+	RequiresRepublish    *bool
+	SELinuxMount         *bool
+}
+
+type TokenRequest struct {
+	Audience          string
+	ExpirationSeconds *int64
+}
+
+// ConvertCSIDriver creates a helper CSIDriver from a storagev1 CSIDriver
+func ConvertCSIDriver(driver *storagev1.CSIDriver) CSIDriver {
+	var tokenRequests []TokenRequest
+	for _, tokenRequest := range driver.Spec.TokenRequests {
+		tokenRequests = append(tokenRequests, TokenRequest{
+			Audience:          tokenRequest.Audience,
+			ExpirationSeconds: tokenRequest.ExpirationSeconds,
+		})
+	}
+	return CSIDriver{
+		TypeMeta:   ConvertTypeMeta(driver.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&driver.ObjectMeta),
+		Spec: CSIDriverSpec{
+			AttachRequired:       driver.Spec.AttachRequired,
+			PodInfoOnMount:       driver.Spec.PodInfoOnMount,
+			VolumeLifecycleModes: convertStrings(driver.Spec.VolumeLifecycleModes),
+			StorageCapacity:      driver.Spec.StorageCapacity,
+			FSGroupPolicy:        convertStringPtr(driver.Spec.FSGroupPolicy),
+			TokenRequests:        tokenRequests,
+			RequiresRepublish:    driver.Spec.RequiresRepublish,
+			SELinuxMount:         driver.Spec.SELinuxMount,
+		},
+	}
+}
+
+// --- CSINode (storagev1) ---
+type CSINode struct {
+	TypeMeta
+	ObjectMeta
+	Spec CSINodeSpec
+}
+
+type CSINodeSpec struct {
+	Drivers []CSINodeDriver
+}
+
+type CSINodeDriver struct {
+	Name         string
+	NodeID       string
+	TopologyKeys []string
+	// AllocatableCount is the maximum number of volumes of the driver that can be attached to the node
+	AllocatableCount *int32
+}
+
+// ConvertCSINode creates a helper CSINode from a storagev1 CSINode
+func ConvertCSINode(node *storagev1.CSINode) CSINode {
+	drivers := make([]CSINodeDriver, len(node.Spec.Drivers))
+	for i, driver := range node.Spec.Drivers {
+		drivers[i] = CSINodeDriver{
+			Name:         driver.Name,
+			NodeID:       driver.NodeID,
+			TopologyKeys: driver.TopologyKeys,
+		}
+		if driver.Allocatable != nil {
+			drivers[i].AllocatableCount = driver.Allocatable.Count
+		}
+	}
+	return CSINode{
+		TypeMeta:   ConvertTypeMeta(node.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&node.ObjectMeta),
+		Spec:       CSINodeSpec{Drivers: drivers},
+	}
+}
+
+// --- VolumeAttachment (storagev1) ---
+type VolumeAttachment struct {
+	TypeMeta
+	ObjectMeta
+	Spec   VolumeAttachmentSpec
+	Status VolumeAttachmentStatus
+}
+
+type VolumeAttachmentSpec struct {
+	Attacher string
+	Source   VolumeAttachmentSource
+	NodeName string
+}
+
+type VolumeAttachmentSource struct {
+	PersistentVolumeName *string
+	// InlineVolumeSpec is set for inline volumes of pods that were migrated to a CSI driver
+	InlineVolumeSpec *PersistentVolumeSpec
+}
+
+type VolumeAttachmentStatus struct {
+	Attached           bool
+	AttachmentMetadata map[string]string
+	AttachError        *VolumeError
+	DetachError        *VolumeError
+}
+
+type VolumeError struct {
+	Time    time.Time
+	Message string
+}
+
+func ConvertVolumeError(volumeError *storagev1.VolumeError) *VolumeError {
+	if volumeError == nil {
+		return nil
+	}
+	return &VolumeError{
+		Time:    ConvertTime(volumeError.Time),
+		Message: volumeError.Message,
+	}
+}
+
+// ConvertVolumeAttachment creates a helper VolumeAttachment from a storagev1 VolumeAttachment
+func ConvertVolumeAttachment(attachment *storagev1.VolumeAttachment) VolumeAttachment {
+	var inlineVolumeSpec *PersistentVolumeSpec
+	if attachment.Spec.Source.InlineVolumeSpec != nil {
+		spec := ConvertPersistentVolumeSpec(*attachment.Spec.Source.InlineVolumeSpec)
+		inlineVolumeSpec = &spec
+	}
+	return VolumeAttachment{
+		TypeMeta:   ConvertTypeMeta(attachment.TypeMeta),
+		ObjectMeta: ConvertObjectMeta(&attachment.ObjectMeta),
+		Spec: VolumeAttachmentSpec{
+			Attacher: attachment.Spec.Attacher,
+			Source: VolumeAttachmentSource{
+				PersistentVolumeName: attachment.Spec.Source.PersistentVolumeName,
+				InlineVolumeSpec:     inlineVolumeSpec,
+			},
+			NodeName: attachment.Spec.NodeName,
+		},
+		Status: VolumeAttachmentStatus{
+			Attached:           attachment.Status.Attached,
+			AttachmentMetadata: attachment.Status.AttachmentMetadata,
+			AttachError:        ConvertVolumeError(attachment.Status.AttachError),
+			DetachError:        ConvertVolumeError(attachment.Status.DetachError),
+		},
+	}
+}
+
+// --- VolumeSnapshot ---
+type VolumeSnapshot struct {
+	TypeMeta
+	ObjectMeta
+	Spec   VolumeSnapshotSpec
+	Status *VolumeSnapshotStatus
+}
+
+type VolumeSnapshotSpec struct {
+	Source                  VolumeSnapshotSource
+	VolumeSnapshotClassName *string
+}
+
+// VolumeSnapshotSource is either the PersistentVolumeClaim to snapshot (dynamic provisioning)
+// or a pre-existing VolumeSnapshotContent
+type VolumeSnapshotSource struct {
+	PersistentVolumeClaimName *string
+	VolumeSnapshotContentName *string
+}
+
+type VolumeSnapshotStatus struct {
+	BoundVolumeSnapshotContentName *string
+	// CreationTime is the time the snapshot was taken by the storage system
+	CreationTime            *time.Time
+	ReadyToUse              *bool
+	RestoreSize             *string // resource.Quantity
+	Error                   *VolumeSnapshotError
+	VolumeGroupSnapshotName *string
+}
+
+type VolumeSnapshotError struct {
+	Time    *time.Time
+	Message *string
+}
+
+// ConvertVolumeSnapshot creates a helper VolumeSnapshot from an unstructured snapshot.storage.k8s.io VolumeSnapshot
+func ConvertVolumeSnapshot(item *unstructured.Unstructured) (VolumeSnapshot, error) {
+	var volumeSnapshot VolumeSnapshot
+	if err := convertUnstructured(item, &volumeSnapshot); err != nil {
+		return VolumeSnapshot{}, err
+	}
+	volumeSnapshot.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return volumeSnapshot, nil
+}
+
+// --- VolumeSnapshotClass ---
+type VolumeSnapshotClass struct {
+	TypeMeta
+	ObjectMeta
+	Driver         string
+	Parameters     map[string]string
+	DeletionPolicy string
+}
+
+// ConvertVolumeSnapshotClass creates a helper VolumeSnapshotClass from an unstructured snapshot.storage.k8s.io VolumeSnapshotClass
+func ConvertVolumeSnapshotClass(item *unstructured.Unstructured) (VolumeSnapshotClass, error) {
+	var volumeSnapshotClass VolumeSnapshotClass
+	if err := convertUnstructured(item, &volumeSnapshotClass); err != nil {
+		return VolumeSnapshotClass{}, err
+	}
+	volumeSnapshotClass.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return volumeSnapshotClass, nil
+}
+
+// --- VolumeSnapshotContent ---
+type VolumeSnapshotContent struct {
+	TypeMeta
+	ObjectMeta
+	Spec   VolumeSnapshotContentSpec
+	Status *VolumeSnapshotContentStatus
+}
+
+type VolumeSnapshotContentSpec struct {
+	VolumeSnapshotRef       ObjectReference
+	DeletionPolicy          string
+	Driver                  string
+	VolumeSnapshotClassName *string
+	Source                  VolumeSnapshotContentSource
+	SourceVolumeMode        *string
+}
+
+// VolumeSnapshotContentSource is either the handle of the snapshotted volume (dynamic provisioning)
+// or the handle of a pre-existing snapshot of the storage system
+type VolumeSnapshotContentSource struct {
+	VolumeHandle   *string
+	SnapshotHandle *string
+}
+
+type VolumeSnapshotContentStatus struct {
+	SnapshotHandle *string
+	// CreationTime is the time the snapshot was taken by the storage system, in nanoseconds since the epoch
+	CreationTime              *int64
+	RestoreSize               *int64
+	ReadyToUse                *bool
+	Error                     *VolumeSnapshotError
+	VolumeGroupSnapshotHandle *string
+}
+
+// ConvertVolumeSnapshotContent creates a helper VolumeSnapshotContent from an unstructured snapshot.storage.k8s.io VolumeSnapshotContent
+func ConvertVolumeSnapshotContent(item *unstructured.Unstructured) (VolumeSnapshotContent, error) {
+	var volumeSnapshotContent VolumeSnapshotContent
+	if err := convertUnstructured(item, &volumeSnapshotContent); err != nil {
+		return VolumeSnapshotContent{}, err
+	}
+	volumeSnapshotContent.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return volumeSnapshotContent, nil
+}
@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ConvertUnstructuredObjectMeta creates a helper ObjectMeta from the metadata of an unstructured object
+func ConvertUnstructuredObjectMeta(item *unstructured.Unstructured) ObjectMeta {
+	return ObjectMeta{
+		Name:                       item.GetName(),
+		GenerateName:               item.GetGenerateName(),
+		Namespace:                  item.GetNamespace(),
+		SelfLink:                   item.GetSelfLink(),
+		UID:                        item.GetUID(),
+		ResourceVersion:            item.GetResourceVersion(),
+		Generation:                 item.GetGeneration(),
+		CreationTimestamp:          ConvertTime(item.GetCreationTimestamp()),
+		DeletionTimestamp:          ConvertTimePtr(item.GetDeletionTimestamp()),
+		DeletionGracePeriodSeconds: item.GetDeletionGracePeriodSeconds(),
+		Labels:                     item.GetLabels(),
+		Annotations:                item.GetAnnotations(),
+		OwnerReferences:            ConvertOwnerReferences(item.GetOwnerReferences()),
+		Finalizers:                 item.GetFinalizers(),
+	}
+}
+
+// convertUnstructured decodes the content of an object listed with the dynamic client, e.g. a custom resource, into a helper struct.
+// The helpers follow the schema of the API version the objects are listed in and only declare the fields that are kept.
+// The helpers have no json tags, encoding/json matches the camelCase fields of the object to them case-insensitively.
+func convertUnstructured(item *unstructured.Unstructured, out any) error {
+	data, err := json.Marshal(item.Object)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
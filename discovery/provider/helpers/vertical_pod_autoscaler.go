@@ -0,0 +1,127 @@
+package helpers
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// --- VerticalPodAutoscaler ---
+type VerticalPodAutoscaler struct {
+	TypeMeta
+	ObjectMeta
+	Spec   VerticalPodAutoscalerSpec
+	Status VerticalPodAutoscalerStatus
+}
+
+type VerticalPodAutoscalerSpec struct {
+	// TargetRef references the workload (e.g. a Deployment) whose pods are autoscaled
+	TargetRef      *CrossVersionObjectReference
+	UpdatePolicy   *PodUpdatePolicy
+	ResourcePolicy *PodResourcePolicy
+	Recommenders   []VerticalPodAutoscalerRecommenderSelector
+}
+
+type PodUpdatePolicy struct {
+	// UpdateMode is Off, Initial, Recreate or Auto, Auto when unset
+	UpdateMode  *string
+	MinReplicas *int32
+}
+
+type PodResourcePolicy struct {
+	ContainerPolicies []ContainerResourcePolicy
+}
+
+type ContainerResourcePolicy struct {
+	ContainerName       string
+	Mode                *string
+	MinAllowed          map[string]string
+	MaxAllowed          map[string]string
+	ControlledResources []string
+	ControlledValues    *string
+}
+
+type VerticalPodAutoscalerRecommenderSelector struct {
+	Name string
+}
+
+type VerticalPodAutoscalerStatus struct {
+	Recommendation *RecommendedPodResources
+	Conditions     []metav1.Condition
+}
+
+type RecommendedPodResources struct {
+	ContainerRecommendations []RecommendedContainerResources
+}
+
+// RecommendedContainerResources holds the recommendations of a container as reported by the recommender,
+// e.g. {"cpu": "25m", "memory": "262144k"}
+type RecommendedContainerResources struct {
+	ContainerName  string
+	Target         map[string]string
+	LowerBound     map[string]string
+	UpperBound     map[string]string
+	UncappedTarget map[string]string
+}
+
+// ConvertVerticalPodAutoscaler creates a helper VerticalPodAutoscaler from an unstructured autoscaling.k8s.io VerticalPodAutoscaler
+func ConvertVerticalPodAutoscaler(item *unstructured.Unstructured) (VerticalPodAutoscaler, error) {
+	var verticalPodAutoscaler VerticalPodAutoscaler
+	if err := convertUnstructured(item, &verticalPodAutoscaler); err != nil {
+		return VerticalPodAutoscaler{}, err
+	}
+	verticalPodAutoscaler.ObjectMeta = ConvertUnstructuredObjectMeta(item)
+	return verticalPodAutoscaler, nil
+}
+
+// ContainerRecommendation is the recommendation of a container normalized to CPU millicores and memory bytes
+type ContainerRecommendation struct {
+	ContainerName               string
+	TargetCPUMillicores         int64
+	TargetMemoryBytes           int64
+	LowerBoundCPUMillicores     int64
+	LowerBoundMemoryBytes       int64
+	UpperBoundCPUMillicores     int64
+	UpperBoundMemoryBytes       int64
+	UncappedTargetCPUMillicores int64
+	UncappedTargetMemoryBytes   int64
+}
+
+// ContainerRecommendations returns the normalized recommendations of every container, nil until the recommender has run
+func (vpa VerticalPodAutoscaler) ContainerRecommendations() ([]ContainerRecommendation, error) {
+	if vpa.Status.Recommendation == nil {
+		return nil, nil
+	}
+	recommendations := make([]ContainerRecommendation, len(vpa.Status.Recommendation.ContainerRecommendations))
+	for i, container := range vpa.Status.Recommendation.ContainerRecommendations {
+		target, err := ConvertResourceUsage(container.Target)
+		if err != nil {
+			return nil, fmt.Errorf("container %s target: %w", container.ContainerName, err)
+		}
+		lowerBound, err := ConvertResourceUsage(container.LowerBound)
+		if err != nil {
+			return nil, fmt.Errorf("container %s lower bound: %w", container.ContainerName, err)
+		}
+		upperBound, err := ConvertResourceUsage(container.UpperBound)
+		if err != nil {
+			return nil, fmt.Errorf("container %s upper bound: %w", container.ContainerName, err)
+		}
+		uncappedTarget, err := ConvertResourceUsage(container.UncappedTarget)
+		if err != nil {
+			return nil, fmt.Errorf("container %s uncapped target: %w", container.ContainerName, err)
+		}
+		recommendations[i] = ContainerRecommendation{
+			ContainerName:               container.ContainerName,
+			TargetCPUMillicores:         target.CPUMillicores,
+			TargetMemoryBytes:           target.MemoryBytes,
+			LowerBoundCPUMillicores:     lowerBound.CPUMillicores,
+			LowerBoundMemoryBytes:       lowerBound.MemoryBytes,
+			UpperBoundCPUMillicores:     upperBound.CPUMillicores,
+			UpperBoundMemoryBytes:       upperBound.MemoryBytes,
+			UncappedTargetCPUMillicores: uncappedTarget.CPUMillicores,
+			UncappedTargetMemoryBytes:   uncappedTarget.MemoryBytes,
+		}
+	}
+	return recommendations, nil
+}
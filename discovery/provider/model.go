@@ -3,8 +3,11 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/opengovern/og-describer-kubernetes/discovery/provider/helpers"
 	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type Metadata struct {
@@ -19,8 +22,66 @@ type KubernetesResourceDescription struct {
 	ResourceVersion   string
 	ResourceTable     string
 	ApiVersion        string
+	Group             string
+	Version           string
+	Resource          string
+	Namespaced        bool
 	Metadata          map[string]interface{}
+	Spec              interface{}
 	Status            interface{}
+	// Truncated is set if the spec and status were dropped because they exceed the capture size limit
+	Truncated bool
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesAPIServiceDescription struct {
+	MetaObject helpers.ObjectMeta
+	APIService helpers.APIService
+	// Available is true if the Available condition of the APIService is True, i.e. the API can be discovered and served
+	Available          bool
+	AvailableCondition *helpers.APIServiceCondition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesArgoApplicationDescription struct {
+	MetaObject      helpers.ObjectMeta
+	ArgoApplication helpers.ArgoApplication
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesArgoAppProjectDescription struct {
+	MetaObject     helpers.ObjectMeta
+	ArgoAppProject helpers.ArgoAppProject
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesCertificateDescription struct {
+	MetaObject  helpers.ObjectMeta
+	Certificate helpers.Certificate
+	// Ready is true if the Ready condition of the certificate is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesCertificateRequestDescription struct {
+	MetaObject         helpers.ObjectMeta
+	CertificateRequest helpers.CertificateRequest
+	// Ready is true if the Ready condition of the certificate request is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesCertificateSigningRequestDescription struct {
+	MetaObject                helpers.ObjectMeta
+	CertificateSigningRequest helpers.CertificateSigningRequest
+	// ApprovalStatus is Approved, Denied, Failed or Pending
+	ApprovalStatus string
 }
 
 type KubernetesClusterDescription struct {
@@ -31,172 +92,504 @@ type KubernetesClusterDescription struct {
 	ServerVersion         string
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesClusterIssuerDescription struct {
+	MetaObject    helpers.ObjectMeta
+	ClusterIssuer helpers.ClusterIssuer
+	IssuerType    string
+	// Ready is true if the Ready condition of the cluster issuer is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
 type KubernetesClusterRoleDescription struct {
 	MetaObject  helpers.ObjectMeta
 	ClusterRole helpers.ClusterRole
 }
 
+//getfilter:name=Description.MetaObject.Name
 type KubernetesClusterRoleBindingDescription struct {
 	MetaObject         helpers.ObjectMeta
 	ClusterRoleBinding helpers.ClusterRoleBinding
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesConfigMapDescription struct {
 	MetaObject helpers.ObjectMeta
 	ConfigMap  helpers.ConfigMap
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesCronJobDescription struct {
 	MetaObject helpers.ObjectMeta
 	CronJob    helpers.CronJob
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesCSIDriverDescription struct {
+	MetaObject helpers.ObjectMeta
+	CSIDriver  helpers.CSIDriver
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesCSINodeDescription struct {
+	MetaObject helpers.ObjectMeta
+	CSINode    helpers.CSINode
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesCustomResourceDescription struct {
 	MetaObject         helpers.ObjectMeta
 	FullyQualifiedName string
 	CustomResource     any
+	Spec               map[string]any
+	Status             map[string]any
 }
 
+//getfilter:name=Description.MetaObject.Name
 type KubernetesCustomResourceDefinitionDescription struct {
 	MetaObject               helpers.ObjectMeta
 	CustomResourceDefinition helpers.CustomResourceDefinition
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesDaemonSetDescription struct {
 	MetaObject          helpers.ObjectMeta
 	DaemonSet           helpers.DaemonSet
 	LabelSelectorString string
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesDeploymentDescription struct {
 	MetaObject          helpers.ObjectMeta
 	Deployment          helpers.Deployment
 	LabelSelectorString string
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesEndpointSliceDescription struct {
 	MetaObject    helpers.ObjectMeta
 	EndpointSlice helpers.EndpointSlice
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesEndpointDescription struct {
 	MetaObject helpers.ObjectMeta
 	Endpoint   helpers.Endpoints
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesEventDescription struct {
 	MetaObject helpers.ObjectMeta
 	Event      helpers.Event
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesFlowSchemaDescription struct {
+	MetaObject helpers.ObjectMeta
+	FlowSchema helpers.FlowSchema
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesFluxGitRepositoryDescription struct {
+	MetaObject        helpers.ObjectMeta
+	FluxGitRepository helpers.FluxGitRepository
+	// Ready is true if the Ready condition of the Git repository is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesFluxHelmReleaseDescription struct {
+	MetaObject      helpers.ObjectMeta
+	FluxHelmRelease helpers.FluxHelmRelease
+	// Ready is true if the Ready condition of the Helm release is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesFluxHelmRepositoryDescription struct {
+	MetaObject         helpers.ObjectMeta
+	FluxHelmRepository helpers.FluxHelmRepository
+	// Ready is true if the Ready condition of the Helm repository is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesFluxKustomizationDescription struct {
+	MetaObject        helpers.ObjectMeta
+	FluxKustomization helpers.FluxKustomization
+	// Ready is true if the Ready condition of the kustomization is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesFluxOCIRepositoryDescription struct {
+	MetaObject        helpers.ObjectMeta
+	FluxOCIRepository helpers.FluxOCIRepository
+	// Ready is true if the Ready condition of the OCI repository is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesGatewayDescription struct {
+	MetaObject helpers.ObjectMeta
+	Gateway    helpers.Gateway
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesGatewayClassDescription struct {
+	MetaObject   helpers.ObjectMeta
+	GatewayClass helpers.GatewayClass
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesGRPCRouteDescription struct {
+	MetaObject helpers.ObjectMeta
+	GRPCRoute  helpers.GRPCRoute
+	// BackendRefs are the backends of every rule of the route
+	BackendRefs []helpers.BackendRef
+}
+
+type KubernetesHelmReleaseDescription struct {
+	HelmRelease helpers.HelmRelease
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesHTTPRouteDescription struct {
+	MetaObject helpers.ObjectMeta
+	HTTPRoute  helpers.HTTPRoute
+	// BackendRefs are the backends of every rule of the route
+	BackendRefs []helpers.BackendRef
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesHorizontalPodAutoscalerDescription struct {
 	MetaObject              helpers.ObjectMeta
 	HorizontalPodAutoscaler helpers.HorizontalPodAutoscaler
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesIngressDescription struct {
 	MetaObject helpers.ObjectMeta
 	Ingress    helpers.Ingress
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesIngressClassDescription struct {
+	MetaObject   helpers.ObjectMeta
+	IngressClass helpers.IngressClass
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesIssuerDescription struct {
+	MetaObject helpers.ObjectMeta
+	Issuer     helpers.Issuer
+	IssuerType string
+	// Ready is true if the Ready condition of the issuer is True
+	Ready          bool
+	ReadyCondition *metav1.Condition
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesJobDescription struct {
 	MetaObject          helpers.ObjectMeta
 	Job                 batchv1.Job
 	LabelSelectorString string
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesLeaseDescription struct {
+	MetaObject helpers.ObjectMeta
+	Lease      helpers.Lease
+	ExpiresAt  *time.Time
+	// Stale is true if the lease had no holder or wasn't renewed within its duration when it was described
+	Stale bool
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesLimitRangeDescription struct {
 	MetaObject helpers.ObjectMeta
 	LimitRange helpers.LimitRange
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesMutatingWebhookConfigurationDescription struct {
+	MetaObject                   helpers.ObjectMeta
+	MutatingWebhookConfiguration helpers.MutatingWebhookConfiguration
+}
+
+//getfilter:name=Description.MetaObject.Name
 type KubernetesNamespaceDescription struct {
 	MetaObject helpers.ObjectMeta
 	Namespace  helpers.Namespace
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesNetworkPolicyDescription struct {
 	MetaObject    helpers.ObjectMeta
 	NetworkPolicy helpers.NetworkPolicy
 }
 
+//getfilter:name=Description.MetaObject.Name
 type KubernetesNodeDescription struct {
 	MetaObject helpers.ObjectMeta
 	Node       helpers.Node
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesNodeMetricDescription struct {
+	MetaObject helpers.ObjectMeta
+	NodeMetric helpers.NodeMetric
+}
+
+//getfilter:name=Description.MetaObject.Name
 type KubernetesPersistentVolumeDescription struct {
 	MetaObject helpers.ObjectMeta
 	PV         helpers.PersistentVolume
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesPersistentVolumeClaimDescription struct {
 	MetaObject helpers.ObjectMeta
 	PVC        helpers.PersistentVolumeClaim
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesPodDescription struct {
 	MetaObject helpers.ObjectMeta
 	Pod        helpers.Pod
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesPodDisruptionBudgetDescription struct {
 	MetaObject          helpers.ObjectMeta
 	PodDisruptionBudget helpers.PodDisruptionBudget
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesPodMetricDescription struct {
+	MetaObject helpers.ObjectMeta
+	PodMetric  helpers.PodMetric
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesPodMonitorDescription struct {
+	MetaObject          helpers.ObjectMeta
+	PodMonitor          helpers.PodMonitor
+	LabelSelectorString string
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesPodTemplateDescription struct {
 	MetaObject  helpers.ObjectMeta
 	PodTemplate helpers.PodTemplate
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesPriorityClassDescription struct {
+	MetaObject    helpers.ObjectMeta
+	PriorityClass helpers.PriorityClass
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesPriorityLevelConfigurationDescription struct {
+	MetaObject                 helpers.ObjectMeta
+	PriorityLevelConfiguration helpers.PriorityLevelConfiguration
+	// NominalConcurrencyShares are the shares of the Limited or Exempt priority level
+	NominalConcurrencyShares *int32
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesPrometheusDescription struct {
+	MetaObject helpers.ObjectMeta
+	Prometheus helpers.Prometheus
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesPrometheusRuleDescription struct {
+	MetaObject     helpers.ObjectMeta
+	PrometheusRule helpers.PrometheusRule
+	// Alerts are the names of the alerting rules of every group
+	Alerts []string
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesReferenceGrantDescription struct {
+	MetaObject     helpers.ObjectMeta
+	ReferenceGrant helpers.ReferenceGrant
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesReplicaSetDescription struct {
 	MetaObject          helpers.ObjectMeta
 	ReplicaSet          helpers.ReplicaSet
 	LabelSelectorString string
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesReplicationControllerDescription struct {
 	MetaObject            helpers.ObjectMeta
 	ReplicationController helpers.ReplicationController
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesResourceQuotaDescription struct {
 	MetaObject    helpers.ObjectMeta
 	ResourceQuota helpers.ResourceQuota
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesRoleDescription struct {
 	MetaObject helpers.ObjectMeta
 	Role       helpers.Role
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesRoleBindingDescription struct {
 	MetaObject  helpers.ObjectMeta
 	RoleBinding helpers.RoleBinding
 }
 
+//getfilter:name=Description.MetaObject.Name
+type KubernetesRuntimeClassDescription struct {
+	MetaObject   helpers.ObjectMeta
+	RuntimeClass helpers.RuntimeClass
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesSecretDescription struct {
 	MetaObject helpers.ObjectMeta
 	Secret     helpers.Secret
 	DataNumber int
 }
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesServiceDescription struct {
 	MetaObject helpers.ObjectMeta
 	Service    helpers.Service
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesServiceAccountDescription struct {
 	MetaObject     helpers.ObjectMeta
 	ServiceAccount helpers.ServiceAccount
 }
 
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesServiceMonitorDescription struct {
+	MetaObject          helpers.ObjectMeta
+	ServiceMonitor      helpers.ServiceMonitor
+	LabelSelectorString string
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
 type KubernetesStatefulSetDescription struct {
 	MetaObject  helpers.ObjectMeta
 	StatefulSet helpers.StatefulSet
 }
 
+//getfilter:name=Description.MetaObject.Name
 type KubernetesStorageClassDescription struct {
 	MetaObject   helpers.ObjectMeta
 	StorageClass helpers.StorageClass
 }
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesValidatingAdmissionPolicyDescription struct {
+	MetaObject                helpers.ObjectMeta
+	ValidatingAdmissionPolicy helpers.ValidatingAdmissionPolicy
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesValidatingAdmissionPolicyBindingDescription struct {
+	MetaObject                       helpers.ObjectMeta
+	ValidatingAdmissionPolicyBinding helpers.ValidatingAdmissionPolicyBinding
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesValidatingWebhookConfigurationDescription struct {
+	MetaObject                     helpers.ObjectMeta
+	ValidatingWebhookConfiguration helpers.ValidatingWebhookConfiguration
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesVerticalPodAutoscalerDescription struct {
+	MetaObject            helpers.ObjectMeta
+	VerticalPodAutoscaler helpers.VerticalPodAutoscaler
+	// ContainerRecommendations are the recommendations of every container in CPU millicores and memory bytes
+	ContainerRecommendations []helpers.ContainerRecommendation
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesVolumeAttachmentDescription struct {
+	MetaObject       helpers.ObjectMeta
+	VolumeAttachment helpers.VolumeAttachment
+}
+
+//getfilter:name=Description.MetaObject.Name
+//getfilter:namespace=Description.MetaObject.Namespace
+type KubernetesVolumeSnapshotDescription struct {
+	MetaObject     helpers.ObjectMeta
+	VolumeSnapshot helpers.VolumeSnapshot
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesVolumeSnapshotClassDescription struct {
+	MetaObject          helpers.ObjectMeta
+	VolumeSnapshotClass helpers.VolumeSnapshotClass
+}
+
+//getfilter:name=Description.MetaObject.Name
+type KubernetesVolumeSnapshotContentDescription struct {
+	MetaObject            helpers.ObjectMeta
+	VolumeSnapshotContent helpers.VolumeSnapshotContent
+}
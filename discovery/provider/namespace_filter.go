@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	NamespacesIncludeParameter = "namespaces_include"
+	NamespacesExcludeParameter = "namespaces_exclude"
+)
+
+// NamespaceFilter scopes the describers to a set of namespaces.
+// Patterns are comma separated globs (e.g. "team-*,kube-system"), an empty include list allows every namespace
+// and excludes always win over includes.
+// Scope holds the namespaces a credential is restricted to, if set the namespaced resources are listed
+// namespace by namespace instead of cluster-wide and only these namespaces are allowed.
+// Filters with patterns are resolved to the namespaces they allow before describing (see Resolve),
+// so the resources of excluded namespaces are never fetched from the API server.
+type NamespaceFilter struct {
+	Include []string
+	Exclude []string
+	Scope   []string
+
+	// resolved holds the namespaces allowed by Include and Exclude once the filter is resolved, nil otherwise
+	resolved []string
+}
+
+// NewNamespaceFilter builds a NamespaceFilter from the namespaces_include and namespaces_exclude parameters
+func NewNamespaceFilter(parameters map[string]string) (NamespaceFilter, error) {
+	include, err := parseNamespacePatterns(parameters[NamespacesIncludeParameter])
+	if err != nil {
+		return NamespaceFilter{}, fmt.Errorf("invalid %s: %w", NamespacesIncludeParameter, err)
+	}
+	exclude, err := parseNamespacePatterns(parameters[NamespacesExcludeParameter])
+	if err != nil {
+		return NamespaceFilter{}, fmt.Errorf("invalid %s: %w", NamespacesExcludeParameter, err)
+	}
+	return NamespaceFilter{
+		Include: include,
+		Exclude: exclude,
+	}, nil
+}
+
+// IsScoped returns true if the credential is restricted to a set of namespaces, cluster-scoped resources aren't described then
+func (f NamespaceFilter) IsScoped() bool {
+	return len(f.Scope) > 0
+}
+
+// IsEmpty returns true if the filter allows every namespace
+func (f NamespaceFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0 && len(f.Scope) == 0
+}
+
+// Resolve sets the namespaces allowed by the include and exclude patterns, ListNamespaces returns them afterwards.
+// An include list of literal names is used as it is, otherwise the namespaces returned by listNamespaces are matched
+// against the patterns. Filters with a scope or without patterns are left as they are.
+func (f *NamespaceFilter) Resolve(ctx context.Context, listNamespaces func(context.Context) ([]string, error)) error {
+	if len(f.Scope) > 0 || (len(f.Include) == 0 && len(f.Exclude) == 0) {
+		return nil
+	}
+	candidates := f.Include
+	if len(f.Include) == 0 || slices.ContainsFunc(f.Include, isNamespacePattern) {
+		namespaces, err := listNamespaces(ctx)
+		if err != nil {
+			return fmt.Errorf("error listing namespaces: %w", err)
+		}
+		candidates = namespaces
+	}
+	resolved := make([]string, 0, len(candidates))
+	for _, namespace := range candidates {
+		if f.Allows(namespace) && !slices.Contains(resolved, namespace) {
+			resolved = append(resolved, namespace)
+		}
+	}
+	f.resolved = resolved
+	return nil
+}
+
+// ListNamespaces returns the namespaces namespaced resources have to be listed in, a single empty namespace
+// (all namespaces) is returned if the filter has neither a scope nor resolved patterns
+func (f NamespaceFilter) ListNamespaces() []string {
+	if len(f.Scope) == 0 {
+		if f.resolved != nil {
+			return f.resolved
+		}
+		return []string{""}
+	}
+	var namespaces []string
+	for _, namespace := range f.Scope {
+		if f.Allows(namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// Allows returns true if resources of the namespace can be described, cluster-scoped resources (empty namespace) are always allowed
+func (f NamespaceFilter) Allows(namespace string) bool {
+	if namespace == "" {
+		return true
+	}
+	if len(f.Scope) > 0 && !slices.Contains(f.Scope, namespace) {
+		return false
+	}
+	for _, pattern := range f.Exclude {
+		if matchNamespace(pattern, namespace) {
+			return false
+		}
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, pattern := range f.Include {
+		if matchNamespace(pattern, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNamespacePattern returns true if the pattern has glob metacharacters, i.e. it isn't a literal namespace name
+func isNamespacePattern(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[\`)
+}
+
+func matchNamespace(pattern, namespace string) bool {
+	// patterns are validated in parseNamespacePatterns, so the error can be ignored
+	matched, _ := path.Match(pattern, namespace)
+	return matched
+}
+
+func parseNamespacePatterns(value string) ([]string, error) {
+	var patterns []string
+	for _, pattern := range strings.Split(value, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", pattern, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNamespaceFilterAllows(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    NamespaceFilter
+		namespace string
+		want      bool
+	}{
+		{name: "empty filter allows every namespace", filter: NamespaceFilter{}, namespace: "default", want: true},
+		{name: "cluster-scoped resources are always allowed", filter: NamespaceFilter{Include: []string{"team-*"}, Scope: []string{"team-a"}}, namespace: "", want: true},
+		{name: "literal include", filter: NamespaceFilter{Include: []string{"default"}}, namespace: "default", want: true},
+		{name: "not included", filter: NamespaceFilter{Include: []string{"default"}}, namespace: "kube-system", want: false},
+		{name: "glob include", filter: NamespaceFilter{Include: []string{"team-*"}}, namespace: "team-a", want: true},
+		{name: "glob include does not match a prefix only", filter: NamespaceFilter{Include: []string{"team-*"}}, namespace: "teams", want: false},
+		{name: "exclude", filter: NamespaceFilter{Exclude: []string{"kube-*"}}, namespace: "kube-system", want: false},
+		{name: "not excluded", filter: NamespaceFilter{Exclude: []string{"kube-*"}}, namespace: "default", want: true},
+		{name: "exclude wins over a literal include", filter: NamespaceFilter{Include: []string{"kube-system"}, Exclude: []string{"kube-*"}}, namespace: "kube-system", want: false},
+		{name: "exclude wins over a glob include", filter: NamespaceFilter{Include: []string{"team-*"}, Exclude: []string{"team-secret"}}, namespace: "team-secret", want: false},
+		{name: "included and not excluded", filter: NamespaceFilter{Include: []string{"team-*"}, Exclude: []string{"team-secret"}}, namespace: "team-a", want: true},
+		{name: "scope restricts the namespaces", filter: NamespaceFilter{Scope: []string{"team-a"}}, namespace: "team-b", want: false},
+		{name: "scope allows its namespaces", filter: NamespaceFilter{Scope: []string{"team-a"}}, namespace: "team-a", want: true},
+		{name: "scope does not override excludes", filter: NamespaceFilter{Exclude: []string{"team-a"}, Scope: []string{"team-a"}}, namespace: "team-a", want: false},
+		{name: "patterns do not widen the scope", filter: NamespaceFilter{Include: []string{"*"}, Scope: []string{"team-a"}}, namespace: "team-b", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Allows(tt.namespace); got != tt.want {
+				t.Errorf("Allows(%q) = %v, want %v", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewNamespaceFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters map[string]string
+		want       NamespaceFilter
+		wantErr    bool
+	}{
+		{name: "no parameters", parameters: nil, want: NamespaceFilter{}},
+		{
+			name:       "comma separated patterns are trimmed",
+			parameters: map[string]string{NamespacesIncludeParameter: " team-*, default ,", NamespacesExcludeParameter: "kube-system"},
+			want:       NamespaceFilter{Include: []string{"team-*", "default"}, Exclude: []string{"kube-system"}},
+		},
+		{name: "invalid include pattern", parameters: map[string]string{NamespacesIncludeParameter: "team-["}, wantErr: true},
+		{name: "invalid exclude pattern", parameters: map[string]string{NamespacesExcludeParameter: "[a-"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNamespaceFilter(tt.parameters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewNamespaceFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNamespaceFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNamespaceFilterResolve(t *testing.T) {
+	clusterNamespaces := []string{"default", "kube-system", "team-a", "team-b", "team-secret"}
+
+	tests := []struct {
+		name       string
+		filter     NamespaceFilter
+		listErr    error
+		want       []string
+		wantListed bool
+		wantErr    bool
+	}{
+		{name: "empty filter lists cluster-wide", filter: NamespaceFilter{}, want: []string{""}},
+		{name: "literal includes are not looked up", filter: NamespaceFilter{Include: []string{"team-a", "default"}}, want: []string{"team-a", "default"}},
+		{name: "excludes apply to literal includes", filter: NamespaceFilter{Include: []string{"team-a", "kube-system"}, Exclude: []string{"kube-*"}}, want: []string{"team-a"}},
+		{name: "glob includes are matched against the namespaces", filter: NamespaceFilter{Include: []string{"team-*"}, Exclude: []string{"team-secret"}}, want: []string{"team-a", "team-b"}, wantListed: true},
+		{name: "excludes only are matched against the namespaces", filter: NamespaceFilter{Exclude: []string{"kube-*", "team-*"}}, want: []string{"default"}, wantListed: true},
+		{name: "no namespace matches", filter: NamespaceFilter{Include: []string{"prod-*"}}, want: []string{}, wantListed: true},
+		{name: "scoped filters are not resolved", filter: NamespaceFilter{Include: []string{"team-*"}, Scope: []string{"team-a", "default"}}, want: []string{"team-a"}},
+		{name: "list error", filter: NamespaceFilter{Include: []string{"team-*"}}, listErr: errors.New("forbidden"), wantListed: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed := false
+			filter := tt.filter
+			err := filter.Resolve(context.Background(), func(context.Context) ([]string, error) {
+				listed = true
+				return clusterNamespaces, tt.listErr
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if listed != tt.wantListed {
+				t.Errorf("namespaces listed = %v, want %v", listed, tt.wantListed)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := filter.ListNamespaces(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListNamespaces() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
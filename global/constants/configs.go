@@ -1,13 +1,94 @@
 package constants
 
-import "github.com/opengovern/og-util/pkg/integration"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/opengovern/og-util/pkg/integration"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
 
 const (
 	IntegrationTypeLower = "kubernetes"                                    // example: aws, azure
 	IntegrationName      = integration.Type("kubernetes")                  // example: aws_account, github_account
 	OGPluginRepoURL      = "github.com/opengovern/og-describer-kubernetes" // example: github.com/opengovern/og-describer-aws
+
+	// KubeContextLabel is the integration label holding the kubeconfig context an integration was discovered from
+	KubeContextLabel = "context_name"
+)
+
+const (
+	CredentialTypeKubeconfig = "kubeconfig"
+	CredentialTypeInCluster  = "in_cluster"
+	CredentialTypeToken      = "token"
 )
 
 type IntegrationCredentials struct {
+	// CredentialType is one of CredentialTypeKubeconfig, CredentialTypeInCluster or CredentialTypeToken
+	CredentialType string `json:"credential_type,omitempty"`
+
 	KubeConfig string `json:"kubeconfig"`
+	// Context pins the kubeconfig context to use, the current-context is used if empty
+	Context string `json:"context,omitempty"`
+
+	// Server, Token, CertificateAuthorityData and InsecureSkipTLSVerify make up a bearer token credential,
+	// CertificateAuthorityData is the PEM bundle of the cluster CA, optionally base64 encoded as in a kubeconfig
+	Server                   string `json:"server,omitempty"`
+	Token                    string `json:"token,omitempty"`
+	CertificateAuthorityData string `json:"certificate_authority_data,omitempty"`
+	InsecureSkipTLSVerify    bool   `json:"insecure_skip_tls_verify,omitempty"`
+
+	// Namespaces optionally restricts the credential to a comma separated list of namespaces,
+	// e.g. for in-cluster service accounts that are only bound to roles in those namespaces
+	Namespaces string `json:"namespaces,omitempty"`
+}
+
+// GetCredentialType returns the credential type, it is inferred from the fields of credentials without an explicit one:
+// a credential holding a kubeconfig is a kubeconfig credential and one holding a server or a token is a bearer token credential.
+// The in-cluster service account is never inferred, an empty string is returned for empty credentials.
+func (c IntegrationCredentials) GetCredentialType() string {
+	if c.CredentialType != "" {
+		return c.CredentialType
+	}
+	if c.KubeConfig != "" {
+		return CredentialTypeKubeconfig
+	}
+	if c.Server != "" || c.Token != "" {
+		return CredentialTypeToken
+	}
+	return ""
+}
+
+// GetNamespaces returns the deduplicated namespaces the credential is restricted to, every namespace must be a valid DNS-1123 label
+func (c IntegrationCredentials) GetNamespaces() ([]string, error) {
+	var namespaces []string
+	for _, namespace := range strings.Split(c.Namespaces, ",") {
+		namespace = strings.TrimSpace(namespace)
+		if namespace == "" || slices.Contains(namespaces, namespace) {
+			continue
+		}
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces, nil
+}
+
+// Validate returns an error if the credential type is unknown or can't be inferred,
+// so a blank credential never falls back to the service account the describer runs as,
+// or if the namespaces the credential is restricted to are invalid.
+// The platform and the describers both validate credentials with it.
+func (c IntegrationCredentials) Validate() error {
+	switch c.GetCredentialType() {
+	case CredentialTypeKubeconfig, CredentialTypeInCluster, CredentialTypeToken:
+	case "":
+		return errors.New("empty credentials: provide a kubeconfig, a server and token, or set credential_type to in_cluster")
+	default:
+		return fmt.Errorf("unsupported credential type %s", c.CredentialType)
+	}
+	_, err := c.GetNamespaces()
+	return err
 }
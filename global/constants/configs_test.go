@@ -0,0 +1,58 @@
+package constants
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntegrationCredentialsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials IntegrationCredentials
+		wantType    string
+		wantErr     bool
+	}{
+		{name: "empty credentials", credentials: IntegrationCredentials{}, wantType: "", wantErr: true},
+		{name: "namespaces only", credentials: IntegrationCredentials{Namespaces: "team-a"}, wantType: "", wantErr: true},
+		{name: "explicit in-cluster", credentials: IntegrationCredentials{CredentialType: CredentialTypeInCluster}, wantType: CredentialTypeInCluster},
+		{name: "inferred kubeconfig", credentials: IntegrationCredentials{KubeConfig: "apiVersion: v1"}, wantType: CredentialTypeKubeconfig},
+		{name: "inferred token", credentials: IntegrationCredentials{Server: "https://example.com", Token: "t"}, wantType: CredentialTypeToken},
+		{name: "unsupported type", credentials: IntegrationCredentials{CredentialType: "oidc"}, wantType: "oidc", wantErr: true},
+		{name: "invalid namespace", credentials: IntegrationCredentials{CredentialType: CredentialTypeInCluster, Namespaces: "team-a,Team_B"}, wantType: CredentialTypeInCluster, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.credentials.GetCredentialType(); got != tt.wantType {
+				t.Errorf("GetCredentialType() = %q, want %q", got, tt.wantType)
+			}
+			if err := tt.credentials.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntegrationCredentialsGetNamespaces(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: nil},
+		{name: "names are trimmed and deduplicated", value: "team-a, team-b,team-a,", want: []string{"team-a", "team-b"}},
+		{name: "globs are rejected", value: "team-*", wantErr: true},
+		{name: "uppercase names are rejected", value: "Team-A", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IntegrationCredentials{Namespaces: tt.value}.GetNamespaces()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetNamespaces() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNamespaces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package kubeauth
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// InClusterContextName is the context name of the kubeconfig built for in-cluster credentials
+const InClusterContextName = "in-cluster"
+
+// InClusterKubeconfig builds a single context kubeconfig from the service account the process runs as, it is used by
+// both the platform and the describers. It references the mounted token and CA files rather than embedding them,
+// so the rotated token is always used.
+func InClusterKubeconfig() (*clientcmdapi.Config, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig := clientcmdapi.NewConfig()
+	kubeConfig.Clusters[InClusterContextName] = &clientcmdapi.Cluster{
+		Server:               config.Host,
+		CertificateAuthority: config.TLSClientConfig.CAFile,
+	}
+	kubeConfig.AuthInfos[InClusterContextName] = &clientcmdapi.AuthInfo{
+		TokenFile: config.BearerTokenFile,
+	}
+	kubeConfig.Contexts[InClusterContextName] = &clientcmdapi.Context{
+		Cluster:  InClusterContextName,
+		AuthInfo: InClusterContextName,
+	}
+	kubeConfig.CurrentContext = InClusterContextName
+	return kubeConfig, nil
+}
+
+// VerifyContextCluster checks that a context taken from the integration labels points to the cluster the integration
+// was discovered for (its provider ID is the API server URL of that cluster), so editing the label can't redirect
+// the integration to another cluster of the kubeconfig. Trailing slashes of the URLs are ignored.
+func VerifyContextCluster(kubeConfig *clientcmdapi.Config, contextName string, providerID string) error {
+	kubeContext, ok := kubeConfig.Contexts[contextName]
+	if !ok {
+		return fmt.Errorf("context %s not found in kubeconfig", contextName)
+	}
+	cluster, ok := kubeConfig.Clusters[kubeContext.Cluster]
+	if !ok {
+		return fmt.Errorf("cluster %s of context %s not found in kubeconfig", kubeContext.Cluster, contextName)
+	}
+	if strings.TrimSuffix(cluster.Server, "/") != strings.TrimSuffix(providerID, "/") {
+		return fmt.Errorf("context %s points to %s, the integration was discovered for %s", contextName, cluster.Server, providerID)
+	}
+	return nil
+}
+
+// IntegrationContext returns the context of a shared kubeconfig an integration is described with. The provider ID of the
+// integration, the API server URL of the cluster it was discovered for, decides the cluster rather than its labels:
+// the context label is only used if it points to that cluster, without one the first context pointing to it is used,
+// the current-context first and then by name as discovery picks them. Without a provider ID, e.g. for local runs,
+// the label is used as is and an empty name stands for the current-context.
+func IntegrationContext(kubeConfig *clientcmdapi.Config, labelContext string, providerID string) (string, error) {
+	if providerID == "" {
+		return labelContext, nil
+	}
+	if labelContext != "" {
+		if err := VerifyContextCluster(kubeConfig, labelContext, providerID); err != nil {
+			return "", err
+		}
+		return labelContext, nil
+	}
+
+	contextNames := make([]string, 0, len(kubeConfig.Contexts))
+	for contextName := range kubeConfig.Contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Slice(contextNames, func(i, j int) bool {
+		if (contextNames[i] == kubeConfig.CurrentContext) != (contextNames[j] == kubeConfig.CurrentContext) {
+			return contextNames[i] == kubeConfig.CurrentContext
+		}
+		return contextNames[i] < contextNames[j]
+	})
+	for _, contextName := range contextNames {
+		if VerifyContextCluster(kubeConfig, contextName, providerID) == nil {
+			return contextName, nil
+		}
+	}
+	return "", fmt.Errorf("no context of the kubeconfig points to %s", providerID)
+}
+
+// TokenRestConfig validates a bearer token credential and builds its REST config directly, without a kubeconfig.
+// It is used by both the platform and the describers.
+func TokenRestConfig(creds constants.IntegrationCredentials) (*rest.Config, error) {
+	server, err := url.Parse(strings.TrimSpace(creds.Server))
+	if err != nil {
+		return nil, fmt.Errorf("invalid server: %w", err)
+	}
+	if server.Scheme != "https" || server.Host == "" {
+		return nil, fmt.Errorf("invalid server %q, expected an https URL", creds.Server)
+	}
+
+	token := strings.TrimSpace(creds.Token)
+	if token == "" {
+		return nil, errors.New("token is required")
+	}
+
+	caData, err := parseCertificateAuthorityData(creds.CertificateAuthorityData)
+	if err != nil {
+		return nil, err
+	}
+	if len(caData) > 0 && creds.InsecureSkipTLSVerify {
+		return nil, errors.New("certificate_authority_data and insecure_skip_tls_verify cannot be set together")
+	}
+
+	return &rest.Config{
+		Host:        server.String(),
+		BearerToken: token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   caData,
+			Insecure: creds.InsecureSkipTLSVerify,
+		},
+	}, nil
+}
+
+// parseCertificateAuthorityData accepts a PEM bundle either as is or base64 encoded, an empty value means the system roots are used
+func parseCertificateAuthorityData(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	data := []byte(value)
+	if !strings.HasPrefix(value, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, errors.New("invalid certificate_authority_data, expected a PEM bundle or a base64 encoded PEM bundle")
+		}
+		data = decoded
+	}
+	if _, err := certutil.ParseCertsPEM(data); err != nil {
+		return nil, fmt.Errorf("invalid certificate_authority_data: %w", err)
+	}
+	return data, nil
+}
+
+// TokenContextName names the cluster of a bearer token credential after the host of its server
+func TokenContextName(server string) string {
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return server
+}
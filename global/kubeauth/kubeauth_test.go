@@ -0,0 +1,57 @@
+package kubeauth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func TestTokenRestConfig(t *testing.T) {
+	caPEM, _, err := certutil.GenerateSelfSignedCertKey("kubernetes", nil, nil)
+	if err != nil {
+		t.Fatalf("GenerateSelfSignedCertKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		creds      constants.IntegrationCredentials
+		wantHost   string
+		wantCAData []byte
+		wantErr    bool
+	}{
+		{name: "system roots", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com:6443", Token: " t "}, wantHost: "https://k8s.example.com:6443"},
+		{name: "PEM CA", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com", Token: "t", CertificateAuthorityData: string(caPEM)}, wantHost: "https://k8s.example.com", wantCAData: caPEM},
+		{name: "base64 CA", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com", Token: "t", CertificateAuthorityData: base64.StdEncoding.EncodeToString(caPEM)}, wantHost: "https://k8s.example.com", wantCAData: caPEM},
+		{name: "insecure", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com", Token: "t", InsecureSkipTLSVerify: true}, wantHost: "https://k8s.example.com"},
+		{name: "CA and insecure", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com", Token: "t", CertificateAuthorityData: string(caPEM), InsecureSkipTLSVerify: true}, wantErr: true},
+		{name: "invalid CA", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com", Token: "t", CertificateAuthorityData: "not a certificate"}, wantErr: true},
+		{name: "http server", creds: constants.IntegrationCredentials{Server: "http://k8s.example.com", Token: "t"}, wantErr: true},
+		{name: "missing token", creds: constants.IntegrationCredentials{Server: "https://k8s.example.com"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TokenRestConfig(tt.creds)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TokenRestConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Host != tt.wantHost {
+				t.Errorf("Host = %q, want %q", got.Host, tt.wantHost)
+			}
+			if got.BearerToken != "t" {
+				t.Errorf("BearerToken = %q, want %q", got.BearerToken, "t")
+			}
+			if !bytes.Equal(bytes.TrimSpace(got.CAData), bytes.TrimSpace(tt.wantCAData)) {
+				t.Errorf("CAData = %q, want %q", got.CAData, tt.wantCAData)
+			}
+			if got.Insecure != tt.creds.InsecureSkipTLSVerify {
+				t.Errorf("Insecure = %v, want %v", got.Insecure, tt.creds.InsecureSkipTLSVerify)
+			}
+		})
+	}
+}
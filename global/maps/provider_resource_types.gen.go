@@ -18,7 +18,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesResources),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesResource),
 	},
 
 	"Kubernetes/Node": {
@@ -31,7 +31,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesNode),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesNode),
 	},
 
 	"Kubernetes/PersistentVolume": {
@@ -44,7 +44,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPersistentVolume),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPersistentVolume),
 	},
 
 	"Kubernetes/PersistentVolumeClaim": {
@@ -57,7 +57,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPersistentVolumeClaim),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPersistentVolumeClaim),
 	},
 
 	"Kubernetes/Pod": {
@@ -70,7 +70,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPod),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPod),
 	},
 
 	"Kubernetes/Secret": {
@@ -83,7 +83,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesSecret),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesSecret),
 	},
 
 	"Kubernetes/Service": {
@@ -96,7 +96,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesService),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesService),
 	},
 
 	"Kubernetes/ConfigMap": {
@@ -109,7 +109,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesConfigMap),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesConfigMap),
 	},
 
 	"Kubernetes/ServiceAccount": {
@@ -122,7 +122,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesServiceAccount),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesServiceAccount),
 	},
 
 	"Kubernetes/StatefulSet": {
@@ -135,7 +135,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesStatefulSet),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesStatefulSet),
 	},
 
 	"Kubernetes/Deployment": {
@@ -148,7 +148,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesDeployment),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesDeployment),
 	},
 
 	"Kubernetes/ReplicaSet": {
@@ -161,7 +161,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesReplicaSet),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesReplicaSet),
 	},
 
 	"Kubernetes/DaemonSet": {
@@ -174,7 +174,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesDaemonSet),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesDaemonSet),
 	},
 
 	"Kubernetes/Endpoint": {
@@ -187,7 +187,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesEndpoint),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesEndpoint),
 	},
 
 	"Kubernetes/EndpointSlice": {
@@ -200,7 +200,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesEndpointSlice),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesEndpointSlice),
 	},
 
 	"Kubernetes/Event": {
@@ -213,7 +213,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesEvent),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesEvent),
 	},
 
 	"Kubernetes/Job": {
@@ -226,7 +226,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesJob),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesJob),
 	},
 
 	"Kubernetes/CronJob": {
@@ -239,7 +239,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCronJob),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCronJob),
 	},
 
 	"Kubernetes/Ingress": {
@@ -252,7 +252,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesIngress),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesIngress),
 	},
 
 	"Kubernetes/NetworkPolicy": {
@@ -265,7 +265,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesNetworkPolicy),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesNetworkPolicy),
 	},
 
 	"Kubernetes/Role": {
@@ -278,7 +278,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesRole),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesRole),
 	},
 
 	"Kubernetes/RoleBinding": {
@@ -291,7 +291,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesRoleBinding),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesRoleBinding),
 	},
 
 	"Kubernetes/Cluster": {
@@ -304,7 +304,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCluster),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCluster),
 	},
 
 	"Kubernetes/ClusterRole": {
@@ -317,7 +317,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesClusterRole),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesClusterRole),
 	},
 
 	"Kubernetes/ClusterRoleBinding": {
@@ -330,7 +330,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesClusterRoleBinding),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesClusterRoleBinding),
 	},
 
 	"Kubernetes/PodDisruptionBudget": {
@@ -343,7 +343,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPodDisruptionBudget),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPodDisruptionBudget),
 	},
 
 	"Kubernetes/PodTemplate": {
@@ -356,7 +356,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPodTemplate),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPodTemplate),
 	},
 
 	"Kubernetes/HorizontalPodAutoscaler": {
@@ -369,7 +369,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesHorizontalPodAutoscaler),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesHorizontalPodAutoscaler),
 	},
 
 	"Kubernetes/CustomResourceDefinition": {
@@ -382,7 +382,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCustomResourceDefinition),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCustomResourceDefinition),
 	},
 
 	"Kubernetes/CustomResource": {
@@ -395,7 +395,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCustomResource),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCustomResource),
 	},
 
 	"Kubernetes/StorageClass": {
@@ -408,7 +408,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesStorageClass),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesStorageClass),
 	},
 
 	"Kubernetes/LimitRange": {
@@ -421,7 +421,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesLimitRange),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesLimitRange),
 	},
 
 	"Kubernetes/Namespace": {
@@ -434,7 +434,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesNamespace),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesNamespace),
 	},
 
 	"Kubernetes/ReplicationController": {
@@ -447,7 +447,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesReplicationController),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesReplicationController),
 	},
 
 	"Kubernetes/RessourceQuota": {
@@ -460,7 +460,553 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesResourceQuota),
-		GetDescriber:         nil,
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesResourceQuota),
+	},
+
+	"Kubernetes/HelmRelease": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/HelmRelease",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesHelmRelease),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesHelmRelease),
+	},
+
+	"Kubernetes/MutatingWebhookConfiguration": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/MutatingWebhookConfiguration",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesMutatingWebhookConfiguration),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesMutatingWebhookConfiguration),
+	},
+
+	"Kubernetes/ValidatingWebhookConfiguration": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ValidatingWebhookConfiguration",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesValidatingWebhookConfiguration),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesValidatingWebhookConfiguration),
+	},
+
+	"Kubernetes/ValidatingAdmissionPolicy": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ValidatingAdmissionPolicy",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesValidatingAdmissionPolicy),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesValidatingAdmissionPolicy),
+	},
+
+	"Kubernetes/ValidatingAdmissionPolicyBinding": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ValidatingAdmissionPolicyBinding",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesValidatingAdmissionPolicyBinding),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesValidatingAdmissionPolicyBinding),
+	},
+
+	"Kubernetes/IngressClass": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/IngressClass",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesIngressClass),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesIngressClass),
+	},
+
+	"Kubernetes/GatewayClass": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/GatewayClass",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesGatewayClass),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesGatewayClass),
+	},
+
+	"Kubernetes/Gateway": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/Gateway",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesGateway),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesGateway),
+	},
+
+	"Kubernetes/HTTPRoute": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/HTTPRoute",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesHTTPRoute),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesHTTPRoute),
+	},
+
+	"Kubernetes/GRPCRoute": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/GRPCRoute",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesGRPCRoute),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesGRPCRoute),
+	},
+
+	"Kubernetes/ReferenceGrant": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ReferenceGrant",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesReferenceGrant),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesReferenceGrant),
+	},
+
+	"Kubernetes/CSIDriver": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/CSIDriver",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCSIDriver),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCSIDriver),
+	},
+
+	"Kubernetes/CSINode": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/CSINode",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCSINode),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCSINode),
+	},
+
+	"Kubernetes/VolumeAttachment": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/VolumeAttachment",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesVolumeAttachment),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesVolumeAttachment),
+	},
+
+	"Kubernetes/VolumeSnapshot": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/VolumeSnapshot",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesVolumeSnapshot),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesVolumeSnapshot),
+	},
+
+	"Kubernetes/VolumeSnapshotClass": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/VolumeSnapshotClass",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesVolumeSnapshotClass),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesVolumeSnapshotClass),
+	},
+
+	"Kubernetes/VolumeSnapshotContent": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/VolumeSnapshotContent",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesVolumeSnapshotContent),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesVolumeSnapshotContent),
+	},
+
+	"Kubernetes/PriorityClass": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/PriorityClass",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPriorityClass),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPriorityClass),
+	},
+
+	"Kubernetes/RuntimeClass": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/RuntimeClass",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesRuntimeClass),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesRuntimeClass),
+	},
+
+	"Kubernetes/APIService": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/APIService",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesAPIService),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesAPIService),
+	},
+
+	"Kubernetes/CertificateSigningRequest": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/CertificateSigningRequest",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCertificateSigningRequest),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCertificateSigningRequest),
+	},
+
+	"Kubernetes/Lease": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/Lease",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesLease),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesLease),
+	},
+
+	"Kubernetes/FlowSchema": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/FlowSchema",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesFlowSchema),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesFlowSchema),
+	},
+
+	"Kubernetes/PriorityLevelConfiguration": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/PriorityLevelConfiguration",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPriorityLevelConfiguration),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPriorityLevelConfiguration),
+	},
+
+	"Kubernetes/NodeMetric": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/NodeMetric",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesNodeMetric),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesNodeMetric),
+	},
+
+	"Kubernetes/PodMetric": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/PodMetric",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPodMetric),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPodMetric),
+	},
+
+	"Kubernetes/VerticalPodAutoscaler": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/VerticalPodAutoscaler",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesVerticalPodAutoscaler),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesVerticalPodAutoscaler),
+	},
+
+	"Kubernetes/Certificate": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/Certificate",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCertificate),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCertificate),
+	},
+
+	"Kubernetes/CertificateRequest": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/CertificateRequest",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesCertificateRequest),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesCertificateRequest),
+	},
+
+	"Kubernetes/Issuer": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/Issuer",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesIssuer),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesIssuer),
+	},
+
+	"Kubernetes/ClusterIssuer": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ClusterIssuer",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesClusterIssuer),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesClusterIssuer),
+	},
+
+	"Kubernetes/ServiceMonitor": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ServiceMonitor",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesServiceMonitor),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesServiceMonitor),
+	},
+
+	"Kubernetes/PodMonitor": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/PodMonitor",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPodMonitor),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPodMonitor),
+	},
+
+	"Kubernetes/PrometheusRule": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/PrometheusRule",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPrometheusRule),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPrometheusRule),
+	},
+
+	"Kubernetes/Prometheus": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/Prometheus",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesPrometheus),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesPrometheus),
+	},
+
+	"Kubernetes/ArgoApplication": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ArgoApplication",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesArgoApplication),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesArgoApplication),
+	},
+
+	"Kubernetes/ArgoAppProject": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/ArgoAppProject",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesArgoAppProject),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesArgoAppProject),
+	},
+
+	"Kubernetes/FluxKustomization": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/FluxKustomization",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesFluxKustomization),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesFluxKustomization),
+	},
+
+	"Kubernetes/FluxHelmRelease": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/FluxHelmRelease",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesFluxHelmRelease),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesFluxHelmRelease),
+	},
+
+	"Kubernetes/FluxGitRepository": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/FluxGitRepository",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesFluxGitRepository),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesFluxGitRepository),
+	},
+
+	"Kubernetes/FluxHelmRepository": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/FluxHelmRepository",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesFluxHelmRepository),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesFluxHelmRepository),
+	},
+
+	"Kubernetes/FluxOCIRepository": {
+		IntegrationType:      constants.IntegrationName,
+		ResourceName:         "Kubernetes/FluxOCIRepository",
+		Tags:                 map[string][]string{
+        },
+		Labels:               map[string]string{
+        },
+		Annotations:          map[string]string{
+        },
+		ListDescriber:        provider.DescribeByIntegration(describers.KubernetesFluxOCIRepository),
+		GetDescriber:         provider.DescribeSingleByIntegration(describers.GetKubernetesFluxOCIRepository),
 	},
 }
 
@@ -711,6 +1257,300 @@ var ResourceTypeConfigs = map[string]*interfaces.ResourceTypeConfiguration{
 		Description:                 "",
 		
 	},
+
+	"Kubernetes/HelmRelease": {
+		Name:         "Kubernetes/HelmRelease",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/MutatingWebhookConfiguration": {
+		Name:         "Kubernetes/MutatingWebhookConfiguration",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ValidatingWebhookConfiguration": {
+		Name:         "Kubernetes/ValidatingWebhookConfiguration",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ValidatingAdmissionPolicy": {
+		Name:         "Kubernetes/ValidatingAdmissionPolicy",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ValidatingAdmissionPolicyBinding": {
+		Name:         "Kubernetes/ValidatingAdmissionPolicyBinding",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/IngressClass": {
+		Name:         "Kubernetes/IngressClass",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/GatewayClass": {
+		Name:         "Kubernetes/GatewayClass",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/Gateway": {
+		Name:         "Kubernetes/Gateway",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/HTTPRoute": {
+		Name:         "Kubernetes/HTTPRoute",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/GRPCRoute": {
+		Name:         "Kubernetes/GRPCRoute",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ReferenceGrant": {
+		Name:         "Kubernetes/ReferenceGrant",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/CSIDriver": {
+		Name:         "Kubernetes/CSIDriver",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/CSINode": {
+		Name:         "Kubernetes/CSINode",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/VolumeAttachment": {
+		Name:         "Kubernetes/VolumeAttachment",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/VolumeSnapshot": {
+		Name:         "Kubernetes/VolumeSnapshot",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/VolumeSnapshotClass": {
+		Name:         "Kubernetes/VolumeSnapshotClass",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/VolumeSnapshotContent": {
+		Name:         "Kubernetes/VolumeSnapshotContent",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/PriorityClass": {
+		Name:         "Kubernetes/PriorityClass",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/RuntimeClass": {
+		Name:         "Kubernetes/RuntimeClass",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/APIService": {
+		Name:         "Kubernetes/APIService",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/CertificateSigningRequest": {
+		Name:         "Kubernetes/CertificateSigningRequest",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/Lease": {
+		Name:         "Kubernetes/Lease",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/FlowSchema": {
+		Name:         "Kubernetes/FlowSchema",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/PriorityLevelConfiguration": {
+		Name:         "Kubernetes/PriorityLevelConfiguration",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/NodeMetric": {
+		Name:         "Kubernetes/NodeMetric",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/PodMetric": {
+		Name:         "Kubernetes/PodMetric",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/VerticalPodAutoscaler": {
+		Name:         "Kubernetes/VerticalPodAutoscaler",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/Certificate": {
+		Name:         "Kubernetes/Certificate",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/CertificateRequest": {
+		Name:         "Kubernetes/CertificateRequest",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/Issuer": {
+		Name:         "Kubernetes/Issuer",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ClusterIssuer": {
+		Name:         "Kubernetes/ClusterIssuer",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ServiceMonitor": {
+		Name:         "Kubernetes/ServiceMonitor",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/PodMonitor": {
+		Name:         "Kubernetes/PodMonitor",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/PrometheusRule": {
+		Name:         "Kubernetes/PrometheusRule",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/Prometheus": {
+		Name:         "Kubernetes/Prometheus",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ArgoApplication": {
+		Name:         "Kubernetes/ArgoApplication",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/ArgoAppProject": {
+		Name:         "Kubernetes/ArgoAppProject",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/FluxKustomization": {
+		Name:         "Kubernetes/FluxKustomization",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/FluxHelmRelease": {
+		Name:         "Kubernetes/FluxHelmRelease",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/FluxGitRepository": {
+		Name:         "Kubernetes/FluxGitRepository",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/FluxHelmRepository": {
+		Name:         "Kubernetes/FluxHelmRepository",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
+
+	"Kubernetes/FluxOCIRepository": {
+		Name:         "Kubernetes/FluxOCIRepository",
+		IntegrationType:      constants.IntegrationName,
+		Description:                 "",
+		
+	},
 }
 
 
@@ -750,4 +1590,46 @@ var ResourceTypesList = []string{
   "Kubernetes/Namespace",
   "Kubernetes/ReplicationController",
   "Kubernetes/RessourceQuota",
+  "Kubernetes/HelmRelease",
+  "Kubernetes/MutatingWebhookConfiguration",
+  "Kubernetes/ValidatingWebhookConfiguration",
+  "Kubernetes/ValidatingAdmissionPolicy",
+  "Kubernetes/ValidatingAdmissionPolicyBinding",
+  "Kubernetes/IngressClass",
+  "Kubernetes/GatewayClass",
+  "Kubernetes/Gateway",
+  "Kubernetes/HTTPRoute",
+  "Kubernetes/GRPCRoute",
+  "Kubernetes/ReferenceGrant",
+  "Kubernetes/CSIDriver",
+  "Kubernetes/CSINode",
+  "Kubernetes/VolumeAttachment",
+  "Kubernetes/VolumeSnapshot",
+  "Kubernetes/VolumeSnapshotClass",
+  "Kubernetes/VolumeSnapshotContent",
+  "Kubernetes/PriorityClass",
+  "Kubernetes/RuntimeClass",
+  "Kubernetes/APIService",
+  "Kubernetes/CertificateSigningRequest",
+  "Kubernetes/Lease",
+  "Kubernetes/FlowSchema",
+  "Kubernetes/PriorityLevelConfiguration",
+  "Kubernetes/NodeMetric",
+  "Kubernetes/PodMetric",
+  "Kubernetes/VerticalPodAutoscaler",
+  "Kubernetes/Certificate",
+  "Kubernetes/CertificateRequest",
+  "Kubernetes/Issuer",
+  "Kubernetes/ClusterIssuer",
+  "Kubernetes/ServiceMonitor",
+  "Kubernetes/PodMonitor",
+  "Kubernetes/PrometheusRule",
+  "Kubernetes/Prometheus",
+  "Kubernetes/ArgoApplication",
+  "Kubernetes/ArgoAppProject",
+  "Kubernetes/FluxKustomization",
+  "Kubernetes/FluxHelmRelease",
+  "Kubernetes/FluxGitRepository",
+  "Kubernetes/FluxHelmRepository",
+  "Kubernetes/FluxOCIRepository",
 }
\ No newline at end of file
@@ -40,6 +40,48 @@ var ResourceTypesToTables = map[string]string{
   "Kubernetes/Namespace": "kubernetes_namespace",
   "Kubernetes/ReplicationController": "kubernetes_replication_controller",
   "Kubernetes/RessourceQuota": "kubernetes_resource_quota",
+  "Kubernetes/HelmRelease": "kubernetes_helm_release",
+  "Kubernetes/MutatingWebhookConfiguration": "kubernetes_mutating_webhook_configuration",
+  "Kubernetes/ValidatingWebhookConfiguration": "kubernetes_validating_webhook_configuration",
+  "Kubernetes/ValidatingAdmissionPolicy": "kubernetes_validating_admission_policy",
+  "Kubernetes/ValidatingAdmissionPolicyBinding": "kubernetes_validating_admission_policy_binding",
+  "Kubernetes/IngressClass": "kubernetes_ingress_class",
+  "Kubernetes/GatewayClass": "kubernetes_gateway_class",
+  "Kubernetes/Gateway": "kubernetes_gateway",
+  "Kubernetes/HTTPRoute": "kubernetes_http_route",
+  "Kubernetes/GRPCRoute": "kubernetes_grpc_route",
+  "Kubernetes/ReferenceGrant": "kubernetes_reference_grant",
+  "Kubernetes/CSIDriver": "kubernetes_csi_driver",
+  "Kubernetes/CSINode": "kubernetes_csi_node",
+  "Kubernetes/VolumeAttachment": "kubernetes_volume_attachment",
+  "Kubernetes/VolumeSnapshot": "kubernetes_volume_snapshot",
+  "Kubernetes/VolumeSnapshotClass": "kubernetes_volume_snapshot_class",
+  "Kubernetes/VolumeSnapshotContent": "kubernetes_volume_snapshot_content",
+  "Kubernetes/PriorityClass": "kubernetes_priority_class",
+  "Kubernetes/RuntimeClass": "kubernetes_runtime_class",
+  "Kubernetes/APIService": "kubernetes_api_service",
+  "Kubernetes/CertificateSigningRequest": "kubernetes_certificate_signing_request",
+  "Kubernetes/Lease": "kubernetes_lease",
+  "Kubernetes/FlowSchema": "kubernetes_flow_schema",
+  "Kubernetes/PriorityLevelConfiguration": "kubernetes_priority_level_configuration",
+  "Kubernetes/NodeMetric": "kubernetes_node_metric",
+  "Kubernetes/PodMetric": "kubernetes_pod_metric",
+  "Kubernetes/VerticalPodAutoscaler": "kubernetes_vertical_pod_autoscaler",
+  "Kubernetes/Certificate": "kubernetes_certificate",
+  "Kubernetes/CertificateRequest": "kubernetes_certificate_request",
+  "Kubernetes/Issuer": "kubernetes_issuer",
+  "Kubernetes/ClusterIssuer": "kubernetes_cluster_issuer",
+  "Kubernetes/ServiceMonitor": "kubernetes_service_monitor",
+  "Kubernetes/PodMonitor": "kubernetes_pod_monitor",
+  "Kubernetes/PrometheusRule": "kubernetes_prometheus_rule",
+  "Kubernetes/Prometheus": "kubernetes_prometheus",
+  "Kubernetes/ArgoApplication": "kubernetes_argo_application",
+  "Kubernetes/ArgoAppProject": "kubernetes_argo_app_project",
+  "Kubernetes/FluxKustomization": "kubernetes_flux_kustomization",
+  "Kubernetes/FluxHelmRelease": "kubernetes_flux_helm_release",
+  "Kubernetes/FluxGitRepository": "kubernetes_flux_git_repository",
+  "Kubernetes/FluxHelmRepository": "kubernetes_flux_helm_repository",
+  "Kubernetes/FluxOCIRepository": "kubernetes_flux_oci_repository",
 }
 
 var ResourceTypeToDescription = map[string]interface{}{
@@ -78,6 +120,48 @@ var ResourceTypeToDescription = map[string]interface{}{
   "Kubernetes/Namespace": opengovernance.KubernetesNamespace{},
   "Kubernetes/ReplicationController": opengovernance.KubernetesReplicationController{},
   "Kubernetes/RessourceQuota": opengovernance.KubernetesResourceQuota{},
+  "Kubernetes/HelmRelease": opengovernance.KubernetesHelmRelease{},
+  "Kubernetes/MutatingWebhookConfiguration": opengovernance.KubernetesMutatingWebhookConfiguration{},
+  "Kubernetes/ValidatingWebhookConfiguration": opengovernance.KubernetesValidatingWebhookConfiguration{},
+  "Kubernetes/ValidatingAdmissionPolicy": opengovernance.KubernetesValidatingAdmissionPolicy{},
+  "Kubernetes/ValidatingAdmissionPolicyBinding": opengovernance.KubernetesValidatingAdmissionPolicyBinding{},
+  "Kubernetes/IngressClass": opengovernance.KubernetesIngressClass{},
+  "Kubernetes/GatewayClass": opengovernance.KubernetesGatewayClass{},
+  "Kubernetes/Gateway": opengovernance.KubernetesGateway{},
+  "Kubernetes/HTTPRoute": opengovernance.KubernetesHTTPRoute{},
+  "Kubernetes/GRPCRoute": opengovernance.KubernetesGRPCRoute{},
+  "Kubernetes/ReferenceGrant": opengovernance.KubernetesReferenceGrant{},
+  "Kubernetes/CSIDriver": opengovernance.KubernetesCSIDriver{},
+  "Kubernetes/CSINode": opengovernance.KubernetesCSINode{},
+  "Kubernetes/VolumeAttachment": opengovernance.KubernetesVolumeAttachment{},
+  "Kubernetes/VolumeSnapshot": opengovernance.KubernetesVolumeSnapshot{},
+  "Kubernetes/VolumeSnapshotClass": opengovernance.KubernetesVolumeSnapshotClass{},
+  "Kubernetes/VolumeSnapshotContent": opengovernance.KubernetesVolumeSnapshotContent{},
+  "Kubernetes/PriorityClass": opengovernance.KubernetesPriorityClass{},
+  "Kubernetes/RuntimeClass": opengovernance.KubernetesRuntimeClass{},
+  "Kubernetes/APIService": opengovernance.KubernetesAPIService{},
+  "Kubernetes/CertificateSigningRequest": opengovernance.KubernetesCertificateSigningRequest{},
+  "Kubernetes/Lease": opengovernance.KubernetesLease{},
+  "Kubernetes/FlowSchema": opengovernance.KubernetesFlowSchema{},
+  "Kubernetes/PriorityLevelConfiguration": opengovernance.KubernetesPriorityLevelConfiguration{},
+  "Kubernetes/NodeMetric": opengovernance.KubernetesNodeMetric{},
+  "Kubernetes/PodMetric": opengovernance.KubernetesPodMetric{},
+  "Kubernetes/VerticalPodAutoscaler": opengovernance.KubernetesVerticalPodAutoscaler{},
+  "Kubernetes/Certificate": opengovernance.KubernetesCertificate{},
+  "Kubernetes/CertificateRequest": opengovernance.KubernetesCertificateRequest{},
+  "Kubernetes/Issuer": opengovernance.KubernetesIssuer{},
+  "Kubernetes/ClusterIssuer": opengovernance.KubernetesClusterIssuer{},
+  "Kubernetes/ServiceMonitor": opengovernance.KubernetesServiceMonitor{},
+  "Kubernetes/PodMonitor": opengovernance.KubernetesPodMonitor{},
+  "Kubernetes/PrometheusRule": opengovernance.KubernetesPrometheusRule{},
+  "Kubernetes/Prometheus": opengovernance.KubernetesPrometheus{},
+  "Kubernetes/ArgoApplication": opengovernance.KubernetesArgoApplication{},
+  "Kubernetes/ArgoAppProject": opengovernance.KubernetesArgoAppProject{},
+  "Kubernetes/FluxKustomization": opengovernance.KubernetesFluxKustomization{},
+  "Kubernetes/FluxHelmRelease": opengovernance.KubernetesFluxHelmRelease{},
+  "Kubernetes/FluxGitRepository": opengovernance.KubernetesFluxGitRepository{},
+  "Kubernetes/FluxHelmRepository": opengovernance.KubernetesFluxHelmRepository{},
+  "Kubernetes/FluxOCIRepository": opengovernance.KubernetesFluxOCIRepository{},
 }
 
 var TablesToResourceTypes = map[string]string{
@@ -116,4 +200,46 @@ var TablesToResourceTypes = map[string]string{
   "kubernetes_namespace": "Kubernetes/Namespace",
   "kubernetes_replication_controller": "Kubernetes/ReplicationController",
   "kubernetes_resource_quota": "Kubernetes/RessourceQuota",
+  "kubernetes_helm_release": "Kubernetes/HelmRelease",
+  "kubernetes_mutating_webhook_configuration": "Kubernetes/MutatingWebhookConfiguration",
+  "kubernetes_validating_webhook_configuration": "Kubernetes/ValidatingWebhookConfiguration",
+  "kubernetes_validating_admission_policy": "Kubernetes/ValidatingAdmissionPolicy",
+  "kubernetes_validating_admission_policy_binding": "Kubernetes/ValidatingAdmissionPolicyBinding",
+  "kubernetes_ingress_class": "Kubernetes/IngressClass",
+  "kubernetes_gateway_class": "Kubernetes/GatewayClass",
+  "kubernetes_gateway": "Kubernetes/Gateway",
+  "kubernetes_http_route": "Kubernetes/HTTPRoute",
+  "kubernetes_grpc_route": "Kubernetes/GRPCRoute",
+  "kubernetes_reference_grant": "Kubernetes/ReferenceGrant",
+  "kubernetes_csi_driver": "Kubernetes/CSIDriver",
+  "kubernetes_csi_node": "Kubernetes/CSINode",
+  "kubernetes_volume_attachment": "Kubernetes/VolumeAttachment",
+  "kubernetes_volume_snapshot": "Kubernetes/VolumeSnapshot",
+  "kubernetes_volume_snapshot_class": "Kubernetes/VolumeSnapshotClass",
+  "kubernetes_volume_snapshot_content": "Kubernetes/VolumeSnapshotContent",
+  "kubernetes_priority_class": "Kubernetes/PriorityClass",
+  "kubernetes_runtime_class": "Kubernetes/RuntimeClass",
+  "kubernetes_api_service": "Kubernetes/APIService",
+  "kubernetes_certificate_signing_request": "Kubernetes/CertificateSigningRequest",
+  "kubernetes_lease": "Kubernetes/Lease",
+  "kubernetes_flow_schema": "Kubernetes/FlowSchema",
+  "kubernetes_priority_level_configuration": "Kubernetes/PriorityLevelConfiguration",
+  "kubernetes_node_metric": "Kubernetes/NodeMetric",
+  "kubernetes_pod_metric": "Kubernetes/PodMetric",
+  "kubernetes_vertical_pod_autoscaler": "Kubernetes/VerticalPodAutoscaler",
+  "kubernetes_certificate": "Kubernetes/Certificate",
+  "kubernetes_certificate_request": "Kubernetes/CertificateRequest",
+  "kubernetes_issuer": "Kubernetes/Issuer",
+  "kubernetes_cluster_issuer": "Kubernetes/ClusterIssuer",
+  "kubernetes_service_monitor": "Kubernetes/ServiceMonitor",
+  "kubernetes_pod_monitor": "Kubernetes/PodMonitor",
+  "kubernetes_prometheus_rule": "Kubernetes/PrometheusRule",
+  "kubernetes_prometheus": "Kubernetes/Prometheus",
+  "kubernetes_argo_application": "Kubernetes/ArgoApplication",
+  "kubernetes_argo_app_project": "Kubernetes/ArgoAppProject",
+  "kubernetes_flux_kustomization": "Kubernetes/FluxKustomization",
+  "kubernetes_flux_helm_release": "Kubernetes/FluxHelmRelease",
+  "kubernetes_flux_git_repository": "Kubernetes/FluxGitRepository",
+  "kubernetes_flux_helm_repository": "Kubernetes/FluxHelmRepository",
+  "kubernetes_flux_oci_repository": "Kubernetes/FluxOCIRepository",
 }
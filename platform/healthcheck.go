@@ -9,5 +9,5 @@ type Config struct {
 }
 
 func IntegrationHealthcheck(creds constants.IntegrationCredentials, cfg Config) (bool, error) {
-	return DoHealthcheck(creds.KubeConfig)
+	return DoHealthcheck(creds)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/opengovern/og-util/pkg/integration"
 	"github.com/opengovern/og-util/pkg/integration/interfaces"
 	"k8s.io/client-go/tools/clientcmd"
+	"sort"
 )
 
 type Integration struct{}
@@ -39,11 +40,25 @@ func (i *Integration) HealthCheck(jsonData []byte, providerId string, labels map
 	if err != nil {
 		return false, err
 	}
+	// integrations discovered from a multi-context kubeconfig share its credential, the context of the cluster
+	// the integration was discovered for is set on the credential before it is used
+	if credentials.GetCredentialType() == constants2.CredentialTypeKubeconfig && credentials.Context == "" {
+		credentials.Context, err = IntegrationContext([]byte(credentials.KubeConfig), labels[constants2.KubeContextLabel], providerId)
+		if err != nil {
+			return false, err
+		}
+	}
 	isHealthy, err := IntegrationHealthcheck(credentials, Config{})
 
 	return isHealthy, err
 }
 
+// DiscoverIntegrations returns one integration per reachable cluster of the credentials.
+// Every context is checked, contexts pointing to an already discovered cluster are skipped
+// (the current-context wins, then contexts are taken by name) and the context of each integration is kept in its labels.
+// The provider ID of each integration is the API server URL of its context's cluster. Health checks and describers set
+// the context of that cluster on the shared credential: the context label is only used if it still points to it,
+// otherwise the context is looked up from the provider ID, so a missing label never falls back to the current-context.
 func (i *Integration) DiscoverIntegrations(jsonData []byte) ([]integration.Integration, error) {
 	var credentials constants2.IntegrationCredentials
 	err := json.Unmarshal(jsonData, &credentials)
@@ -51,28 +66,51 @@ func (i *Integration) DiscoverIntegrations(jsonData []byte) ([]integration.Integ
 		return nil, err
 	}
 
-	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(credentials.KubeConfig))
+	config, err := clientcmd.Load([]byte(credentials.KubeConfig))
 	if err != nil {
 		return nil, err
 	}
 
-	info, err := DoDiscovery(credentials.KubeConfig)
+	clusters, err := DoDiscovery(credentials)
 	if err != nil {
 		return nil, err
 	}
 
-	var labels pgtype.JSONB
-	if err := labels.Set(info); err != nil {
-		return nil, err
+	contextNames := make([]string, 0, len(clusters))
+	for contextName := range clusters {
+		contextNames = append(contextNames, contextName)
 	}
+	sort.Slice(contextNames, func(i, j int) bool {
+		if (contextNames[i] == config.CurrentContext) != (contextNames[j] == config.CurrentContext) {
+			return contextNames[i] == config.CurrentContext
+		}
+		return contextNames[i] < contextNames[j]
+	})
+
+	var integrations []integration.Integration
+	seenEndpoints := make(map[string]bool)
+	for _, contextName := range contextNames {
+		info := clusters[contextName]
+		endpoint := info["endpoint"]
+		if seenEndpoints[endpoint] {
+			continue
+		}
+		seenEndpoints[endpoint] = true
 
-	return []integration.Integration{
-		{
-			ProviderID: config.Host,
-			Name:       config.Host,
+		info[constants2.KubeContextLabel] = contextName
+		var labels pgtype.JSONB
+		if err := labels.Set(info); err != nil {
+			return nil, err
+		}
+
+		integrations = append(integrations, integration.Integration{
+			ProviderID: endpoint,
+			Name:       contextName,
 			Labels:     labels,
-		},
-	}, nil
+		})
+	}
+
+	return integrations, nil
 }
 
 func (i *Integration) GetResourceTypesByLabels(labels map[string]string) ([]interfaces.ResourceTypeConfiguration, error) {
@@ -64,13 +64,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	// Zap logger
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/opengovern/og-describer-kubernetes/global/constants"
+	"github.com/opengovern/og-describer-kubernetes/global/kubeauth"
+
 	// Kubernetes client-go libraries
 	authorizationv1 "k8s.io/api/authorization/v1" // For SelfSubjectAccessReview
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -93,6 +98,8 @@ const (
 	RequestTimeout = 8 * time.Second
 	// EnvLogLevel is the environment variable used to control logging level.
 	EnvLogLevel = "LOG_LEVEL"
+	// MaxConcurrentContextChecks bounds how many kubeconfig contexts are validated and reached in parallel during discovery.
+	MaxConcurrentContextChecks = 8
 )
 
 // --- Output Structures (with snake_case JSON tags) ---
@@ -116,12 +123,13 @@ type ErrorInfo struct {
 
 // HealthPermission represents a specific permission check failure during the health check.
 type HealthPermission struct {
-	Group    string `json:"group"`
-	Version  string `json:"version"`
-	Resource string `json:"resource"`
-	Verb     string `json:"verb"`
-	Scope    string `json:"scope"` // e.g., "cluster", "namespace"
-	Reason   string `json:"reason,omitempty"`
+	Group     string `json:"group"`
+	Version   string `json:"version"`
+	Resource  string `json:"resource"`
+	Verb      string `json:"verb"`
+	Scope     string `json:"scope"`               // e.g., "cluster", "namespace"
+	Namespace string `json:"namespace,omitempty"` // Set only for credentials restricted to a set of namespaces
+	Reason    string `json:"reason,omitempty"`
 }
 
 // HealthStatus represents the overall outcome of the health check.
@@ -135,11 +143,12 @@ type HealthStatus struct {
 
 // ResourceToCheck defines the GVR and scope for a health check permission.
 type ResourceToCheck struct {
-	Group    string
-	Version  string
-	Resource string
-	Scope    string // Friendly scope name for reporting ("cluster" or "namespace")
-	Friendly string // User-friendly name (e.g., "Pod", "Deployment")
+	Group     string
+	Version   string
+	Resource  string
+	Scope     string // Friendly scope name for reporting ("cluster" or "namespace")
+	Friendly  string // User-friendly name (e.g., "Pod", "Deployment")
+	Namespace string // Namespace the permission is checked in, empty for cluster-wide
 }
 
 // resourcesToVerify defines the list of resource types and their corresponding API groups/versions
@@ -161,6 +170,11 @@ var resourcesToVerify = []ResourceToCheck{
 	{Group: "", Version: "v1", Resource: "secrets", Scope: "namespace", Friendly: "Secret"},
 	{Group: "", Version: "v1", Resource: "serviceaccounts", Scope: "namespace", Friendly: "ServiceAccount"},
 	{Group: "", Version: "v1", Resource: "services", Scope: "namespace", Friendly: "Service"},
+	// Admission Registration API Group ("admissionregistration.k8s.io")
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations", Scope: "cluster", Friendly: "MutatingWebhookConfiguration"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations", Scope: "cluster", Friendly: "ValidatingWebhookConfiguration"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicies", Scope: "cluster", Friendly: "ValidatingAdmissionPolicy"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicybindings", Scope: "cluster", Friendly: "ValidatingAdmissionPolicyBinding"},
 	// Apps API Group ("apps")
 	{Group: "apps", Version: "v1", Resource: "daemonsets", Scope: "namespace", Friendly: "DaemonSet"},
 	{Group: "apps", Version: "v1", Resource: "deployments", Scope: "namespace", Friendly: "Deployment"},
@@ -189,6 +203,22 @@ var resourcesToVerify = []ResourceToCheck{
 	{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions", Scope: "cluster", Friendly: "CustomResourceDefinition"},
 }
 
+// namespacedResourcesToVerify returns the checks of credentials restricted to a set of namespaces:
+// 'list' permission on every namespaced resource is checked in each of the namespaces, cluster-scoped resources are not checked.
+func namespacedResourcesToVerify(namespaces []string) []ResourceToCheck {
+	var checks []ResourceToCheck
+	for _, namespace := range namespaces {
+		for _, resourceCheck := range resourcesToVerify {
+			if resourceCheck.Scope != "namespace" {
+				continue
+			}
+			resourceCheck.Namespace = namespace
+			checks = append(checks, resourceCheck)
+		}
+	}
+	return checks
+}
+
 // --- Helper Function for Consistent Error JSON ---
 
 // createErrorJSON creates a standardized JSON string representation of an ErrorInfo struct.
@@ -217,59 +247,85 @@ func createErrorJSON(message string, detailErr error, logger *zap.Logger) string
 
 // --- Kubeconfig Validation Function ---
 
-// ValidateKubeconfig reads, parses, and performs structural validation on a kubeconfig file.
-// It returns the parsed Kubeconfig structure (api.Config), the REST config, and nil error on success.
+// ValidateKubeconfig parses and performs structural validation on in-memory kubeconfig data, nothing is written to disk.
+// It returns the parsed Kubeconfig structure (api.Config), the REST config of its current-context, and nil error on success.
 // On failure, it logs the error (if logger is enabled) and returns nil configs along with the error.
-func ValidateKubeconfig(ctx context.Context, kubeconfigPath string, logger *zap.Logger) (*api.Config, *rest.Config, error) {
-	l := logger.With(zap.String("kubeconfig_path", kubeconfigPath))
-
-	// 1. Read File
-	kubeconfigBytes, err := os.ReadFile(kubeconfigPath)
-	if err != nil {
-		wrappedErr := xerrors.Errorf("failed to read kubeconfig file '%s': %w", kubeconfigPath, err)
-		l.Error("Cannot read input file", zap.Error(wrappedErr)) // Logged at Error level
-		return nil, nil, wrappedErr
-	}
+func ValidateKubeconfig(ctx context.Context, kubeconfigBytes []byte, logger *zap.Logger) (*api.Config, *rest.Config, error) {
+	l := logger
 
-	// 2. Parse Structure (Lean Check)
-	configAPI, err := clientcmd.Load(kubeconfigBytes)
+	// 1. Parse Data
+	configAPI, err := LoadKubeconfig(kubeconfigBytes, logger)
 	if err != nil {
-		wrappedErr := xerrors.Errorf("failed to parse kubeconfig data from '%s': %w", kubeconfigPath, err)
-		l.Error("Cannot parse kubeconfig data (is it valid YAML/JSON?)", zap.Error(wrappedErr)) // Logged at Error level
-		return nil, nil, wrappedErr
+		return nil, nil, err
 	}
 
-	// 3. Deeper Validation
+	// 2. Deeper Validation of the current-context
 	if configAPI.CurrentContext == "" {
 		errMsg := "kubeconfig validation failed: current-context is not set"
 		err := xerrors.New(errMsg)
 		l.Error(errMsg) // Logged at Error level
 		return nil, nil, err
 	}
-	l = l.With(zap.String("current_context", configAPI.CurrentContext))
 
-	contextInfo, contextExists := configAPI.Contexts[configAPI.CurrentContext]
+	restConfig, err := ValidateKubeContext(configAPI, configAPI.CurrentContext, l)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return configAPI, restConfig, nil
+}
+
+// LoadKubeconfig parses in-memory kubeconfig data without validating any of its contexts.
+func LoadKubeconfig(kubeconfigBytes []byte, logger *zap.Logger) (*api.Config, error) {
+	// Parse Structure (Lean Check)
+	configAPI, err := clientcmd.Load(kubeconfigBytes)
+	if err != nil {
+		wrappedErr := xerrors.Errorf("failed to parse kubeconfig data: %w", err)
+		logger.Error("Cannot parse kubeconfig data (is it valid YAML/JSON?)", zap.Error(wrappedErr)) // Logged at Error level
+		return nil, wrappedErr
+	}
+
+	return configAPI, nil
+}
+
+// IntegrationContext picks with kubeauth.IntegrationContext, as the describers do, the context of a shared kubeconfig
+// the integration was discovered from, its provider ID decides the cluster and the context label is only a hint.
+func IntegrationContext(kubeconfigBytes []byte, labelContext string, providerID string) (string, error) {
+	configAPI, err := clientcmd.Load(kubeconfigBytes)
+	if err != nil {
+		return "", xerrors.Errorf("failed to parse kubeconfig data: %w", err)
+	}
+	return kubeauth.IntegrationContext(configAPI, labelContext, providerID)
+}
+
+// ValidateKubeContext performs structural validation on a single context of a parsed kubeconfig
+// and builds the REST config used to reach its cluster.
+// On failure, it logs the error (if logger is enabled) and returns a nil config along with the error.
+func ValidateKubeContext(configAPI *api.Config, contextName string, logger *zap.Logger) (*rest.Config, error) {
+	l := logger.With(zap.String("context", contextName))
+
+	contextInfo, contextExists := configAPI.Contexts[contextName]
 	if !contextExists {
-		errMsg := fmt.Sprintf("current-context '%s' not found in contexts map", configAPI.CurrentContext)
+		errMsg := fmt.Sprintf("context '%s' not found in contexts map", contextName)
 		err := xerrors.New(errMsg)
 		l.Error("Kubeconfig validation failed", zap.String("reason", errMsg))
-		return nil, nil, err
+		return nil, err
 	}
 
 	if contextInfo.Cluster == "" {
-		errMsg := fmt.Sprintf("cluster not defined for context '%s'", configAPI.CurrentContext)
+		errMsg := fmt.Sprintf("cluster not defined for context '%s'", contextName)
 		err := xerrors.New(errMsg)
 		l.Error("Kubeconfig validation failed", zap.String("reason", errMsg))
-		return nil, nil, err
+		return nil, err
 	}
 	l = l.With(zap.String("cluster_name", contextInfo.Cluster))
 
 	_, clusterExists := configAPI.Clusters[contextInfo.Cluster]
 	if !clusterExists {
-		errMsg := fmt.Sprintf("cluster '%s' (referenced by context '%s') not found in clusters map", contextInfo.Cluster, configAPI.CurrentContext)
+		errMsg := fmt.Sprintf("cluster '%s' (referenced by context '%s') not found in clusters map", contextInfo.Cluster, contextName)
 		err := xerrors.New(errMsg)
 		l.Error("Kubeconfig validation failed", zap.String("reason", errMsg))
-		return nil, nil, err
+		return nil, err
 	}
 
 	authInfoNameLog := "<anonymous>"
@@ -277,31 +333,28 @@ func ValidateKubeconfig(ctx context.Context, kubeconfigPath string, logger *zap.
 		authInfoNameLog = contextInfo.AuthInfo
 		_, authInfoExists := configAPI.AuthInfos[contextInfo.AuthInfo]
 		if !authInfoExists {
-			errMsg := fmt.Sprintf("authinfo (user) '%s' (referenced by context '%s') not found in users map", contextInfo.AuthInfo, configAPI.CurrentContext)
+			errMsg := fmt.Sprintf("authinfo (user) '%s' (referenced by context '%s') not found in users map", contextInfo.AuthInfo, contextName)
 			err := xerrors.New(errMsg)
 			l.Error("Kubeconfig validation failed", zap.String("reason", errMsg))
-			return nil, nil, err
+			return nil, err
 		}
 	} else {
 		l.Warn("AuthInfo (user) not defined for context. Cluster access might be anonymous.") // Logged at Warn level
 	}
 	l = l.With(zap.String("auth_info_name", authInfoNameLog))
 
-	// 4. Build REST Config
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	loadingRules.ExplicitPath = kubeconfigPath
-	configOverrides := &clientcmd.ConfigOverrides{}
-	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+	// Build REST Config
+	clientConfig := clientcmd.NewNonInteractiveClientConfig(*configAPI, contextName, &clientcmd.ConfigOverrides{}, nil)
 
 	restConfig, err := clientConfig.ClientConfig()
 	if err != nil {
-		wrappedErr := xerrors.Errorf("failed to build REST client config from kubeconfig: %w", err)
+		wrappedErr := xerrors.Errorf("failed to build REST client config for context '%s': %w", contextName, err)
 		l.Error("Cannot build client configuration", zap.Error(wrappedErr)) // Logged at Error level
-		return nil, nil, wrappedErr
+		return nil, wrappedErr
 	}
 
 	l.Info("Kubeconfig validation successful") // Logged at Info level
-	return configAPI, restConfig, nil
+	return restConfig, nil
 }
 
 // --- Cluster Information Retrieval Function ---
@@ -365,6 +418,12 @@ func GetClusterInfo(ctx context.Context, configAPI *api.Config, restConfig *rest
 	output.AuthMethod = authMethod
 	l = l.With(zap.String("determined_auth_method", authMethod))
 
+	return getClusterInfo(ctx, output, restConfig, l, logger)
+}
+
+// getClusterInfo completes the cluster information with the server version of the cluster.
+// It *always* returns a JSON string: ClusterInfo on success, or ErrorInfo on failure.
+func getClusterInfo(ctx context.Context, output ClusterInfo, restConfig *rest.Config, l *zap.Logger, logger *zap.Logger) string {
 	// 2. Create Clientset
 	clientRestConfig := *restConfig
 	clientRestConfig.Timeout = RequestTimeout
@@ -455,9 +514,10 @@ func GetClusterInfo(ctx context.Context, configAPI *api.Config, restConfig *rest
 
 // --- Health Check Function ---
 
-// VerifyHealth checks if the credentials provided via the restConfig have 'list' permissions.
+// VerifyHealth checks if the credentials provided via the restConfig have 'list' permissions,
+// cluster-wide or, if namespaces is not empty, in each of the namespaces.
 // It *always* returns a JSON string: HealthStatus indicating success or detailing failures.
-func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logger) string {
+func VerifyHealth(ctx context.Context, restConfig *rest.Config, namespaces []string, logger *zap.Logger) string {
 	l := logger
 
 	// 1. Create Clientset
@@ -472,13 +532,18 @@ func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logg
 
 	l.Info("Starting health check: Verifying 'list' permissions for core resources...") // Logged at Info level
 
+	checks := resourcesToVerify
+	if len(namespaces) > 0 {
+		checks = namespacedResourcesToVerify(namespaces)
+	}
+
 	missingPermissions := []HealthPermission{}
-	totalChecks := len(resourcesToVerify)
+	totalChecks := len(checks)
 	failedChecks := 0
 	contextCancelled := false // Flag for cancellation
 
 	// 2. Perform Permission Checks
-	for i, resourceCheck := range resourcesToVerify {
+	for i, resourceCheck := range checks {
 		select {
 		case <-ctx.Done():
 			l.Warn("Context cancelled during health check loop, aborting remaining checks.", zap.Error(ctx.Err())) // Logged at Warn level
@@ -494,7 +559,7 @@ func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logg
 
 		resourceAttributes := &authorizationv1.ResourceAttributes{
 			Group: resourceCheck.Group, Version: resourceCheck.Version, Resource: resourceCheck.Resource,
-			Verb: "list", Namespace: "",
+			Verb: "list", Namespace: resourceCheck.Namespace,
 		}
 		ssar := &authorizationv1.SelfSubjectAccessReview{
 			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: resourceAttributes},
@@ -504,6 +569,7 @@ func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logg
 			zap.String("check_resource", resourceCheck.Friendly),
 			zap.String("group", resourceCheck.Group), zap.String("version", resourceCheck.Version),
 			zap.String("resource", resourceCheck.Resource), zap.String("verb", "list"),
+			zap.String("namespace", resourceCheck.Namespace),
 		)
 		checkLogger.Debug("Performing SelfSubjectAccessReview check") // Logged at Debug level
 
@@ -516,7 +582,7 @@ func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logg
 			checkLogger.Error("Failed to perform SelfSubjectAccessReview API call", zap.Error(err)) // Logged at Error level
 			missingPermissions = append(missingPermissions, HealthPermission{
 				Group: resourceCheck.Group, Version: resourceCheck.Version, Resource: resourceCheck.Resource,
-				Verb: "list", Scope: resourceCheck.Scope, Reason: fmt.Sprintf("API call failed: %s", err.Error()),
+				Verb: "list", Scope: resourceCheck.Scope, Namespace: resourceCheck.Namespace, Reason: fmt.Sprintf("API call failed: %s", err.Error()),
 			})
 			if xerrors.Is(err, context.Canceled) || xerrors.Is(err, context.DeadlineExceeded) {
 				checkLogger.Warn("Context cancelled or deadline exceeded during SSAR check, aborting remaining checks.", zap.Error(err)) // Logged at Warn level
@@ -535,7 +601,7 @@ func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logg
 			checkLogger.Warn("Permission check failed: 'list' denied", zap.String("reason", reason)) // Logged at Warn level
 			missingPermissions = append(missingPermissions, HealthPermission{
 				Group: resourceCheck.Group, Version: resourceCheck.Version, Resource: resourceCheck.Resource,
-				Verb: "list", Scope: resourceCheck.Scope, Reason: reason,
+				Verb: "list", Scope: resourceCheck.Scope, Namespace: resourceCheck.Namespace, Reason: reason,
 			})
 		} else {
 			checkLogger.Debug("Permission check succeeded: 'list' allowed") // Logged at Debug level
@@ -582,7 +648,9 @@ func VerifyHealth(ctx context.Context, restConfig *rest.Config, logger *zap.Logg
 
 // --- Main Function ---
 
-func DoHealthcheck(kubeConfig string) (bool, error) {
+// DoHealthcheck verifies the read permissions of the credentials, for kubeconfig credentials the pinned context
+// (or the current-context if none is pinned) is checked.
+func DoHealthcheck(creds constants.IntegrationCredentials) (bool, error) {
 	encoderCfg := zap.NewProductionEncoderConfig()
 	encoderCfg.TimeKey = "timestamp"
 	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
@@ -596,36 +664,26 @@ func DoHealthcheck(kubeConfig string) (bool, error) {
 		_ = logger.Sync() // Flush logs before exit
 	}()
 
-	tmpDir := os.TempDir()
-
-	// Create the full path for the kubeconfig file
-	kubeconfigPath := filepath.Join(tmpDir, "kubeconfig.yaml")
+	// --- Create Root Context ---
+	ctx := context.Background()
 
-	// Write the string into the file with 0600 permissions
-	err := os.WriteFile(kubeconfigPath, []byte(kubeConfig), 0600)
-	if err != nil {
+	// --- Validate Credentials (Common Step) ---
+	logger.Info("Validating credentials...", zap.String("credential_type", creds.GetCredentialType())) // Logged at Info level
+	if err := creds.Validate(); err != nil {
 		return false, err
 	}
-
-	// --- Basic File Existence Check ---
-	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
+	namespaces, err := creds.GetNamespaces()
+	if err != nil {
 		return false, err
 	}
-
-	// --- Create Root Context ---
-	ctx := context.Background()
-
-	// --- Validate Kubeconfig (Common Step) ---
-	logger.Info("Validating kubeconfig file...", zap.String("path", kubeconfigPath)) // Logged at Info level
-	_, restConfig, err := ValidateKubeconfig(ctx, kubeconfigPath, logger)
+	restConfig, err := credentialsRestConfig(creds, logger)
 	if err != nil {
 		return false, err
 	}
 
 	// --- Execute Action Based on Flag ---
 	var resultJSON string
-	resultJSON = VerifyHealth(ctx, restConfig, logger)
-	logger.Info("Fetching cluster information...", zap.String("kubeconfig_path", kubeconfigPath)) // Logged at Info level
+	resultJSON = VerifyHealth(ctx, restConfig, namespaces, logger)
 
 	// --- Determine Exit Code Based on Result ---
 	var result map[string]interface{}
@@ -639,7 +697,11 @@ func DoHealthcheck(kubeConfig string) (bool, error) {
 	return true, nil
 }
 
-func DoDiscovery(kubeConfig string) (map[string]string, error) {
+// DoDiscovery validates every context of the credentials (only the pinned one if a context is pinned)
+// and returns the cluster information of the reachable ones, keyed by context name.
+// Bearer token credentials have a single cluster, named after the host of their server.
+// Contexts failing validation or whose cluster cannot be reached are logged and skipped, an error is returned only if none of them is reachable.
+func DoDiscovery(creds constants.IntegrationCredentials) (map[string]map[string]string, error) {
 	encoderCfg := zap.NewProductionEncoderConfig()
 	encoderCfg.TimeKey = "timestamp"
 	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
@@ -653,43 +715,177 @@ func DoDiscovery(kubeConfig string) (map[string]string, error) {
 		_ = logger.Sync() // Flush logs before exit
 	}()
 
-	tmpDir := os.TempDir()
+	// --- Create Root Context ---
+	ctx := context.Background()
+
+	if err := creds.Validate(); err != nil {
+		return nil, err
+	}
+
+	// --- Bearer Token Credentials Have a Single Cluster ---
+	if creds.GetCredentialType() == constants.CredentialTypeToken {
+		restConfig, err := TokenRestConfig(creds, logger)
+		if err != nil {
+			return nil, err
+		}
+		contextName := kubeauth.TokenContextName(restConfig.Host)
+		output := ClusterInfo{
+			AuthMethod:            "Token",
+			ContextName:           contextName,
+			Endpoint:              restConfig.Host,
+			TLSServerVerification: !restConfig.Insecure,
+		}
+		logger.Info("Fetching cluster information...", zap.String("context", contextName)) // Logged at Info level
+		info, err := parseClusterInfo(getClusterInfo(ctx, output, restConfig, logger.With(zap.String("context", contextName)), logger))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]map[string]string{contextName: info}, nil
+	}
+
+	// --- Load Kubeconfig (Common Step) ---
+	logger.Info("Loading credentials...", zap.String("credential_type", creds.GetCredentialType())) // Logged at Info level
+	configAPI, err := loadCredentialsKubeconfig(creds, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var contextNames []string
+	if creds.Context != "" {
+		contextNames = append(contextNames, creds.Context)
+	} else {
+		for contextName := range configAPI.Contexts {
+			contextNames = append(contextNames, contextName)
+		}
+	}
+	if len(contextNames) == 0 {
+		return nil, xerrors.New("kubeconfig validation failed: no contexts defined")
+	}
+
+	// --- Validate and Reach Every Context Concurrently ---
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, MaxConcurrentContextChecks)
+		clusters = make(map[string]map[string]string)
+		failures []string
+	)
+	for _, contextName := range contextNames {
+		wg.Add(1)
+		go func(contextName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			info, err := discoverContext(ctx, configAPI, contextName, logger)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Warn("Skipping unreachable context", zap.String("context", contextName), zap.Error(err)) // Logged at Warn level
+				failures = append(failures, fmt.Sprintf("%s: %v", contextName, err))
+				return
+			}
+			clusters[contextName] = info
+		}(contextName)
+	}
+	wg.Wait()
+
+	if len(clusters) == 0 {
+		sort.Strings(failures)
+		return nil, xerrors.Errorf("none of the %d kubeconfig contexts is reachable: %s", len(contextNames), strings.Join(failures, "; "))
+	}
 
-	// Create the full path for the kubeconfig file
-	kubeconfigPath := filepath.Join(tmpDir, "kubeconfig.yaml")
+	return clusters, nil
+}
 
-	// Write the string into the file with 0600 permissions
-	err := os.WriteFile(kubeconfigPath, []byte(kubeConfig), 0600)
+// credentialsRestConfig validates the credentials and builds the REST config of their cluster,
+// for kubeconfig credentials the pinned context (or the current-context if none is pinned) is used.
+func credentialsRestConfig(creds constants.IntegrationCredentials, logger *zap.Logger) (*rest.Config, error) {
+	if creds.GetCredentialType() == constants.CredentialTypeToken {
+		return TokenRestConfig(creds, logger)
+	}
+
+	configAPI, err := loadCredentialsKubeconfig(creds, logger)
 	if err != nil {
 		return nil, err
 	}
+	contextName := creds.Context
+	if contextName == "" {
+		if configAPI.CurrentContext == "" {
+			return nil, xerrors.New("kubeconfig validation failed: current-context is not set")
+		}
+		contextName = configAPI.CurrentContext
+	}
+	return ValidateKubeContext(configAPI, contextName, logger)
+}
+
+// loadCredentialsKubeconfig returns the kubeconfig of the credentials,
+// in-cluster credentials are turned into a kubeconfig referencing the mounted service account token and CA files.
+func loadCredentialsKubeconfig(creds constants.IntegrationCredentials, logger *zap.Logger) (*api.Config, error) {
+	switch creds.GetCredentialType() {
+	case constants.CredentialTypeKubeconfig:
+		return LoadKubeconfig([]byte(creds.KubeConfig), logger)
+	case constants.CredentialTypeInCluster:
+		return inClusterKubeconfig(logger)
+	default:
+		return nil, xerrors.Errorf("unsupported credential type '%s'", creds.CredentialType)
+	}
+}
+
+// inClusterKubeconfig builds a single context kubeconfig from the service account the platform runs as.
+func inClusterKubeconfig(logger *zap.Logger) (*api.Config, error) {
+	configAPI, err := kubeauth.InClusterKubeconfig()
+	if err != nil {
+		wrappedErr := xerrors.Errorf("failed to load in-cluster config: %w", err)
+		logger.Error("Cannot load in-cluster configuration", zap.Error(wrappedErr)) // Logged at Error level
+		return nil, wrappedErr
+	}
+	return configAPI, nil
+}
 
-	// --- Basic File Existence Check ---
-	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
+// TokenRestConfig validates a bearer token credential and builds its REST config with kubeauth.TokenRestConfig, as the describers do.
+// On failure, it logs the error (if logger is enabled) and returns a nil config along with the error.
+func TokenRestConfig(creds constants.IntegrationCredentials, logger *zap.Logger) (*rest.Config, error) {
+	l := logger.With(zap.String("server", creds.Server))
+
+	restConfig, err := kubeauth.TokenRestConfig(creds)
+	if err != nil {
+		l.Error("Bearer token credential validation failed", zap.Error(err)) // Logged at Error level
 		return nil, err
 	}
+	if restConfig.Insecure {
+		l.Warn("TLS verification of the server is disabled.") // Logged at Warn level
+	}
 
-	// --- Create Root Context ---
-	ctx := context.Background()
+	l.Info("Bearer token credential validation successful") // Logged at Info level
+	return restConfig, nil
+}
 
-	// --- Validate Kubeconfig (Common Step) ---
-	logger.Info("Validating kubeconfig file...", zap.String("path", kubeconfigPath)) // Logged at Info level
-	configAPI, restConfig, err := ValidateKubeconfig(ctx, kubeconfigPath, logger)
+// discoverContext validates a single context and fetches the information of its cluster.
+func discoverContext(ctx context.Context, configAPI *api.Config, contextName string, logger *zap.Logger) (map[string]string, error) {
+	restConfig, err := ValidateKubeContext(configAPI, contextName, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	// --- Execute Action Based on Flag ---
-	var resultJSON string
+	// GetClusterInfo reports on the current-context, so point it at the context being discovered
+	contextConfigAPI := configAPI.DeepCopy()
+	contextConfigAPI.CurrentContext = contextName
 
-	logger.Info("Fetching cluster information...", zap.String("kubeconfig_path", kubeconfigPath)) // Logged at Info level
-	resultJSON = GetClusterInfo(ctx, configAPI, restConfig, logger)
+	logger.Info("Fetching cluster information...", zap.String("context", contextName)) // Logged at Info level
+	return parseClusterInfo(GetClusterInfo(ctx, contextConfigAPI, restConfig, logger))
+}
 
-	// --- Determine Exit Code Based on Result ---
+// parseClusterInfo converts the JSON returned by GetClusterInfo to labels, ErrorInfo results are returned as errors.
+func parseClusterInfo(resultJSON string) (map[string]string, error) {
 	var result map[string]interface{}
 	if err := json.Unmarshal([]byte(resultJSON), &result); err != nil {
 		return nil, err
 	}
+	if isError, _ := result["error"].(bool); isError {
+		return nil, xerrors.Errorf("%v: %v", result["message"], result["details"])
+	}
 
 	return convertToStringMap(result), nil
 }